@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+)
+
+// accountCheckTimeout bounds looking up the account the credentials resolve to
+const accountCheckTimeout = 10 * time.Second
+
+// findCredentials looks up credentials JSON in store, "file" or "keychain", and logs where they came from.
+// path is the --credentials file, which takes precedence over the environment and application-default credentials.
+func findCredentials(store, path string) ([]byte, error) {
+	var credentials *gdrive.Credentials
+	var err error
+	if store == "keychain" {
+		credentials, err = gdrive.KeychainCredentials()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load credentials from the OS credential store: %w", err)
+		}
+	} else {
+		credentials, err = gdrive.FindCredentials(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	log.Printf("Using credentials from %s", credentials)
+	return credentials.JSON, nil
+}
+
+// logAccount reports which account the credentials resolve to. A failed lookup is only logged, since the
+// server may start before credentials or the network are available.
+func logAccount(ctx context.Context, driveService *gdrive.DriveService) {
+	ctx, cancel := context.WithTimeout(ctx, accountCheckTimeout)
+	defer cancel()
+
+	account, err := driveService.GetAccountInfo(ctx)
+	if err != nil {
+		log.Printf("Warning: %v. Tools fail until this is fixed; credentials are looked up again on the next call, or reloaded with reload_credentials or SIGHUP", err)
+		return
+	}
+	log.Printf("Authenticated as %s", account.EmailAddress)
+}
+
+// reloadOnSignal reloads the credentials whenever the process receives SIGHUP
+func reloadOnSignal(ctx context.Context, driveService *gdrive.DriveService) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	for range signals {
+		log.Print("Reloading credentials")
+		reloadCtx, cancel := context.WithTimeout(ctx, accountCheckTimeout)
+		account, err := driveService.ReloadCredentials(reloadCtx)
+		cancel()
+		if err != nil {
+			log.Printf("Failed to reload credentials: %v", err)
+			continue
+		}
+		log.Printf("Authenticated as %s", account.EmailAddress)
+	}
+}
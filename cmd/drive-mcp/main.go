@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"runtime/debug"
+	"strings"
+
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+	"github.com/kitagry/drive-mcp/pkg/tools"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// version is the binary version, injected at build time with -ldflags "-X main.version=..."
+var version string
+
+// buildVersion returns the injected version, falling back to the module version for go install builds
+func buildVersion() string {
+	if version != "" {
+		return version
+	}
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	return "dev"
+}
+
+func main() {
+	// Parse flags
+	timeout := flag.Duration("timeout", tools.DefaultTimeout, "Default timeout for each tool call (0 disables the timeout)")
+	perToolTimeouts := tools.ToolTimeouts{}
+	flag.Var(perToolTimeouts, "tool-timeout", "Per-tool timeout override in name=duration form (repeatable, e.g. get_document=5m)")
+	parallelism := flag.Int("parallelism", gdrive.DefaultParallelism, "Maximum number of concurrent API calls made by batch operations")
+	proxy := flag.String("proxy", "", "HTTP(S) proxy URL for all Google API requests (overrides HTTP_PROXY/HTTPS_PROXY, honors NO_PROXY)")
+	cacheSize := flag.Int("cache-size", gdrive.DefaultCacheSize, "Number of document, presentation, and spreadsheet reads to cache while the file is unchanged (0 disables the cache)")
+	rateLimits := gdrive.RateLimits{}
+	flag.Var(rateLimits, "rate-limit", "Client-side request budget in api=qps form for drive, docs, slides, sheets, labels, or activity (repeatable, e.g. sheets=1)")
+	budgets := gdrive.Budgets{}
+	flag.Var(budgets, "budget", "Session limit in kind=limit form on API calls (drive, docs, slides, sheets) or on cells or characters written (repeatable, e.g. drive=500)")
+	breakerThreshold := flag.Int("breaker-threshold", gdrive.DefaultBreakerThreshold, "Consecutive failed calls to a Google API after which its calls fail fast for the cooldown (0 disables the circuit breaker)")
+	breakerCooldown := flag.Duration("breaker-cooldown", gdrive.DefaultBreakerCooldown, "How long calls to a failing Google API fail fast before it is tried again")
+	maxReadChars := flag.Int("max-read-chars", 0, "Largest document or presentation text in characters get_document and get_presentation return whole; larger ones are returned as sections (0 disables the limit)")
+	summarizeReads := flag.Bool("summarize-reads", false, "Summarize documents and presentations over --max-read-chars with the client's model through MCP sampling")
+	watchInterval := flag.Duration("watch-interval", tools.DefaultWatchInterval, "How often files and folders watched with watch_file and watch_folder are checked for changes")
+	maxUploadSize := flag.Int64("max-upload-size", gdrive.DefaultMaxUploadSize, "Largest file in bytes upload_from_url will fetch and upload_file will accept (0 disables the limit)")
+	uploadContentTypes := flag.String("upload-content-types", "", "Comma-separated media types upload_from_url and upload_file accept, e.g. image/*,application/pdf (empty accepts any)")
+	resolveShortcuts := flag.Bool("resolve-shortcuts", true, "Follow shortcuts passed to content tools to the files they point to")
+	readOnly := flag.Bool("read-only", false, "Register only tools that never modify any files")
+	accessPolicyFile := flag.String("access-policy", "", "Path to a JSON access policy restricting which files, folders, and MIME types write tools may change")
+	outputFolder := flag.String("output-folder", "", "ID or URL of a folder every created file is placed in, regardless of the folder requested")
+	rootFolder := flag.String("root-folder", "", "ID or URL of a folder to confine all operations to; files outside its subtree are rejected and hidden from searches")
+	credentialsFile := flag.String("credentials", "", "Path to a credentials JSON file, taking precedence over GOOGLE_APPLICATION_CREDENTIALS and gcloud application-default credentials")
+	credentialsStore := flag.String("credentials-store", "file", "Where to read OAuth credentials from: file (gcloud application-default credentials) or keychain (the OS credential store)")
+	saveCredentials := flag.String("save-credentials", "", "Save the credentials JSON file at this path to the OS credential store and exit")
+	tracing := flag.Bool("otel-tracing", false, "Export a trace span per tool call and per Google API request over OTLP, configured with the OTEL_EXPORTER_OTLP_* environment variables")
+	locale := flag.String("locale", tools.DefaultLocale, "Language of tool and parameter descriptions: "+strings.Join(tools.Locales(), " or "))
+	flag.Parse()
+
+	if *saveCredentials != "" {
+		data, err := os.ReadFile(*saveCredentials)
+		if err != nil {
+			log.Fatal("Failed to read credentials:", err)
+		}
+		if err := gdrive.SaveKeychainCredentials(data); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Saved credentials to the OS credential store under %q", gdrive.KeychainService)
+		return
+	}
+
+	if err := configureProxy(*proxy); err != nil {
+		log.Fatal("Failed to configure proxy:", err)
+	}
+
+	// Initialize Drive service once
+	ctx := context.Background()
+	uploadLimits := gdrive.UploadLimits{MaxSize: *maxUploadSize}
+	if *uploadContentTypes != "" {
+		uploadLimits.ContentTypes = strings.Split(*uploadContentTypes, ",")
+	}
+
+	var accessPolicy *gdrive.AccessPolicy
+	if *accessPolicyFile != "" {
+		var err error
+		accessPolicy, err = gdrive.LoadAccessPolicy(*accessPolicyFile)
+		if err != nil {
+			log.Fatal("Failed to load access policy:", err)
+		}
+	}
+
+	switch *credentialsStore {
+	case "file":
+	case "keychain":
+		if *credentialsFile != "" {
+			log.Fatal("--credentials cannot be used with --credentials-store=keychain")
+		}
+	default:
+		log.Fatalf("Invalid credentials store %q: must be file or keychain", *credentialsStore)
+	}
+
+	opts := append([]gdrive.Option{
+		gdrive.WithParallelism(*parallelism),
+		gdrive.WithCacheSize(*cacheSize),
+		gdrive.WithUploadLimits(uploadLimits),
+		gdrive.WithShortcutResolution(*resolveShortcuts),
+		gdrive.WithRootFolder(*rootFolder),
+		gdrive.WithAccessPolicy(accessPolicy),
+		gdrive.WithOutputFolder(*outputFolder),
+		gdrive.WithCircuitBreaker(*breakerThreshold, *breakerCooldown),
+	}, rateLimits.Options()...)
+	opts = append(opts, budgets.Options()...)
+	// Credentials are looked up on first use and on reload, so the server starts even before they are available
+	opts = append(opts, gdrive.WithCredentialsLoader(func() ([]byte, error) {
+		return findCredentials(*credentialsStore, *credentialsFile)
+	}))
+
+	middlewares := []server.ServerOption{
+		server.WithToolHandlerMiddleware(tools.NewRequestIDMiddleware(log.Default())),
+	}
+	if *tracing {
+		tracerProvider, err := newTracerProvider(ctx, buildVersion())
+		if err != nil {
+			log.Fatal("Failed to set up tracing:", err)
+		}
+		defer func() {
+			if err := tracerProvider.Shutdown(context.Background()); err != nil {
+				log.Println("Failed to flush traces:", err)
+			}
+		}()
+		opts = append(opts, gdrive.WithTracerProvider(tracerProvider))
+		middlewares = append(middlewares, server.WithToolHandlerMiddleware(tools.NewTracingMiddleware(tracerProvider)))
+	}
+	middlewares = append(middlewares,
+		server.WithToolHandlerMiddleware(tools.NewTimeoutMiddleware(*timeout, perToolTimeouts)),
+		server.WithToolHandlerMiddleware(tools.NewReadLimitMiddleware(*maxReadChars, *summarizeReads)),
+		server.WithToolHandlerMiddleware(tools.NewFileURLMiddleware()),
+	)
+
+	driveService, err := gdrive.NewDriveService(ctx, opts...)
+	if err != nil {
+		log.Fatal("Failed to initialize Drive service:", err)
+	}
+	go logAccount(ctx, driveService)
+	go reloadOnSignal(ctx, driveService)
+
+	s := server.NewMCPServer(
+		"Google Drive MCP",
+		buildVersion(),
+		append([]server.ServerOption{server.WithToolCapabilities(true)}, middlewares...)...,
+	)
+	if *summarizeReads {
+		s.EnableSampling()
+	}
+
+	info := &tools.ServerInfo{
+		Version: buildVersion(),
+		Config: map[string]any{
+			"timeout":          timeout.String(),
+			"toolTimeouts":     perToolTimeouts.String(),
+			"parallelism":      *parallelism,
+			"cacheSize":        *cacheSize,
+			"rateLimits":       rateLimits.String(),
+			"budgets":          budgets.String(),
+			"breakerThreshold": *breakerThreshold,
+			"breakerCooldown":  breakerCooldown.String(),
+			"maxReadChars":     *maxReadChars,
+			"summarizeReads":   *summarizeReads,
+			"watchInterval":    watchInterval.String(),
+			"uploadLimits":     uploadLimits,
+			"resolveShortcuts": *resolveShortcuts,
+			"quotaProject":     os.Getenv("GOOGLE_CLOUD_QUOTA_PROJECT_ID"),
+			"proxy":            redactedProxy(*proxy),
+			"readOnly":         *readOnly,
+			"rootFolder":       *rootFolder,
+			"accessPolicy":     accessPolicy,
+			"outputFolder":     *outputFolder,
+			"locale":           *locale,
+			"otelTracing":      *tracing,
+			"credentialsStore": *credentialsStore,
+		},
+	}
+
+	// Register tool handlers
+	registry := tools.NewDefaultRegistry(driveService)
+	registry.Add(tools.ServerInfoTool(driveService, info), tools.StorageQuotaTool(driveService), tools.DiagnoseAuthTool(driveService), tools.ReloadCredentialsTool(driveService))
+	// The server talks to its client over stdio, so it runs on the user's machine and may read and write local files
+	registry.Add(tools.SaveFileTool(driveService), tools.UploadDirectoryTool(driveService))
+	registry.Add(tools.WatchTools(tools.NewWatcher(driveService, *watchInterval, log.Default()))...)
+	if err := registry.Localize(*locale); err != nil {
+		log.Fatal("Failed to localize tools:", err)
+	}
+
+	var filters []tools.Filter
+	if *readOnly {
+		filters = append(filters, tools.ReadOnlyFilter)
+	}
+	info.Tools = registry.Register(s, filters...)
+
+	// Start server
+	if err := server.ServeStdio(s); err != nil {
+		log.Fatal("Failed to start MCP server:", err)
+	}
+}
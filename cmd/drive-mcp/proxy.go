@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// configureProxy routes all outgoing HTTP requests through proxyURL, honoring NO_PROXY.
+// It updates http.DefaultTransport because the Google API clients and the auth libraries
+// fetching OAuth tokens both derive their transports from it. Without a flag value,
+// HTTP_PROXY/HTTPS_PROXY/NO_PROXY from the environment are used as usual.
+func configureProxy(proxyURL string) error {
+	if proxyURL == "" {
+		return nil
+	}
+
+	if _, err := url.Parse(proxyURL); err != nil {
+		return fmt.Errorf("invalid proxy URL: %w", err)
+	}
+
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return fmt.Errorf("unexpected default transport type %T", http.DefaultTransport)
+	}
+
+	noProxy := os.Getenv("NO_PROXY")
+	if noProxy == "" {
+		noProxy = os.Getenv("no_proxy")
+	}
+
+	proxyFunc := (&httpproxy.Config{
+		HTTPProxy:  proxyURL,
+		HTTPSProxy: proxyURL,
+		NoProxy:    noProxy,
+	}).ProxyFunc()
+	transport.Proxy = func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+
+	return nil
+}
+
+// redactedProxy returns proxyURL with any password masked, for reporting
+func redactedProxy(proxyURL string) string {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return ""
+	}
+	return u.Redacted()
+}
@@ -0,0 +1,25 @@
+package fakegoogle
+
+import (
+	"net/http"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// SetAbout sets the account and storage quota reported by the Drive about endpoint
+func (s *Server) SetAbout(about *drive.About) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.about = about
+}
+
+func (s *Server) handleGetAbout(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	about := s.about
+	if about == nil {
+		about = &drive.About{}
+	}
+	writeJSON(w, about)
+}
@@ -0,0 +1,138 @@
+package fakegoogle
+
+import (
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/driveactivity/v2"
+)
+
+// activityTimeFilter and activityActionFilter match the parts of a Drive Activity API filter the fake supports
+var (
+	activityTimeFilter   = regexp.MustCompile(`time >= "([^"]+)"`)
+	activityActionFilter = regexp.MustCompile(`detail\.action_detail_case:\(([^)]*)\)`)
+)
+
+// AddActivity records an activity; its targets' item names, e.g. "items/ID", tie it to files.
+// Activities are reported newest first, so add them oldest first.
+func (s *Server) AddActivity(activity *driveactivity.DriveActivity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.activities = append(s.activities, activity)
+}
+
+func (s *Server) handleQueryActivity(w http.ResponseWriter, r *http.Request) {
+	var request driveactivity.QueryDriveActivityRequest
+	if err := decodeJSON(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var since string
+	if m := activityTimeFilter.FindStringSubmatch(request.Filter); m != nil {
+		since = m[1]
+	}
+	var actions []string
+	if m := activityActionFilter.FindStringSubmatch(request.Filter); m != nil {
+		actions = strings.Fields(m[1])
+	}
+
+	response := &driveactivity.QueryDriveActivityResponse{Activities: []*driveactivity.DriveActivity{}}
+	for i := len(s.activities) - 1; i >= 0; i-- {
+		activity := s.activities[i]
+		if since != "" && activity.Timestamp < since {
+			continue
+		}
+		if len(actions) > 0 && !slices.Contains(actions, activityActionCase(activity.PrimaryActionDetail)) {
+			continue
+		}
+		if !s.activityMatchesLocked(activity, request.ItemName, request.AncestorName) {
+			continue
+		}
+		response.Activities = append(response.Activities, activity)
+		if request.PageSize > 0 && int64(len(response.Activities)) == request.PageSize {
+			break
+		}
+	}
+	writeJSON(w, response)
+}
+
+// activityMatchesLocked reports whether an activity targets itemName, or an item inside the folder ancestorName.
+// With neither set, every activity matches.
+func (s *Server) activityMatchesLocked(activity *driveactivity.DriveActivity, itemName, ancestorName string) bool {
+	if itemName == "" && ancestorName == "" {
+		return true
+	}
+	ancestorID := strings.TrimPrefix(ancestorName, "items/")
+	for _, target := range activity.Targets {
+		if target.DriveItem == nil {
+			continue
+		}
+		if itemName != "" && target.DriveItem.Name == itemName {
+			return true
+		}
+		if ancestorName != "" && s.hasAncestorLocked(strings.TrimPrefix(target.DriveItem.Name, "items/"), ancestorID) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAncestorLocked reports whether fileID is ancestorID or lies anywhere inside it
+func (s *Server) hasAncestorLocked(fileID, ancestorID string) bool {
+	seen := make(map[string]bool)
+	queue := []string{fileID}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == ancestorID {
+			return true
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if file, ok := s.files[id]; ok {
+			queue = append(queue, file.Parents...)
+		}
+	}
+	return false
+}
+
+// activityActionCase returns the filter name of an activity's action, e.g. "EDIT"
+func activityActionCase(d *driveactivity.ActionDetail) string {
+	switch {
+	case d == nil:
+		return ""
+	case d.Create != nil:
+		return "CREATE"
+	case d.Edit != nil:
+		return "EDIT"
+	case d.Move != nil:
+		return "MOVE"
+	case d.Rename != nil:
+		return "RENAME"
+	case d.Delete != nil:
+		return "DELETE"
+	case d.Restore != nil:
+		return "RESTORE"
+	case d.PermissionChange != nil:
+		return "PERMISSION_CHANGE"
+	case d.Comment != nil:
+		return "COMMENT"
+	case d.DlpChange != nil:
+		return "DLP_CHANGE"
+	case d.Reference != nil:
+		return "REFERENCE"
+	case d.SettingsChange != nil:
+		return "SETTINGS_CHANGE"
+	case d.AppliedLabelChange != nil:
+		return "APPLIED_LABEL_CHANGE"
+	}
+	return ""
+}
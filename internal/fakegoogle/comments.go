@@ -0,0 +1,89 @@
+package fakegoogle
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// AddComment registers a comment on a file; comments are listed in the order they are added
+func (s *Server) AddComment(fileID string, comment *drive.Comment) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.comments[fileID] = append(s.comments[fileID], comment)
+}
+
+func (s *Server) handleListComments(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID := r.PathValue("fileId")
+	if _, ok := s.files[fileID]; !ok {
+		writeError(w, http.StatusNotFound, "file %s not found", fileID)
+		return
+	}
+
+	list := &drive.CommentList{Comments: s.comments[fileID]}
+	if list.Comments == nil {
+		list.Comments = []*drive.Comment{}
+	}
+	writeJSON(w, list)
+}
+
+func (s *Server) handleCreateComment(w http.ResponseWriter, r *http.Request) {
+	var comment drive.Comment
+	if err := decodeJSON(r, &comment); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid comment: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID := r.PathValue("fileId")
+	if _, ok := s.files[fileID]; !ok {
+		writeError(w, http.StatusNotFound, "file %s not found", fileID)
+		return
+	}
+
+	comment.Id = fmt.Sprintf("fake-comment-%d", len(s.comments[fileID])+1)
+	comment.CreatedTime = time.Now().UTC().Format(time.RFC3339)
+	comment.ModifiedTime = comment.CreatedTime
+	s.comments[fileID] = append(s.comments[fileID], &comment)
+
+	writeJSON(w, &comment)
+}
+
+func (s *Server) handleCreateReply(w http.ResponseWriter, r *http.Request) {
+	var reply drive.Reply
+	if err := decodeJSON(r, &reply); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid reply: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID, commentID := r.PathValue("fileId"), r.PathValue("commentId")
+	for _, comment := range s.comments[fileID] {
+		if comment.Id != commentID || comment.Deleted {
+			continue
+		}
+		reply.Id = fmt.Sprintf("fake-reply-%d", len(comment.Replies)+1)
+		reply.CreatedTime = time.Now().UTC().Format(time.RFC3339)
+		comment.Replies = append(comment.Replies, &reply)
+		switch reply.Action {
+		case "resolve":
+			comment.Resolved = true
+		case "reopen":
+			comment.Resolved = false
+		}
+		comment.ModifiedTime = reply.CreatedTime
+
+		writeJSON(w, &reply)
+		return
+	}
+	writeError(w, http.StatusNotFound, "comment %s not found", commentID)
+}
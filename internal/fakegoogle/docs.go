@@ -0,0 +1,150 @@
+package fakegoogle
+
+import (
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/drive/v3"
+)
+
+// AddDocument registers a Google Document whose body is the given text.
+// A trailing newline is added if missing, as in real documents.
+func (s *Server) AddDocument(documentID, title, text string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.addFileLocked(&drive.File{
+		Id:       documentID,
+		Name:     title,
+		MimeType: "application/vnd.google-apps.document",
+	})
+	s.documents[documentID] = newDocument(documentID, title, text)
+}
+
+// DocumentText returns the current body text of a registered document
+func (s *Server) DocumentText(documentID string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doc, ok := s.documents[documentID]
+	if !ok {
+		return "", false
+	}
+	return documentText(doc), true
+}
+
+// newDocument builds a document with a single paragraph holding text
+func newDocument(documentID, title, text string) *docs.Document {
+	if !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+
+	// Index 0 is the section break; body text starts at index 1
+	endIndex := int64(1 + len([]rune(text)))
+	return &docs.Document{
+		DocumentId: documentID,
+		Title:      title,
+		Body: &docs.Body{
+			Content: []*docs.StructuralElement{
+				{EndIndex: 1, SectionBreak: &docs.SectionBreak{}},
+				{
+					StartIndex: 1,
+					EndIndex:   endIndex,
+					Paragraph: &docs.Paragraph{
+						Elements: []*docs.ParagraphElement{
+							{
+								StartIndex: 1,
+								EndIndex:   endIndex,
+								TextRun:    &docs.TextRun{Content: text},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// documentText concatenates the text runs of a document body
+func documentText(doc *docs.Document) string {
+	var text string
+	for _, element := range doc.Body.Content {
+		if element.Paragraph == nil {
+			continue
+		}
+		for _, elem := range element.Paragraph.Elements {
+			if elem.TextRun != nil {
+				text += elem.TextRun.Content
+			}
+		}
+	}
+	return text
+}
+
+func (s *Server) handleGetDocument(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	documentID := r.PathValue("documentId")
+	doc, ok := s.documents[documentID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "document %s not found", documentID)
+		return
+	}
+
+	writeJSON(w, doc)
+}
+
+func (s *Server) handleBatchUpdateDocument(w http.ResponseWriter, r *http.Request) {
+	documentID, ok := strings.CutSuffix(r.PathValue("documentId"), ":batchUpdate")
+	if !ok {
+		writeError(w, http.StatusNotFound, "unsupported method")
+		return
+	}
+
+	var req docs.BatchUpdateDocumentRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doc, ok := s.documents[documentID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "document %s not found", documentID)
+		return
+	}
+
+	// Text offsets are document indexes minus the leading section break
+	text := []rune(documentText(doc))
+	for _, request := range req.Requests {
+		switch {
+		case request.DeleteContentRange != nil:
+			start := request.DeleteContentRange.Range.StartIndex - 1
+			end := request.DeleteContentRange.Range.EndIndex - 1
+			if start < 0 || end > int64(len(text))-1 || start > end {
+				writeError(w, http.StatusBadRequest, "invalid delete range")
+				return
+			}
+			text = append(text[:start:start], text[end:]...)
+		case request.InsertText != nil:
+			index := request.InsertText.Location.Index - 1
+			if index < 0 || index > int64(len(text))-1 {
+				writeError(w, http.StatusBadRequest, "invalid insert location")
+				return
+			}
+			inserted := []rune(request.InsertText.Text)
+			text = append(text[:index:index], append(inserted, text[index:]...)...)
+		default:
+			writeError(w, http.StatusBadRequest, "unsupported request")
+			return
+		}
+	}
+
+	s.documents[documentID] = newDocument(documentID, doc.Title, string(text))
+	s.touchLocked(documentID)
+	writeJSON(w, &docs.BatchUpdateDocumentResponse{DocumentId: documentID})
+}
@@ -0,0 +1,570 @@
+package fakegoogle
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+)
+
+var (
+	nameContainsPattern = regexp.MustCompile(`^name contains '(.*)'$`)
+	nameEqualsPattern   = regexp.MustCompile(`^name = '(.*)'$`)
+	fullTextPattern     = regexp.MustCompile(`^fullText contains '(.*)'$`)
+	inParentsPattern    = regexp.MustCompile(`^'(.*)' in parents$`)
+	trashedPattern      = regexp.MustCompile(`^trashed = (true|false)$`)
+	starredPattern      = regexp.MustCompile(`^starred = (true|false)$`)
+	mimeTypePattern     = regexp.MustCompile(`^mimeType (=|!=) '(.*)'$`)
+	inOwnersPattern     = regexp.MustCompile(`^'(.*)' in owners$`)
+	timePattern         = regexp.MustCompile(`^(modifiedTime|createdTime|viewedByMeTime) (>=|>|<=|<) '(.*)'$`)
+	propertiesPattern   = regexp.MustCompile(`^(properties|appProperties) has \{ key='(.*)' and value='(.*)' \}$`)
+)
+
+// fileFilter reports whether a file matches one clause of a Drive query
+type fileFilter func(file *drive.File) bool
+
+// parseQuery converts the subset of the Drive query language used by drive-mcp into filters.
+// fullText matches the file's name, description, and binary or document content.
+func (s *Server) parseQuery(q string) ([]fileFilter, bool) {
+	if q == "" {
+		return nil, true
+	}
+
+	var filters []fileFilter
+	for _, clause := range splitClauses(q) {
+		clause = strings.TrimSpace(clause)
+		if m := nameContainsPattern.FindStringSubmatch(clause); m != nil {
+			name := strings.ToLower(unescapeQuery(m[1]))
+			filters = append(filters, func(file *drive.File) bool {
+				return strings.Contains(strings.ToLower(file.Name), name)
+			})
+		} else if m := nameEqualsPattern.FindStringSubmatch(clause); m != nil {
+			name := unescapeQuery(m[1])
+			filters = append(filters, func(file *drive.File) bool {
+				return file.Name == name
+			})
+		} else if m := fullTextPattern.FindStringSubmatch(clause); m != nil {
+			text := strings.ToLower(unescapeQuery(m[1]))
+			filters = append(filters, func(file *drive.File) bool {
+				content := file.Name + "\n" + file.Description + "\n" + string(s.contents[file.Id])
+				if doc, ok := s.documents[file.Id]; ok {
+					content += "\n" + documentText(doc)
+				}
+				return strings.Contains(strings.ToLower(content), text)
+			})
+		} else if m := inParentsPattern.FindStringSubmatch(clause); m != nil {
+			parent := unescapeQuery(m[1])
+			filters = append(filters, func(file *drive.File) bool {
+				for _, p := range file.Parents {
+					if p == parent {
+						return true
+					}
+				}
+				return false
+			})
+		} else if m := trashedPattern.FindStringSubmatch(clause); m != nil {
+			trashed := m[1] == "true"
+			filters = append(filters, func(file *drive.File) bool {
+				return file.Trashed == trashed
+			})
+		} else if m := starredPattern.FindStringSubmatch(clause); m != nil {
+			starred := m[1] == "true"
+			filters = append(filters, func(file *drive.File) bool {
+				return file.Starred == starred
+			})
+		} else if m := propertiesPattern.FindStringSubmatch(clause); m != nil {
+			appProperties, key, value := m[1] == "appProperties", unescapeQuery(m[2]), unescapeQuery(m[3])
+			filters = append(filters, func(file *drive.File) bool {
+				properties := file.Properties
+				if appProperties {
+					properties = file.AppProperties
+				}
+				v, ok := properties[key]
+				return ok && v == value
+			})
+		} else if m := mimeTypePattern.FindStringSubmatch(clause); m != nil {
+			equal, mimeType := m[1] == "=", m[2]
+			filters = append(filters, func(file *drive.File) bool {
+				return (file.MimeType == mimeType) == equal
+			})
+		} else if m := inOwnersPattern.FindStringSubmatch(clause); m != nil {
+			owner := unescapeQuery(m[1])
+			filters = append(filters, func(file *drive.File) bool {
+				for _, o := range file.Owners {
+					if o.EmailAddress == owner {
+						return true
+					}
+				}
+				return false
+			})
+		} else if m := timePattern.FindStringSubmatch(clause); m != nil {
+			field, op := m[1], m[2]
+			bound, err := time.Parse(time.RFC3339, m[3])
+			if err != nil {
+				return nil, false
+			}
+			filters = append(filters, func(file *drive.File) bool {
+				value := file.ModifiedTime
+				switch field {
+				case "createdTime":
+					value = file.CreatedTime
+				case "viewedByMeTime":
+					value = file.ViewedByMeTime
+				}
+				t, err := time.Parse(time.RFC3339, value)
+				if err != nil {
+					return false
+				}
+				switch op {
+				case ">=":
+					return !t.Before(bound)
+				case ">":
+					return t.After(bound)
+				case "<=":
+					return !t.After(bound)
+				default:
+					return t.Before(bound)
+				}
+			})
+		} else {
+			return nil, false
+		}
+	}
+
+	return filters, true
+}
+
+// splitClauses splits a query on the " and " operators outside of braces
+func splitClauses(q string) []string {
+	var clauses []string
+	depth, start := 0, 0
+	for i := 0; i < len(q); i++ {
+		switch {
+		case q[i] == '{':
+			depth++
+		case q[i] == '}':
+			depth--
+		case depth == 0 && strings.HasPrefix(q[i:], " and "):
+			clauses = append(clauses, q[start:i])
+			start = i + len(" and ")
+			i = start - 1
+		}
+	}
+	return append(clauses, q[start:])
+}
+
+// unescapeQuery reverses the escaping of quotes and backslashes in a query string literal
+func unescapeQuery(s string) string {
+	return strings.NewReplacer(`\'`, `'`, `\\`, `\`).Replace(s)
+}
+
+func (s *Server) handleListFiles(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query().Get("q")
+	filters, ok := s.parseQuery(q)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "unsupported query: %s", q)
+		return
+	}
+
+	pageSize := 100
+	if v := r.URL.Query().Get("pageSize"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid pageSize: %s", v)
+			return
+		}
+		pageSize = n
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := &drive.FileList{Files: []*drive.File{}}
+	for _, id := range s.fileOrder {
+		file := s.files[id]
+		matched := true
+		for _, filter := range filters {
+			if !filter(file) {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		if len(list.Files) == pageSize {
+			break
+		}
+		list.Files = append(list.Files, file)
+	}
+
+	writeJSON(w, list)
+}
+
+// AddFileContent registers a binary file with the given content
+func (s *Server) AddFileContent(file *drive.File, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	setChecksums(file, content)
+	s.addFileLocked(file)
+	s.contents[file.Id] = content
+}
+
+// setChecksums sets the size and checksums Drive reports for binary content
+func setChecksums(file *drive.File, content []byte) {
+	md5Sum := md5.Sum(content)
+	sha1Sum := sha1.Sum(content)
+	sha256Sum := sha256.Sum256(content)
+	file.Size = int64(len(content))
+	file.Md5Checksum = hex.EncodeToString(md5Sum[:])
+	file.Sha1Checksum = hex.EncodeToString(sha1Sum[:])
+	file.Sha256Checksum = hex.EncodeToString(sha256Sum[:])
+}
+
+func (s *Server) handleGetFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID := r.PathValue("fileId")
+	file, ok := s.files[fileID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "file %s not found", fileID)
+		return
+	}
+
+	if r.URL.Query().Get("alt") == "media" {
+		content, ok := s.contents[fileID]
+		if !ok {
+			writeError(w, http.StatusForbidden, "only files with binary content can be downloaded")
+			return
+		}
+		http.ServeContent(w, r, file.Name, time.Time{}, bytes.NewReader(content))
+		return
+	}
+
+	writeJSON(w, file)
+}
+
+// File returns a copy of the file's current metadata
+func (s *Server) File(fileID string) (*drive.File, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, ok := s.files[fileID]
+	if !ok {
+		return nil, false
+	}
+	copied := *file
+	return &copied, true
+}
+
+func (s *Server) handleUpdateFile(w http.ResponseWriter, r *http.Request) {
+	var patch map[string]json.RawMessage
+	if err := decodeJSON(r, &patch); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID := r.PathValue("fileId")
+	file, ok := s.files[fileID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "file %s not found", fileID)
+		return
+	}
+
+	// Apply only the fields present in the request, as PATCH does
+	for key, value := range patch {
+		var err error
+		switch key {
+		case "name":
+			err = json.Unmarshal(value, &file.Name)
+		case "description":
+			err = json.Unmarshal(value, &file.Description)
+		case "trashed":
+			err = json.Unmarshal(value, &file.Trashed)
+		case "starred":
+			err = json.Unmarshal(value, &file.Starred)
+		case "folderColorRgb":
+			err = json.Unmarshal(value, &file.FolderColorRgb)
+		case "contentHints":
+			err = json.Unmarshal(value, &file.ContentHints)
+		case "properties":
+			file.Properties, err = patchProperties(file.Properties, value)
+		case "appProperties":
+			file.AppProperties, err = patchProperties(file.AppProperties, value)
+		default:
+			writeError(w, http.StatusBadRequest, "unsupported field: %s", key)
+			return
+		}
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid %s: %v", key, err)
+			return
+		}
+	}
+
+	if add := r.URL.Query().Get("addParents"); add != "" {
+		file.Parents = append(file.Parents, strings.Split(add, ",")...)
+	}
+	if remove := r.URL.Query().Get("removeParents"); remove != "" {
+		file.Parents = slices.DeleteFunc(file.Parents, func(p string) bool {
+			return slices.Contains(strings.Split(remove, ","), p)
+		})
+	}
+
+	s.touchLocked(fileID)
+	writeJSON(w, file)
+}
+
+// patchProperties merges a properties patch into properties; keys set to null are removed
+func patchProperties(properties map[string]string, value json.RawMessage) (map[string]string, error) {
+	var patch map[string]*string
+	if err := json.Unmarshal(value, &patch); err != nil {
+		return nil, err
+	}
+	if properties == nil {
+		properties = make(map[string]string)
+	}
+	for key, v := range patch {
+		if v == nil {
+			delete(properties, key)
+		} else {
+			properties[key] = *v
+		}
+	}
+	return properties, nil
+}
+
+// newFileIDLocked returns an unused file ID for a file created by the fake
+func (s *Server) newFileIDLocked() string {
+	for {
+		s.nextID++
+		id := fmt.Sprintf("fake-file-%d", s.nextID)
+		if _, ok := s.files[id]; !ok {
+			return id
+		}
+	}
+}
+
+// FileContent returns the binary content of a file
+func (s *Server) FileContent(fileID string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, ok := s.contents[fileID]
+	return content, ok
+}
+
+func (s *Server) handleCopyFile(w http.ResponseWriter, r *http.Request) {
+	var copied drive.File
+	if err := decodeJSON(r, &copied); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID := r.PathValue("fileId")
+	source, ok := s.files[fileID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "file %s not found", fileID)
+		return
+	}
+
+	file := &drive.File{
+		Id:       s.newFileIDLocked(),
+		Name:     cmp.Or(copied.Name, "Copy of "+source.Name),
+		MimeType: cmp.Or(copied.MimeType, source.MimeType),
+		Parents:  source.Parents,
+	}
+	if len(copied.Parents) > 0 {
+		file.Parents = copied.Parents
+	}
+	s.addFileLocked(file)
+
+	// A copy keeping its type keeps its content; an Office import becomes an empty Workspace file
+	if file.MimeType == source.MimeType {
+		if content, ok := s.contents[fileID]; ok {
+			s.contents[file.Id] = content
+		}
+	} else if file.MimeType == "application/vnd.google-apps.document" {
+		s.documents[file.Id] = newDocument(file.Id, file.Name, "")
+	}
+
+	writeJSON(w, file)
+}
+
+func (s *Server) handleExportFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID := r.PathValue("fileId")
+	file, ok := s.files[fileID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "file %s not found", fileID)
+		return
+	}
+	if !strings.HasPrefix(file.MimeType, "application/vnd.google-apps.") {
+		writeError(w, http.StatusForbidden, "only Google Workspace files can be exported")
+		return
+	}
+
+	// The fake exports a document's text regardless of the requested format
+	var text string
+	if doc, ok := s.documents[fileID]; ok {
+		text = documentText(doc)
+	}
+	w.Header().Set("Content-Type", r.URL.Query().Get("mimeType"))
+	_, _ = io.WriteString(w, text)
+}
+
+func (s *Server) handleUploadFile(w http.ResponseWriter, r *http.Request) {
+	if uploadType := r.URL.Query().Get("uploadType"); uploadType != "multipart" {
+		writeError(w, http.StatusBadRequest, "unsupported uploadType: %s", uploadType)
+		return
+	}
+
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid Content-Type: %v", err)
+		return
+	}
+	reader := multipart.NewReader(r.Body, params["boundary"])
+
+	// The first part holds the metadata and the second the media
+	var file drive.File
+	part, err := reader.NextPart()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "missing metadata part: %v", err)
+		return
+	}
+	if err := json.NewDecoder(part).Decode(&file); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid metadata: %v", err)
+		return
+	}
+	part, err = reader.NextPart()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "missing media part: %v", err)
+		return
+	}
+	content, err := io.ReadAll(part)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid media: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file.Id = s.newFileIDLocked()
+	if file.MimeType == "" {
+		file.MimeType = part.Header.Get("Content-Type")
+	}
+	setChecksums(&file, content)
+	s.addFileLocked(&file)
+	s.contents[file.Id] = content
+
+	writeJSON(w, &file)
+}
+
+// handleUploadFileContent replaces the content of a file, leaving its metadata as it is
+func (s *Server) handleUploadFileContent(w http.ResponseWriter, r *http.Request) {
+	if uploadType := r.URL.Query().Get("uploadType"); uploadType != "multipart" {
+		writeError(w, http.StatusBadRequest, "unsupported uploadType: %s", uploadType)
+		return
+	}
+
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid Content-Type: %v", err)
+		return
+	}
+	reader := multipart.NewReader(r.Body, params["boundary"])
+
+	// The first part holds the metadata, which the fake ignores, and the second the media
+	if _, err := reader.NextPart(); err != nil {
+		writeError(w, http.StatusBadRequest, "missing metadata part: %v", err)
+		return
+	}
+	part, err := reader.NextPart()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "missing media part: %v", err)
+		return
+	}
+	content, err := io.ReadAll(part)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid media: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID := r.PathValue("fileId")
+	file, ok := s.files[fileID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "file %s not found", fileID)
+		return
+	}
+	setChecksums(file, content)
+	s.contents[fileID] = content
+	s.touchLocked(fileID)
+
+	writeJSON(w, file)
+}
+
+func (s *Server) handleCreateFile(w http.ResponseWriter, r *http.Request) {
+	var file drive.File
+	if err := decodeJSON(r, &file); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid file: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file.Id = s.newFileIDLocked()
+	// Drive fills in the type of a shortcut's target
+	if details := file.ShortcutDetails; details != nil {
+		if target, ok := s.files[details.TargetId]; ok {
+			details.TargetMimeType = target.MimeType
+		}
+	}
+	s.addFileLocked(&file)
+
+	writeJSON(w, &file)
+}
+
+func (s *Server) handleDeleteFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID := r.PathValue("fileId")
+	if _, ok := s.files[fileID]; !ok {
+		writeError(w, http.StatusNotFound, "file %s not found", fileID)
+		return
+	}
+	delete(s.files, fileID)
+	delete(s.contents, fileID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
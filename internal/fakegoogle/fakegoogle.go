@@ -0,0 +1,151 @@
+// Package fakegoogle provides an in-memory fake of the subset of the Google Drive, Docs, Slides,
+// and Sheets APIs used by drive-mcp, so tool behavior can be exercised without real credentials.
+package fakegoogle
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/driveactivity/v2"
+	"google.golang.org/api/drivelabels/v2"
+	"google.golang.org/api/slides/v1"
+)
+
+// Server is a fake Google API server backed by in-memory state
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	about     *drive.About
+	files     map[string]*drive.File
+	fileOrder []string
+	contents  map[string][]byte
+	revisions map[string][]*drive.Revision
+	// revisionContents holds the content of binary file revisions by file ID and revision ID
+	revisionContents map[[2]string][]byte
+	comments         map[string][]*drive.Comment
+	activities       []*driveactivity.DriveActivity
+	labels           []*drivelabels.GoogleAppsDriveLabelsV2Label
+	// fileLabels holds the labels applied to each file by label ID
+	fileLabels    map[string]map[string]*drive.Label
+	documents     map[string]*docs.Document
+	presentations map[string]*slides.Presentation
+	values        map[string]map[string][][]interface{}
+	nextID        int
+}
+
+// NewServer starts a new fake Google API server. Call Close when done.
+func NewServer() *Server {
+	s := &Server{
+		files:            make(map[string]*drive.File),
+		contents:         make(map[string][]byte),
+		revisions:        make(map[string][]*drive.Revision),
+		revisionContents: make(map[[2]string][]byte),
+		comments:         make(map[string][]*drive.Comment),
+		fileLabels:       make(map[string]map[string]*drive.Label),
+		documents:        make(map[string]*docs.Document),
+		presentations:    make(map[string]*slides.Presentation),
+		values:           make(map[string]map[string][][]interface{}),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /drive/v3/about", s.handleGetAbout)
+	mux.HandleFunc("GET /drive/v3/files", s.handleListFiles)
+	mux.HandleFunc("POST /drive/v3/files", s.handleCreateFile)
+	mux.HandleFunc("GET /drive/v3/files/{fileId}", s.handleGetFile)
+	mux.HandleFunc("PATCH /drive/v3/files/{fileId}", s.handleUpdateFile)
+	mux.HandleFunc("DELETE /drive/v3/files/{fileId}", s.handleDeleteFile)
+	mux.HandleFunc("POST /drive/v3/files/{fileId}/copy", s.handleCopyFile)
+	mux.HandleFunc("GET /drive/v3/files/{fileId}/export", s.handleExportFile)
+	mux.HandleFunc("GET /drive/v3/files/{fileId}/permissions", s.handleListPermissions)
+	mux.HandleFunc("POST /drive/v3/files/{fileId}/permissions", s.handleCreatePermission)
+	mux.HandleFunc("PATCH /drive/v3/files/{fileId}/permissions/{permissionId}", s.handleUpdatePermission)
+	mux.HandleFunc("GET /drive/v3/files/{fileId}/revisions", s.handleListRevisions)
+	mux.HandleFunc("GET /drive/v3/files/{fileId}/revisions/{revisionId}", s.handleGetRevision)
+	mux.HandleFunc("GET /drive/v3/files/{fileId}/comments", s.handleListComments)
+	mux.HandleFunc("POST /drive/v3/files/{fileId}/comments", s.handleCreateComment)
+	mux.HandleFunc("POST /drive/v3/files/{fileId}/comments/{commentId}/replies", s.handleCreateReply)
+	mux.HandleFunc("GET /drive/v3/files/{fileId}/listLabels", s.handleListFileLabels)
+	mux.HandleFunc("POST /drive/v3/files/{fileId}/modifyLabels", s.handleModifyFileLabels)
+	mux.HandleFunc("POST /upload/drive/v3/files", s.handleUploadFile)
+	mux.HandleFunc("PATCH /upload/drive/v3/files/{fileId}", s.handleUploadFileContent)
+	mux.HandleFunc("GET /v1/documents/{documentId}", s.handleGetDocument)
+	mux.HandleFunc("POST /v1/documents/{documentId}", s.handleBatchUpdateDocument)
+	mux.HandleFunc("GET /v1/presentations/{presentationId}", s.handleGetPresentation)
+	mux.HandleFunc("POST /v1/presentations/{presentationId}", s.handleBatchUpdatePresentation)
+	mux.HandleFunc("POST /v2/activity:query", s.handleQueryActivity)
+	mux.HandleFunc("GET /v2/labels", s.handleListLabels)
+	mux.HandleFunc("GET /v2/labels/{labelId}", s.handleGetLabel)
+	mux.HandleFunc("GET /v4/spreadsheets/{spreadsheetId}/values/{range}", s.handleGetValues)
+	mux.HandleFunc("PUT /v4/spreadsheets/{spreadsheetId}/values/{range}", s.handleUpdateValues)
+
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// Endpoint returns the base URL to pass to drive-mcp's WithEndpoint option
+func (s *Server) Endpoint() string {
+	return s.URL + "/"
+}
+
+// AddFile registers Drive file metadata
+func (s *Server) AddFile(file *drive.File) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.addFileLocked(file)
+}
+
+func (s *Server) addFileLocked(file *drive.File) {
+	existing, ok := s.files[file.Id]
+	if !ok {
+		s.fileOrder = append(s.fileOrder, file.Id)
+	}
+	if file.Version == 0 {
+		file.Version = 1
+		if ok {
+			file.Version = existing.Version + 1
+		}
+	}
+	s.files[file.Id] = file
+}
+
+// touchLocked records a modification of the file, as Drive does for every content change
+func (s *Server) touchLocked(fileID string) {
+	file, ok := s.files[fileID]
+	if !ok {
+		return
+	}
+	file.Version++
+	file.ModifiedTime = time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+// writeJSON writes v as a JSON response
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes a Google API style error response
+func writeError(w http.ResponseWriter, code int, format string, args ...any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{
+			"code":    code,
+			"message": fmt.Sprintf(format, args...),
+		},
+	})
+}
+
+// decodeJSON decodes a JSON request body into v
+func decodeJSON(r *http.Request, v any) error {
+	defer r.Body.Close()
+	return json.NewDecoder(r.Body).Decode(v)
+}
@@ -0,0 +1,129 @@
+package fakegoogle
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/drivelabels/v2"
+)
+
+// AddLabel registers a published Drive label; labels are listed in the order they are added
+func (s *Server) AddLabel(label *drivelabels.GoogleAppsDriveLabelsV2Label) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.labels = append(s.labels, label)
+}
+
+// ApplyLabel applies a label with its field values to a file
+func (s *Server) ApplyLabel(fileID string, label *drive.Label) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fileLabels[fileID] == nil {
+		s.fileLabels[fileID] = make(map[string]*drive.Label)
+	}
+	s.fileLabels[fileID][label.Id] = label
+}
+
+func (s *Server) handleListLabels(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := &drivelabels.GoogleAppsDriveLabelsV2ListLabelsResponse{Labels: s.labels}
+	if list.Labels == nil {
+		list.Labels = []*drivelabels.GoogleAppsDriveLabelsV2Label{}
+	}
+	writeJSON(w, list)
+}
+
+func (s *Server) handleGetLabel(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The fake keeps only published revisions
+	labelID, _, _ := strings.Cut(r.PathValue("labelId"), "@")
+	for _, label := range s.labels {
+		if label.Id == labelID {
+			writeJSON(w, label)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "label %s not found", labelID)
+}
+
+func (s *Server) handleListFileLabels(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID := r.PathValue("fileId")
+	if _, ok := s.files[fileID]; !ok {
+		writeError(w, http.StatusNotFound, "file %s not found", fileID)
+		return
+	}
+
+	list := &drive.LabelList{Labels: []*drive.Label{}}
+	for _, label := range s.fileLabels[fileID] {
+		list.Labels = append(list.Labels, label)
+	}
+	sort.Slice(list.Labels, func(i, j int) bool { return list.Labels[i].Id < list.Labels[j].Id })
+	writeJSON(w, list)
+}
+
+func (s *Server) handleModifyFileLabels(w http.ResponseWriter, r *http.Request) {
+	var request drive.ModifyLabelsRequest
+	if err := decodeJSON(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID := r.PathValue("fileId")
+	if _, ok := s.files[fileID]; !ok {
+		writeError(w, http.StatusNotFound, "file %s not found", fileID)
+		return
+	}
+	if s.fileLabels[fileID] == nil {
+		s.fileLabels[fileID] = make(map[string]*drive.Label)
+	}
+
+	response := &drive.ModifyLabelsResponse{}
+	for _, modification := range request.LabelModifications {
+		if modification.RemoveLabel {
+			delete(s.fileLabels[fileID], modification.LabelId)
+			continue
+		}
+		label, ok := s.fileLabels[fileID][modification.LabelId]
+		if !ok {
+			label = &drive.Label{Id: modification.LabelId, Fields: make(map[string]drive.LabelField)}
+			s.fileLabels[fileID][modification.LabelId] = label
+		}
+		for _, field := range modification.FieldModifications {
+			if field.UnsetValues {
+				delete(label.Fields, field.FieldId)
+				continue
+			}
+			value := drive.LabelField{Id: field.FieldId}
+			switch {
+			case field.SetTextValues != nil:
+				value.ValueType, value.Text = "text", field.SetTextValues
+			case field.SetIntegerValues != nil:
+				value.ValueType, value.Integer = "integer", field.SetIntegerValues
+			case field.SetDateValues != nil:
+				value.ValueType, value.DateString = "dateString", field.SetDateValues
+			case field.SetSelectionValues != nil:
+				value.ValueType, value.Selection = "selection", field.SetSelectionValues
+			case field.SetUserValues != nil:
+				value.ValueType = "user"
+				for _, email := range field.SetUserValues {
+					value.User = append(value.User, &drive.User{EmailAddress: email})
+				}
+			}
+			label.Fields[field.FieldId] = value
+		}
+		response.ModifiedLabels = append(response.ModifiedLabels, label)
+	}
+	writeJSON(w, response)
+}
@@ -0,0 +1,83 @@
+package fakegoogle
+
+import (
+	"fmt"
+	"net/http"
+
+	"google.golang.org/api/drive/v3"
+)
+
+func (s *Server) handleListPermissions(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID := r.PathValue("fileId")
+	file, ok := s.files[fileID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "file %s not found", fileID)
+		return
+	}
+
+	list := &drive.PermissionList{Permissions: file.Permissions}
+	if list.Permissions == nil {
+		list.Permissions = []*drive.Permission{}
+	}
+	writeJSON(w, list)
+}
+
+func (s *Server) handleCreatePermission(w http.ResponseWriter, r *http.Request) {
+	var permission drive.Permission
+	if err := decodeJSON(r, &permission); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid permission: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID := r.PathValue("fileId")
+	file, ok := s.files[fileID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "file %s not found", fileID)
+		return
+	}
+
+	// Drive gives link sharing a fixed ID, and other permissions the ID of their account
+	permission.Id = "anyoneWithLink"
+	if permission.Type != "anyone" {
+		permission.Id = fmt.Sprintf("fake-permission-%d", len(file.Permissions)+1)
+	}
+	file.Permissions = append(file.Permissions, &permission)
+	file.Shared = true
+
+	writeJSON(w, &permission)
+}
+
+func (s *Server) handleUpdatePermission(w http.ResponseWriter, r *http.Request) {
+	var patch drive.Permission
+	if err := decodeJSON(r, &patch); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid permission: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID, permissionID := r.PathValue("fileId"), r.PathValue("permissionId")
+	file, ok := s.files[fileID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "file %s not found", fileID)
+		return
+	}
+	for _, permission := range file.Permissions {
+		if permission.Id == permissionID {
+			// Only the role can be changed
+			if patch.Role != "" {
+				permission.Role = patch.Role
+			}
+			writeJSON(w, permission)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "permission %s not found", permissionID)
+}
@@ -0,0 +1,64 @@
+package fakegoogle
+
+import (
+	"net/http"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// AddRevision registers a past revision of a file; revisions are listed in the order they are added
+func (s *Server) AddRevision(fileID string, revision *drive.Revision) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.revisions[fileID] = append(s.revisions[fileID], revision)
+}
+
+func (s *Server) handleListRevisions(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID := r.PathValue("fileId")
+	if _, ok := s.files[fileID]; !ok {
+		writeError(w, http.StatusNotFound, "file %s not found", fileID)
+		return
+	}
+
+	list := &drive.RevisionList{Revisions: s.revisions[fileID]}
+	if list.Revisions == nil {
+		list.Revisions = []*drive.Revision{}
+	}
+	writeJSON(w, list)
+}
+
+// AddRevisionContent registers a past revision of a binary file with its content
+func (s *Server) AddRevisionContent(fileID string, revision *drive.Revision, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.revisions[fileID] = append(s.revisions[fileID], revision)
+	s.revisionContents[[2]string{fileID, revision.Id}] = content
+}
+
+func (s *Server) handleGetRevision(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fileID, revisionID := r.PathValue("fileId"), r.PathValue("revisionId")
+	for _, revision := range s.revisions[fileID] {
+		if revision.Id != revisionID {
+			continue
+		}
+		if r.URL.Query().Get("alt") == "media" {
+			content, ok := s.revisionContents[[2]string{fileID, revisionID}]
+			if !ok {
+				writeError(w, http.StatusForbidden, "only revisions of binary files can be downloaded")
+				return
+			}
+			w.Header().Set("Content-Type", revision.MimeType)
+			_, _ = w.Write(content)
+			return
+		}
+		writeJSON(w, revision)
+		return
+	}
+	writeError(w, http.StatusNotFound, "revision %s of file %s not found", revisionID, fileID)
+}
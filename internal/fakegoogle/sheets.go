@@ -0,0 +1,73 @@
+package fakegoogle
+
+import (
+	"net/http"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// SetValues stores the values returned for a spreadsheet range.
+// Ranges are matched exactly as requested; no A1 notation arithmetic is performed.
+func (s *Server) SetValues(spreadsheetID, rangeName string, values [][]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.setValuesLocked(spreadsheetID, rangeName, values)
+}
+
+func (s *Server) setValuesLocked(spreadsheetID, rangeName string, values [][]interface{}) {
+	if s.values[spreadsheetID] == nil {
+		s.values[spreadsheetID] = make(map[string][][]interface{})
+	}
+	s.values[spreadsheetID][rangeName] = values
+}
+
+// Values returns the values stored for a spreadsheet range
+func (s *Server) Values(spreadsheetID, rangeName string) ([][]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	values, ok := s.values[spreadsheetID][rangeName]
+	return values, ok
+}
+
+func (s *Server) handleGetValues(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	spreadsheetID := r.PathValue("spreadsheetId")
+	rangeName := r.PathValue("range")
+	ranges, ok := s.values[spreadsheetID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "spreadsheet %s not found", spreadsheetID)
+		return
+	}
+
+	writeJSON(w, &sheets.ValueRange{
+		Range:          rangeName,
+		MajorDimension: "ROWS",
+		Values:         ranges[rangeName],
+	})
+}
+
+func (s *Server) handleUpdateValues(w http.ResponseWriter, r *http.Request) {
+	var valueRange sheets.ValueRange
+	if err := decodeJSON(r, &valueRange); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	spreadsheetID := r.PathValue("spreadsheetId")
+	rangeName := r.PathValue("range")
+	s.setValuesLocked(spreadsheetID, rangeName, valueRange.Values)
+	s.touchLocked(spreadsheetID)
+
+	writeJSON(w, &sheets.UpdateValuesResponse{
+		SpreadsheetId: spreadsheetID,
+		UpdatedRange:  rangeName,
+		UpdatedRows:   int64(len(valueRange.Values)),
+	})
+}
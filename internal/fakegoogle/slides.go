@@ -0,0 +1,110 @@
+package fakegoogle
+
+import (
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+)
+
+// AddPresentation registers a Google Slides presentation
+func (s *Server) AddPresentation(presentation *slides.Presentation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.addFileLocked(&drive.File{
+		Id:       presentation.PresentationId,
+		Name:     presentation.Title,
+		MimeType: "application/vnd.google-apps.presentation",
+	})
+	s.presentations[presentation.PresentationId] = presentation
+}
+
+// Presentation returns the current state of a registered presentation
+func (s *Server) Presentation(presentationID string) (*slides.Presentation, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	presentation, ok := s.presentations[presentationID]
+	return presentation, ok
+}
+
+// findShape returns the shape with the given object ID
+func findShape(presentation *slides.Presentation, objectID string) *slides.Shape {
+	for _, slide := range presentation.Slides {
+		for _, element := range slide.PageElements {
+			if element.ObjectId == objectID && element.Shape != nil {
+				return element.Shape
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) handleGetPresentation(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	presentationID := r.PathValue("presentationId")
+	presentation, ok := s.presentations[presentationID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "presentation %s not found", presentationID)
+		return
+	}
+
+	writeJSON(w, presentation)
+}
+
+func (s *Server) handleBatchUpdatePresentation(w http.ResponseWriter, r *http.Request) {
+	presentationID, ok := strings.CutSuffix(r.PathValue("presentationId"), ":batchUpdate")
+	if !ok {
+		writeError(w, http.StatusNotFound, "unsupported method")
+		return
+	}
+
+	var req slides.BatchUpdatePresentationRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request: %v", err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	presentation, ok := s.presentations[presentationID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "presentation %s not found", presentationID)
+		return
+	}
+
+	for _, request := range req.Requests {
+		switch {
+		case request.DeleteText != nil:
+			shape := findShape(presentation, request.DeleteText.ObjectId)
+			if shape == nil {
+				writeError(w, http.StatusBadRequest, "shape %s not found", request.DeleteText.ObjectId)
+				return
+			}
+			shape.Text = &slides.TextContent{}
+		case request.InsertText != nil:
+			shape := findShape(presentation, request.InsertText.ObjectId)
+			if shape == nil {
+				writeError(w, http.StatusBadRequest, "shape %s not found", request.InsertText.ObjectId)
+				return
+			}
+			if shape.Text == nil {
+				shape.Text = &slides.TextContent{}
+			}
+			shape.Text.TextElements = append([]*slides.TextElement{
+				{TextRun: &slides.TextRun{Content: request.InsertText.Text}},
+			}, shape.Text.TextElements...)
+		default:
+			writeError(w, http.StatusBadRequest, "unsupported request")
+			return
+		}
+	}
+
+	s.touchLocked(presentationID)
+	writeJSON(w, &slides.BatchUpdatePresentationResponse{PresentationId: presentationID})
+}
@@ -0,0 +1,322 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/api/driveactivity/v2"
+)
+
+// DefaultActivityPageSize is the number of activities GetFileActivity returns per page when ListOptions sets no
+// page size
+const DefaultActivityPageSize = 50
+
+// activityActions maps the action names GetFileActivity accepts and reports to the Drive Activity API's
+// action detail cases
+var activityActions = map[string]string{
+	"create":             "CREATE",
+	"edit":               "EDIT",
+	"move":               "MOVE",
+	"rename":             "RENAME",
+	"delete":             "DELETE",
+	"restore":            "RESTORE",
+	"permissionChange":   "PERMISSION_CHANGE",
+	"comment":            "COMMENT",
+	"dlpChange":          "DLP_CHANGE",
+	"reference":          "REFERENCE",
+	"settingsChange":     "SETTINGS_CHANGE",
+	"appliedLabelChange": "APPLIED_LABEL_CHANGE",
+}
+
+// ActivityQuery selects the activities GetFileActivity reports
+type ActivityQuery struct {
+	// IncludeContents also reports activity on the items anywhere inside a folder
+	IncludeContents bool
+	// Actions limits the results to these actions, e.g. "edit" or "permissionChange"; empty reports all
+	Actions []string
+	// Since limits the results to activity at or after this time; zero means no limit
+	Since time.Time
+}
+
+// Activity is one event in the history of a file or folder
+type Activity struct {
+	// Time is when the activity happened, or when it ended for activity spanning a time range
+	Time string `json:"time"`
+	// Action is the main action, e.g. "edit", "comment", "move", or "permissionChange"
+	Action string `json:"action"`
+	// Detail describes the action, e.g. the old and new names of a rename
+	Detail  string           `json:"detail,omitempty"`
+	Actors  []ActivityActor  `json:"actors"`
+	Targets []ActivityTarget `json:"targets"`
+}
+
+// ActivityActor is who performed an activity
+type ActivityActor struct {
+	// Type is "user", "deletedUser", "unknownUser", "anonymous", "administrator", "system", or "impersonation"
+	Type string `json:"type"`
+	// PersonName identifies a known user as "people/ID"
+	PersonName    string `json:"personName,omitempty"`
+	IsCurrentUser bool   `json:"isCurrentUser,omitempty"`
+	// EmailAddress and DisplayName are filled in for users with access to the file
+	EmailAddress string `json:"emailAddress,omitempty"`
+	DisplayName  string `json:"displayName,omitempty"`
+}
+
+// ActivityTarget is the file or folder an activity was performed on
+type ActivityTarget struct {
+	FileID   string `json:"fileId,omitempty"`
+	Title    string `json:"title"`
+	MimeType string `json:"mimeType,omitempty"`
+}
+
+// ActivityList is a page of activities, newest first
+type ActivityList struct {
+	Activities []Activity `json:"activities"`
+	// NextPageToken fetches the next page; empty on the last page
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// GetFileActivity returns the history of a file or folder from the Drive Activity API, newest first: edits,
+// comments, moves, renames, permission changes, and more, with who performed them and when. Actors are identified
+// by person name; those with access to the file also get their email address and display name. opts.OrderBy and
+// opts.Fields are not supported. For a shortcut, the activity of its target is reported.
+func (ds *DriveService) GetFileActivity(ctx context.Context, fileID string, query ActivityQuery, opts ListOptions) (*ActivityList, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if opts.OrderBy != "" || len(opts.Fields) > 0 {
+		return nil, errors.New("orderBy and fields are not supported for activity")
+	}
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	var filters []string
+	if !query.Since.IsZero() {
+		filters = append(filters, fmt.Sprintf("time >= %q", query.Since.UTC().Format(time.RFC3339)))
+	}
+	if len(query.Actions) > 0 {
+		cases := make([]string, 0, len(query.Actions))
+		for _, action := range query.Actions {
+			detailCase, ok := activityActions[action]
+			if !ok {
+				return nil, fmt.Errorf("unsupported action %q", action)
+			}
+			cases = append(cases, detailCase)
+		}
+		filters = append(filters, "detail.action_detail_case:("+strings.Join(cases, " ")+")")
+	}
+
+	request := &driveactivity.QueryDriveActivityRequest{
+		Filter:    strings.Join(filters, " AND "),
+		PageSize:  int64(opts.pageSize(DefaultActivityPageSize)),
+		PageToken: opts.PageToken,
+	}
+	if query.IncludeContents {
+		request.AncestorName = "items/" + fileID
+	} else {
+		request.ItemName = "items/" + fileID
+	}
+	r, err := ds.activityService.Activity.Query(request).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query activity: %w", err)
+	}
+
+	list := &ActivityList{Activities: make([]Activity, 0, len(r.Activities)), NextPageToken: r.NextPageToken}
+	for _, activity := range r.Activities {
+		list.Activities = append(list.Activities, newActivity(activity))
+	}
+
+	// A user's permission ID is their person ID, so the file's permissions name the actors who can access it.
+	// Listing them may need more access than reading the activity does, so actors stay unnamed if it fails.
+	if permissions, err := ds.listPermissions(ctx, fileID); err == nil {
+		users := make(map[string]Permission, len(permissions))
+		for _, permission := range permissions {
+			if permission.Type == "user" {
+				users["people/"+permission.ID] = permission
+			}
+		}
+		for _, activity := range list.Activities {
+			for i, actor := range activity.Actors {
+				if user, ok := users[actor.PersonName]; ok {
+					activity.Actors[i].EmailAddress = user.EmailAddress
+					activity.Actors[i].DisplayName = user.DisplayName
+				}
+			}
+		}
+	}
+
+	return list, nil
+}
+
+// newActivity converts a Drive Activity API activity
+func newActivity(a *driveactivity.DriveActivity) Activity {
+	activity := Activity{
+		Time:    a.Timestamp,
+		Actors:  make([]ActivityActor, 0, len(a.Actors)),
+		Targets: make([]ActivityTarget, 0, len(a.Targets)),
+	}
+	if activity.Time == "" && a.TimeRange != nil {
+		activity.Time = a.TimeRange.EndTime
+	}
+	activity.Action, activity.Detail = describeAction(a.PrimaryActionDetail)
+	for _, actor := range a.Actors {
+		activity.Actors = append(activity.Actors, newActivityActor(actor))
+	}
+	for _, target := range a.Targets {
+		switch {
+		case target.DriveItem != nil:
+			activity.Targets = append(activity.Targets, ActivityTarget{
+				FileID:   strings.TrimPrefix(target.DriveItem.Name, "items/"),
+				Title:    target.DriveItem.Title,
+				MimeType: target.DriveItem.MimeType,
+			})
+		case target.FileComment != nil && target.FileComment.Parent != nil:
+			activity.Targets = append(activity.Targets, ActivityTarget{
+				FileID:   strings.TrimPrefix(target.FileComment.Parent.Name, "items/"),
+				Title:    target.FileComment.Parent.Title,
+				MimeType: target.FileComment.Parent.MimeType,
+			})
+		case target.Drive != nil:
+			activity.Targets = append(activity.Targets, ActivityTarget{Title: target.Drive.Title})
+		}
+	}
+	return activity
+}
+
+// newActivityActor converts a Drive Activity API actor
+func newActivityActor(a *driveactivity.Actor) ActivityActor {
+	switch {
+	case a.User != nil:
+		return newActivityUser(a.User)
+	case a.Anonymous != nil:
+		return ActivityActor{Type: "anonymous"}
+	case a.Administrator != nil:
+		return ActivityActor{Type: "administrator"}
+	case a.System != nil:
+		return ActivityActor{Type: "system"}
+	case a.Impersonation != nil && a.Impersonation.ImpersonatedUser != nil:
+		actor := newActivityUser(a.Impersonation.ImpersonatedUser)
+		actor.Type = "impersonation"
+		return actor
+	}
+	return ActivityActor{Type: "unknownUser"}
+}
+
+// newActivityUser converts a Drive Activity API user
+func newActivityUser(u *driveactivity.User) ActivityActor {
+	switch {
+	case u.KnownUser != nil:
+		return ActivityActor{Type: "user", PersonName: u.KnownUser.PersonName, IsCurrentUser: u.KnownUser.IsCurrentUser}
+	case u.DeletedUser != nil:
+		return ActivityActor{Type: "deletedUser"}
+	}
+	return ActivityActor{Type: "unknownUser"}
+}
+
+// describeAction returns the name of an action, as in activityActions, and a short description of it
+func describeAction(d *driveactivity.ActionDetail) (string, string) {
+	switch {
+	case d == nil:
+		return "", ""
+	case d.Create != nil:
+		switch {
+		case d.Create.Upload != nil:
+			return "create", "uploaded"
+		case d.Create.Copy != nil && d.Create.Copy.OriginalObject != nil:
+			return "create", "copied from " + referenceTitle(d.Create.Copy.OriginalObject)
+		}
+		return "create", ""
+	case d.Edit != nil:
+		return "edit", ""
+	case d.Move != nil:
+		var parts []string
+		if added := referenceTitles(d.Move.AddedParents); added != "" {
+			parts = append(parts, "moved to "+added)
+		}
+		if removed := referenceTitles(d.Move.RemovedParents); removed != "" {
+			parts = append(parts, "removed from "+removed)
+		}
+		return "move", strings.Join(parts, ", ")
+	case d.Rename != nil:
+		return "rename", fmt.Sprintf("renamed from %q to %q", d.Rename.OldTitle, d.Rename.NewTitle)
+	case d.Delete != nil:
+		if d.Delete.Type == "PERMANENT_DELETE" {
+			return "delete", "permanently deleted"
+		}
+		return "delete", "moved to trash"
+	case d.Restore != nil:
+		return "restore", "restored from trash"
+	case d.PermissionChange != nil:
+		var parts []string
+		for _, p := range d.PermissionChange.AddedPermissions {
+			parts = append(parts, "added "+describePermission(p))
+		}
+		for _, p := range d.PermissionChange.RemovedPermissions {
+			parts = append(parts, "removed "+describePermission(p))
+		}
+		return "permissionChange", strings.Join(parts, ", ")
+	case d.Comment != nil:
+		switch {
+		case d.Comment.Post != nil:
+			return "comment", strings.ToLower(d.Comment.Post.Subtype)
+		case d.Comment.Assignment != nil:
+			return "comment", "assignment " + strings.ToLower(d.Comment.Assignment.Subtype)
+		case d.Comment.Suggestion != nil:
+			return "comment", "suggestion " + strings.ToLower(d.Comment.Suggestion.Subtype)
+		}
+		return "comment", ""
+	case d.DlpChange != nil:
+		return "dlpChange", strings.ToLower(d.DlpChange.Type)
+	case d.Reference != nil:
+		return "reference", strings.ToLower(d.Reference.Type)
+	case d.SettingsChange != nil:
+		return "settingsChange", ""
+	case d.AppliedLabelChange != nil:
+		return "appliedLabelChange", ""
+	}
+	return "", ""
+}
+
+// describePermission describes a permission in a permission change, e.g. "writer for group team@example.com"
+func describePermission(p *driveactivity.Permission) string {
+	role := strings.ToLower(p.Role)
+	switch {
+	case p.User != nil:
+		if p.User.KnownUser != nil {
+			return role + " for user " + p.User.KnownUser.PersonName
+		}
+		return role + " for a user"
+	case p.Group != nil:
+		return role + " for group " + p.Group.Email
+	case p.Domain != nil:
+		return role + " for domain " + p.Domain.Name
+	case p.Anyone != nil:
+		return role + " for anyone with the link"
+	}
+	return role
+}
+
+// referenceTitle returns the title of a referenced item or drive
+func referenceTitle(r *driveactivity.TargetReference) string {
+	switch {
+	case r.DriveItem != nil:
+		return fmt.Sprintf("%q", r.DriveItem.Title)
+	case r.Drive != nil:
+		return fmt.Sprintf("%q", r.Drive.Title)
+	}
+	return "an unknown item"
+}
+
+// referenceTitles joins the titles of referenced items or drives
+func referenceTitles(references []*driveactivity.TargetReference) string {
+	titles := make([]string, 0, len(references))
+	for _, r := range references {
+		titles = append(titles, referenceTitle(r))
+	}
+	return strings.Join(titles, ", ")
+}
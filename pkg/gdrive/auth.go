@@ -0,0 +1,140 @@
+package gdrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/option"
+	"google.golang.org/api/transport"
+	htransport "google.golang.org/api/transport/http"
+)
+
+// WithCredentialsLoader looks up credentials JSON with load when the services are first used and again on
+// every ReloadCredentials, e.g. to re-read a credentials file replaced after the server started. load returning
+// nil JSON falls back to gcloud application-default credentials. It takes precedence over WithCredentialsJSON.
+func WithCredentialsLoader(load func() ([]byte, error)) Option {
+	return func(ds *DriveService) {
+		ds.credentialsLoader = load
+	}
+}
+
+// authTransport authenticates requests with credentials looked up on first use, so the server can start before
+// credentials are available, and looked up again after reset
+type authTransport struct {
+	ds *DriveService
+
+	mu          sync.Mutex
+	base        http.RoundTripper
+	tokenSource oauth2.TokenSource
+}
+
+// current returns the authenticated transport and its token source, looking up credentials if needed.
+// A failed lookup is not remembered, so credentials provided later are picked up by the next request.
+func (t *authTransport) current(ctx context.Context) (http.RoundTripper, oauth2.TokenSource, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.base == nil {
+		base, tokenSource, err := t.ds.newTransport(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		t.base, t.tokenSource = base, tokenSource
+	}
+	return t.base, t.tokenSource, nil
+}
+
+// reset drops the credentials, so they are looked up again on the next request
+func (t *authTransport) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.base, t.tokenSource = nil, nil
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base, _, err := t.current(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	return base.RoundTrip(req)
+}
+
+// newTransport looks up credentials and returns an authenticated transport and its token source.
+// A custom HTTP client's transport is used as is, since it handles authentication itself.
+func (ds *DriveService) newTransport(ctx context.Context) (http.RoundTripper, oauth2.TokenSource, error) {
+	if ds.httpClient != nil {
+		if ds.httpClient.Transport == nil {
+			return http.DefaultTransport, nil, nil
+		}
+		return ds.httpClient.Transport, nil, nil
+	}
+
+	// Use gcloud application-default credentials unless credentials are given
+	credentialsJSON := ds.credentialsJSON
+	if ds.credentialsLoader != nil {
+		var err error
+		credentialsJSON, err = ds.credentialsLoader()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to find credentials: %w", err)
+		}
+	}
+	// The token source keeps the context for later refreshes, so it must not end with the request that
+	// happened to look up the credentials
+	ctx = context.WithoutCancel(ctx)
+	credentialOptions := []option.ClientOption{
+		option.WithScopes(requestedScopes...),
+	}
+	if credentialsJSON != nil {
+		credentialOptions = append(credentialOptions, option.WithCredentialsJSON(credentialsJSON))
+	}
+	creds, err := transport.Creds(ctx, credentialOptions...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find credentials: %w", err)
+	}
+
+	options := []option.ClientOption{option.WithCredentials(creds)}
+	// The client libraries' own spans record full URLs, which contain file IDs
+	if ds.tracer != nil {
+		options = append(options, option.WithTelemetryDisabled())
+	}
+	// Use quota project if set in environment variable
+	if quotaProject := os.Getenv("GOOGLE_CLOUD_QUOTA_PROJECT_ID"); quotaProject != "" {
+		options = append(options, option.WithQuotaProject(quotaProject))
+	}
+	base, err := htransport.NewTransport(ctx, http.DefaultTransport, options...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create transport: %w", err)
+	}
+	return base, creds.TokenSource, nil
+}
+
+// token returns an access token of the current credentials
+func (ds *DriveService) token(ctx context.Context) (*oauth2.Token, error) {
+	_, tokenSource, err := ds.auth.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if tokenSource == nil {
+		return nil, fmt.Errorf("credentials of a custom HTTP client cannot be inspected")
+	}
+	token, err := tokenSource.Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access token: %w", err)
+	}
+	return token, nil
+}
+
+// ReloadCredentials drops the current credentials and looks them up again, e.g. after signing in again with
+// gcloud or replacing a credentials file, so the server does not need to be restarted. It returns the account
+// the new credentials resolve to.
+func (ds *DriveService) ReloadCredentials(ctx context.Context) (*AccountInfo, error) {
+	ds.auth.reset()
+	if _, _, err := ds.auth.current(ctx); err != nil {
+		return nil, err
+	}
+	return ds.GetAccountInfo(ctx)
+}
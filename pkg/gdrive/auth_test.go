@@ -0,0 +1,43 @@
+package gdrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// TestCredentialsOutliveFirstContext checks that the token is still refreshed after the context of the request
+// that looked up the credentials is canceled, as happens when the first request is a short startup check
+func TestCredentialsOutliveFirstContext(t *testing.T) {
+	var refreshes atomic.Int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := refreshes.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		// Expire at once, so every token request refreshes
+		fmt.Fprintf(w, `{"access_token":"token%d","token_type":"Bearer","expires_in":1}`, n)
+	}))
+	defer tokenServer.Close()
+
+	credentialsJSON := fmt.Sprintf(`{"type":"authorized_user","client_id":"id","client_secret":"secret","refresh_token":"refresh","token_uri":%q}`, tokenServer.URL)
+	ds, err := NewDriveService(context.Background(), WithCredentialsJSON([]byte(credentialsJSON)))
+	if err != nil {
+		t.Fatalf("NewDriveService: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, err := ds.token(ctx); err != nil {
+		t.Fatalf("first token: %v", err)
+	}
+	cancel()
+
+	token, err := ds.token(context.Background())
+	if err != nil {
+		t.Fatalf("token after the first context was canceled: %v", err)
+	}
+	if token.AccessToken == "token1" || refreshes.Load() < 2 {
+		t.Errorf("token was not refreshed: got %s after %d refreshes", token.AccessToken, refreshes.Load())
+	}
+}
@@ -0,0 +1,146 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/api/googleapi"
+)
+
+// FileResult is the outcome of a per-file operation in a batch
+type FileResult struct {
+	ID    string     `json:"id"`
+	File  *DriveFile `json:"file,omitempty"`
+	Error string     `json:"error,omitempty"`
+}
+
+// GetFilesMetadata retrieves metadata for multiple files.
+// The Go client has no support for Drive's batch endpoint, so the calls are fanned out
+// concurrently, bounded by the configured parallelism. A failure for one file is reported
+// in its result instead of failing the whole call.
+func (ds *DriveService) GetFilesMetadata(ctx context.Context, fileIDs []string, extraFields []string) ([]FileResult, error) {
+	if len(fileIDs) == 0 {
+		return nil, errors.New("file IDs are empty")
+	}
+
+	fields, err := fileFields(extraFields)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]FileResult, len(fileIDs))
+	err = forEachConcurrent(ctx, ds.parallelism, len(fileIDs), func(ctx context.Context, i int) error {
+		results[i].ID = fileIDs[i]
+
+		if err := ds.checkScope(ctx, fileIDs[i]); err != nil {
+			results[i].Error = err.Error()
+			return nil
+		}
+
+		file, err := ds.driveService.Files.Get(fileIDs[i]).
+			Fields(googleapi.Field(fields)).
+			SupportsAllDrives(true).
+			Context(ctx).
+			Do()
+		if err != nil {
+			results[i].Error = fmt.Sprintf("failed to get file: %v", err)
+			return nil
+		}
+
+		driveFile, err := newDriveFile(file, extraFields)
+		if err != nil {
+			results[i].Error = err.Error()
+			return nil
+		}
+		results[i].File = &driveFile
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// MaxBatchFiles is the most files BatchFileOperation accepts in one call
+const MaxBatchFiles = 100
+
+// Operations BatchFileOperation can apply to files
+const (
+	BatchMove   = "move"
+	BatchTrash  = "trash"
+	BatchCopy   = "copy"
+	BatchStar   = "star"
+	BatchUnstar = "unstar"
+)
+
+// BatchOperation is the operation BatchFileOperation applies to every file
+type BatchOperation struct {
+	// Operation is one of the Batch constants
+	Operation string
+	// FolderID is the folder to move the files into, required for move, or to put the copies in, optional for copy
+	FolderID string
+}
+
+// BatchFileOperation moves, trashes, copies, stars, or unstars multiple files, as MoveFile, TrashFile, CopyFile, and
+// UpdateFileMetadata do for one. Like GetFilesMetadata, the calls are fanned out concurrently, bounded by the
+// configured parallelism, and a failure for one file is reported in its result instead of failing the whole call.
+// Results are in the order of fileIDs, each with the moved, trashed, copied, or updated file. When ctx is canceled
+// or times out partway, the results are still returned, so the files already changed are known; files whose
+// operation never started report the context's error.
+func (ds *DriveService) BatchFileOperation(ctx context.Context, fileIDs []string, op BatchOperation) ([]FileResult, error) {
+	if len(fileIDs) == 0 {
+		return nil, errors.New("file IDs are empty")
+	}
+	if len(fileIDs) > MaxBatchFiles {
+		return nil, fmt.Errorf("too many files: %d given, at most %d allowed per call", len(fileIDs), MaxBatchFiles)
+	}
+
+	var apply func(ctx context.Context, fileID string) (*DriveFile, error)
+	switch op.Operation {
+	case BatchMove:
+		if op.FolderID == "" {
+			return nil, errors.New("folder ID is empty")
+		}
+		apply = func(ctx context.Context, fileID string) (*DriveFile, error) {
+			return ds.MoveFile(ctx, fileID, op.FolderID, "")
+		}
+	case BatchTrash:
+		apply = ds.TrashFile
+	case BatchCopy:
+		apply = func(ctx context.Context, fileID string) (*DriveFile, error) {
+			return ds.CopyFile(ctx, fileID, "", op.FolderID, false)
+		}
+	case BatchStar, BatchUnstar:
+		starred := op.Operation == BatchStar
+		apply = func(ctx context.Context, fileID string) (*DriveFile, error) {
+			return ds.UpdateFileMetadata(ctx, fileID, FileMetadataUpdate{Starred: &starred})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported operation %q; use move, trash, copy, star, or unstar", op.Operation)
+	}
+
+	results := make([]FileResult, len(fileIDs))
+	started := make([]bool, len(fileIDs))
+	err := forEachConcurrent(ctx, ds.parallelism, len(fileIDs), func(ctx context.Context, i int) error {
+		started[i] = true
+
+		file, err := apply(ctx, fileIDs[i])
+		if err != nil {
+			results[i].Error = err.Error()
+			return nil
+		}
+		results[i].File = file
+		return nil
+	})
+
+	// Operations only fail per file, so err is the context's error, stopping the files not yet started
+	for i := range results {
+		results[i].ID = fileIDs[i]
+		if !started[i] && err != nil {
+			results[i].Error = fmt.Sprintf("not started: %v", err)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,132 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Defaults of WithCircuitBreaker
+const (
+	DefaultBreakerThreshold = 5
+	DefaultBreakerCooldown  = 30 * time.Second
+)
+
+// ErrAPIUnavailable is returned without calling an API while its circuit breaker is open
+var ErrAPIUnavailable = errors.New("temporarily unavailable")
+
+// apiDisplayNames are the names of the APIs used in error messages
+var apiDisplayNames = map[string]string{
+	APIDrive:    "Drive API",
+	APIDocs:     "Docs API",
+	APISlides:   "Slides API",
+	APISheets:   "Sheets API",
+	APILabels:   "Drive Labels API",
+	APIActivity: "Drive Activity API",
+}
+
+// WithCircuitBreaker makes calls to an API fail fast with ErrAPIUnavailable for cooldown after threshold
+// consecutive calls to it failed with a server error, a rate limit, or no response, instead of letting every tool
+// call wait through its timeout during an outage. After the cooldown, one call is let through to probe the API.
+// threshold <= 0 disables the breaker.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) Option {
+	return func(ds *DriveService) {
+		ds.breakerThreshold = threshold
+		ds.breakerCooldown = cooldown
+	}
+}
+
+// circuitBreaker tracks the consecutive failures of one API
+type circuitBreaker struct {
+	api       string
+	threshold int
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// allow reports whether a call may be made, or returns an error telling when to retry
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return nil
+	}
+	wait := time.Until(b.openUntil)
+	if wait <= 0 && !b.probing {
+		// Let one call through to see whether the API has recovered
+		b.probing = true
+		return nil
+	}
+	// Round up, so the caller never retries before the probe is allowed
+	wait = max(wait.Truncate(time.Second)+time.Second, time.Second)
+	return fmt.Errorf("%s %w, retry after %s", apiDisplayNames[b.api], ErrAPIUnavailable, wait)
+}
+
+// record counts the outcome of a call, opening the breaker after too many consecutive failures
+func (b *circuitBreaker) record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.cooldown)
+	}
+}
+
+// release ends a probe without counting its outcome
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+// breakerTransport sends requests through base while its breaker allows them
+type breakerTransport struct {
+	base    http.RoundTripper
+	breaker *circuitBreaker
+}
+
+func (t *breakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.breaker.allow(); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	switch {
+	case err == nil:
+		t.breaker.record(resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests)
+	case isOutage(req.Context(), err):
+		t.breaker.record(true)
+	default:
+		// Errors such as an exhausted budget or canceled calls say nothing about the API
+		t.breaker.release()
+	}
+	return resp, err
+}
+
+// isOutage reports whether err means the API could not be reached or did not answer in time. A call ended by its
+// own context, such as a tool timeout while reading a large document, is not counted against the API.
+func isOutage(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var netErr net.Error
+	return errors.Is(err, context.DeadlineExceeded) || errors.As(err, &netErr)
+}
@@ -0,0 +1,61 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// roundTripFunc adapts a function to http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestBreakerTransportCountsOnlyOutages(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		err         error
+		callerEnded bool
+		wantOpen    bool
+	}{
+		{name: "server error", status: http.StatusServiceUnavailable, wantOpen: true},
+		{name: "rate limited", status: http.StatusTooManyRequests, wantOpen: true},
+		{name: "API deadline", err: context.DeadlineExceeded, wantOpen: true},
+		{name: "not found", status: http.StatusNotFound},
+		{name: "caller deadline", err: context.DeadlineExceeded, callerEnded: true},
+		{name: "caller canceled", err: context.Canceled, callerEnded: true},
+		{name: "other error", err: errors.New("budget exhausted")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &breakerTransport{
+				base: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return &http.Response{StatusCode: tt.status, Body: http.NoBody}, nil
+				}),
+				breaker: &circuitBreaker{api: APIDocs, threshold: 2, cooldown: time.Minute},
+			}
+
+			for range 2 {
+				ctx, cancel := context.WithCancel(context.Background())
+				if tt.callerEnded {
+					cancel()
+				}
+				req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://docs.googleapis.com/", nil)
+				transport.RoundTrip(req)
+				cancel()
+			}
+
+			if err := transport.breaker.allow(); (err != nil) != tt.wantOpen {
+				t.Errorf("breaker open = %v, want %v", err != nil, tt.wantOpen)
+			}
+		})
+	}
+}
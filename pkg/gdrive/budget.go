@@ -0,0 +1,148 @@
+package gdrive
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Budget kinds limiting what write tools change, besides the API names, which limit API calls
+const (
+	// BudgetCells limits the spreadsheet cells written
+	BudgetCells = "cells"
+	// BudgetCharacters limits the characters of text written to documents and presentations
+	BudgetCharacters = "characters"
+)
+
+// BudgetKinds lists the kinds of budget WithBudget accepts
+var BudgetKinds = append(slices.Clone(APIs), BudgetCells, BudgetCharacters)
+
+// ErrBudgetExhausted is returned once a call would exceed a budget set with WithBudget
+var ErrBudgetExhausted = errors.New("session budget exhausted")
+
+// WithBudget limits the total use of kind, one of BudgetKinds, over the life of the DriveService: the calls made
+// to an API, or the cells or characters written. Calls and writes beyond the budget fail with ErrBudgetExhausted,
+// as a brake on runaway loops. limit <= 0 removes the limit.
+func WithBudget(kind string, limit int64) Option {
+	return func(ds *DriveService) {
+		if ds.budget.limits == nil {
+			ds.budget.limits = make(map[string]int64)
+		}
+		ds.budget.limits[kind] = limit
+	}
+}
+
+// budget tracks the use of each limited kind
+type budget struct {
+	mu     sync.Mutex
+	limits map[string]int64
+	used   map[string]int64
+}
+
+// limited reports whether kind has a limit
+func (b *budget) limited(kind string) bool {
+	return b.limits[kind] > 0
+}
+
+// spend records n uses of kind, failing without recording them if they would exceed its limit
+func (b *budget) spend(kind string, n int64) error {
+	if !b.limited(kind) {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	limit, used := b.limits[kind], b.used[kind]
+	if used+n > limit {
+		unit := kind
+		if slices.Contains(APIs, kind) {
+			unit = kind + " API calls"
+		}
+		return fmt.Errorf("%w: %d more would exceed the limit of %d %s (%d used)", ErrBudgetExhausted, n, limit, unit, used)
+	}
+	if b.used == nil {
+		b.used = make(map[string]int64)
+	}
+	b.used[kind] = used + n
+	return nil
+}
+
+// budgetTransport charges each request to its API's call budget before sending it
+type budgetTransport struct {
+	api    string
+	base   http.RoundTripper
+	budget *budget
+}
+
+func (t *budgetTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.budget.spend(t.api, 1); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return t.base.RoundTrip(req)
+}
+
+// countCells returns the number of cells in rows
+func countCells[T any](rows [][]T) int64 {
+	var n int64
+	for _, row := range rows {
+		n += int64(len(row))
+	}
+	return n
+}
+
+// countCharacters returns the number of characters in texts
+func countCharacters(texts ...string) int64 {
+	var n int64
+	for _, text := range texts {
+		n += int64(utf8.RuneCountInString(text))
+	}
+	return n
+}
+
+// Budgets holds session budgets parsed from "kind=limit" flags
+type Budgets map[string]int64
+
+// String returns the budgets in "kind=limit" form
+func (b Budgets) String() string {
+	var pairs []string
+	for kind, limit := range b {
+		pairs = append(pairs, kind+"="+strconv.FormatInt(limit, 10))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// Set parses a "kind=limit" budget
+func (b Budgets) Set(value string) error {
+	kind, limit, ok := strings.Cut(value, "=")
+	if !ok || !slices.Contains(BudgetKinds, kind) {
+		return fmt.Errorf("invalid budget %q: expected kind=limit with kind one of %s", value, strings.Join(BudgetKinds, ", "))
+	}
+
+	n, err := strconv.ParseInt(limit, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid budget %q: %w", value, err)
+	}
+
+	b[kind] = n
+	return nil
+}
+
+// Options returns a WithBudget option for each configured budget
+func (b Budgets) Options() []Option {
+	var opts []Option
+	for kind, limit := range b {
+		opts = append(opts, WithBudget(kind, limit))
+	}
+	return opts
+}
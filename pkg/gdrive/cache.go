@@ -0,0 +1,118 @@
+package gdrive
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultCacheSize is the number of read results kept by the content cache
+const DefaultCacheSize = 64
+
+// contentCache holds document, presentation, and spreadsheet reads keyed by file version,
+// so repeated reads of an unchanged file skip the expensive content call
+type contentCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]cacheEntry
+	order      []string
+}
+
+// cacheEntry is a cached read of one file at one version
+type cacheEntry struct {
+	fileID  string
+	version string
+	value   any
+}
+
+func newContentCache(maxEntries int) *contentCache {
+	if maxEntries <= 0 {
+		return nil
+	}
+	return &contentCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]cacheEntry),
+	}
+}
+
+// get returns the value cached under key if it was read at version
+func (c *contentCache) get(key, version string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || entry.version != version {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// put caches value under key, evicting the oldest entry when full
+func (c *contentCache) put(key, fileID, version string, value any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok {
+		if len(c.order) >= c.maxEntries {
+			delete(c.entries, c.order[0])
+			c.order = c.order[1:]
+		}
+		c.order = append(c.order, key)
+	}
+	c.entries[key] = cacheEntry{fileID: fileID, version: version, value: value}
+}
+
+// invalidate drops all entries for fileID
+func (c *contentCache) invalidate(fileID string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	order := c.order[:0]
+	for _, key := range c.order {
+		if c.entries[key].fileID == fileID {
+			delete(c.entries, key)
+			continue
+		}
+		order = append(order, key)
+	}
+	c.order = order
+}
+
+// fileVersion returns a token that changes whenever the file is modified
+func (ds *DriveService) fileVersion(ctx context.Context, fileID string) (string, error) {
+	file, err := ds.driveService.Files.Get(fileID).Fields("version, modifiedTime").SupportsAllDrives(true).Context(ctx).Do()
+	if err != nil {
+		return "", fmt.Errorf("failed to get file version: %w", err)
+	}
+	return fmt.Sprintf("%d@%s", file.Version, file.ModifiedTime), nil
+}
+
+// cachedRead returns the cached result of read for fileID if the file is unchanged since it was cached.
+// key identifies the read, including any parameters such as a range. If the file version
+// cannot be determined, read is called without caching.
+func cachedRead[T any](ctx context.Context, ds *DriveService, fileID, key string, read func() (T, error)) (T, error) {
+	if ds.cache == nil {
+		return read()
+	}
+
+	version, err := ds.fileVersion(ctx, fileID)
+	if err != nil {
+		return read()
+	}
+
+	if value, ok := ds.cache.get(key, version); ok {
+		return value.(T), nil
+	}
+
+	value, err := read()
+	if err != nil {
+		return value, err
+	}
+	ds.cache.put(key, fileID, version, value)
+
+	return value, nil
+}
@@ -0,0 +1,63 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// FileCapabilities reports what the current user may do with a file, so a mutation can be checked before it is attempted
+type FileCapabilities struct {
+	ID                     string `json:"id"`
+	Name                   string `json:"name"`
+	MimeType               string `json:"mimeType"`
+	CanEdit                bool   `json:"canEdit"`
+	CanModifyContent       bool   `json:"canModifyContent"`
+	CanComment             bool   `json:"canComment"`
+	CanShare               bool   `json:"canShare"`
+	CanCopy                bool   `json:"canCopy"`
+	CanDownload            bool   `json:"canDownload"`
+	CanRename              bool   `json:"canRename"`
+	CanTrash               bool   `json:"canTrash"`
+	CanDelete              bool   `json:"canDelete"`
+	CanAddChildren         bool   `json:"canAddChildren"`
+	CanMoveItemWithinDrive bool   `json:"canMoveItemWithinDrive"`
+	CanReadRevisions       bool   `json:"canReadRevisions"`
+}
+
+// GetFileCapabilities returns Drive's per-file capabilities for the current user
+func (ds *DriveService) GetFileCapabilities(ctx context.Context, fileID string) (*FileCapabilities, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if err := ds.checkScope(ctx, fileID); err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Get(fileID).
+		Fields("id, name, mimeType, capabilities").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file capabilities: %w", err)
+	}
+
+	capabilities := &FileCapabilities{ID: file.Id, Name: file.Name, MimeType: file.MimeType}
+	if c := file.Capabilities; c != nil {
+		capabilities.CanEdit = c.CanEdit
+		capabilities.CanModifyContent = c.CanModifyContent
+		capabilities.CanComment = c.CanComment
+		capabilities.CanShare = c.CanShare
+		capabilities.CanCopy = c.CanCopy
+		capabilities.CanDownload = c.CanDownload
+		capabilities.CanRename = c.CanRename
+		capabilities.CanTrash = c.CanTrash
+		capabilities.CanDelete = c.CanDelete
+		capabilities.CanAddChildren = c.CanAddChildren
+		capabilities.CanMoveItemWithinDrive = c.CanMoveItemWithinDrive
+		capabilities.CanReadRevisions = c.CanReadRevisions
+	}
+
+	return capabilities, nil
+}
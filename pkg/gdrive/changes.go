@@ -0,0 +1,98 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// DefaultChangePageSize is the number of changes ListChanges requests per page when ListOptions sets no page size,
+// the most the Changes API allows
+const DefaultChangePageSize = 1000
+
+// FileChange is a change to one file reported by the Changes API
+type FileChange struct {
+	FileID string `json:"fileId"`
+	// Time is when the change was recorded
+	Time string `json:"time"`
+	// Removed is set when the file was deleted or is no longer accessible; the fields below are then empty
+	Removed      bool     `json:"removed,omitempty"`
+	Name         string   `json:"name,omitempty"`
+	MimeType     string   `json:"mimeType,omitempty"`
+	Parents      []string `json:"parents,omitempty"`
+	Trashed      bool     `json:"trashed,omitempty"`
+	ModifiedTime string   `json:"modifiedTime,omitempty"`
+}
+
+// ChangeList is one page of changes
+type ChangeList struct {
+	Changes []FileChange `json:"changes"`
+	// NextPageToken continues the listing when more changes are pending
+	NextPageToken string `json:"nextPageToken,omitempty"`
+	// NewStartPageToken is set on the last page, and lists the changes made after it when passed to ListChanges
+	NewStartPageToken string `json:"newStartPageToken,omitempty"`
+}
+
+// GetStartPageToken returns a page token that lists the changes made from now on when passed to ListChanges
+func (ds *DriveService) GetStartPageToken(ctx context.Context) (string, error) {
+	token, err := ds.driveService.Changes.GetStartPageToken().
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return "", fmt.Errorf("failed to get start page token: %w", err)
+	}
+	return token.StartPageToken, nil
+}
+
+// ListChanges lists the changes to files in My Drive and shared drives made since opts.PageToken was issued, which
+// is required. With a root folder set, changes to files outside it are left out, so a page may hold fewer changes
+// than opts.PageSize while more follow; removals are always reported, since a removed file's location is no longer
+// known. opts.OrderBy and opts.Fields are not supported.
+func (ds *DriveService) ListChanges(ctx context.Context, opts ListOptions) (*ChangeList, error) {
+	if opts.PageToken == "" {
+		return nil, errors.New("page token is empty")
+	}
+	if opts.OrderBy != "" || len(opts.Fields) > 0 {
+		return nil, errors.New("orderBy and fields are not supported for changes")
+	}
+
+	r, err := ds.driveService.Changes.List(opts.PageToken).
+		PageSize(int64(opts.pageSize(DefaultChangePageSize))).
+		IncludeItemsFromAllDrives(true).
+		SupportsAllDrives(true).
+		Fields("nextPageToken, newStartPageToken, changes(fileId, time, removed, file(name, mimeType, parents, trashed, modifiedTime))").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list changes: %w", err)
+	}
+
+	list := &ChangeList{
+		Changes:           []FileChange{},
+		NextPageToken:     r.NextPageToken,
+		NewStartPageToken: r.NewStartPageToken,
+	}
+	for _, change := range r.Changes {
+		fileChange := FileChange{FileID: change.FileId, Time: change.Time, Removed: change.Removed || change.File == nil}
+		if !fileChange.Removed {
+			if ds.rootFolder != "" {
+				inside, err := ds.inRootFolder(ctx, change.FileId, change.File.Parents)
+				if err != nil {
+					return nil, err
+				}
+				if !inside {
+					continue
+				}
+			}
+			fileChange.Name = change.File.Name
+			fileChange.MimeType = change.File.MimeType
+			fileChange.Parents = change.File.Parents
+			fileChange.Trashed = change.File.Trashed
+			fileChange.ModifiedTime = change.File.ModifiedTime
+		}
+		list.Changes = append(list.Changes, fileChange)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,34 @@
+package gdrive
+
+import (
+	"fmt"
+
+	"google.golang.org/api/docs/v1"
+)
+
+// paragraphElementFields is the field mask of the paragraph elements read as text, including smart chips
+const paragraphElementFields = "textRun(content),person(personProperties(name,email)),richLink(richLinkProperties(title,uri,mimeType))"
+
+// elementText returns the text of a paragraph element. Smart chips, which have no text of their own,
+// are represented as tokens such as "@[Person: Alice <alice@example.com>]" or "@[File: Plan](https://...)".
+func elementText(elem *docs.ParagraphElement) string {
+	switch {
+	case elem.TextRun != nil:
+		return elem.TextRun.Content
+	case elem.Person != nil && elem.Person.PersonProperties != nil:
+		person := elem.Person.PersonProperties
+		if person.Name == "" || person.Name == person.Email {
+			return fmt.Sprintf("@[Person: %s]", person.Email)
+		}
+		return fmt.Sprintf("@[Person: %s <%s>]", person.Name, person.Email)
+	case elem.RichLink != nil && elem.RichLink.RichLinkProperties != nil:
+		link := elem.RichLink.RichLinkProperties
+		// Links to Drive files carry the file's MIME type
+		kind := "Link"
+		if link.MimeType != "" {
+			kind = "File"
+		}
+		return fmt.Sprintf("@[%s: %s](%s)", kind, link.Title, link.Uri)
+	}
+	return ""
+}
@@ -0,0 +1,186 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// commentFields are the comment fields read for Comment
+const commentFields = "id, content, author(displayName, emailAddress), createdTime, modifiedTime, resolved, deleted, " +
+	"quotedFileContent(value), replies(id, content, author(displayName, emailAddress), createdTime, action, deleted)"
+
+// DefaultCommentPageSize is the number of comments ListComments reads per page when ListOptions sets no page size
+const DefaultCommentPageSize = 20
+
+// Comment is a comment on a file, with its replies
+type Comment struct {
+	ID           string    `json:"id"`
+	Content      string    `json:"content"`
+	Author       *FileUser `json:"author,omitempty"`
+	CreatedTime  string    `json:"createdTime"`
+	ModifiedTime string    `json:"modifiedTime"`
+	Resolved     bool      `json:"resolved"`
+	// QuotedText is the text of the file the comment is anchored to, empty for comments on the whole file
+	QuotedText string         `json:"quotedText,omitempty"`
+	Replies    []CommentReply `json:"replies,omitempty"`
+}
+
+// CommentReply is a reply in a comment thread
+type CommentReply struct {
+	ID          string    `json:"id"`
+	Content     string    `json:"content,omitempty"`
+	Author      *FileUser `json:"author,omitempty"`
+	CreatedTime string    `json:"createdTime"`
+	// Action is "resolve" or "reopen" for replies that changed the comment's state
+	Action string `json:"action,omitempty"`
+}
+
+// CommentList is a page of comments
+type CommentList struct {
+	Comments []Comment `json:"comments"`
+	// NextPageToken fetches the next page; empty on the last page
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// ListComments lists the comments on a file with their replies, oldest first. Resolved comments are left out unless
+// includeResolved is set, so a page may hold fewer comments than opts.PageSize while more follow. Deleted comments
+// and replies are never listed. opts.OrderBy and opts.Fields are not supported. For a shortcut, the comments on its
+// target are listed.
+func (ds *DriveService) ListComments(ctx context.Context, fileID string, includeResolved bool, opts ListOptions) (*CommentList, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if opts.OrderBy != "" || len(opts.Fields) > 0 {
+		return nil, errors.New("orderBy and fields are not supported for comments")
+	}
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := ds.driveService.Comments.List(fileID).
+		PageSize(int64(opts.pageSize(DefaultCommentPageSize))).
+		PageToken(opts.PageToken).
+		Fields("nextPageToken, comments(" + commentFields + ")").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	list := &CommentList{Comments: []Comment{}, NextPageToken: r.NextPageToken}
+	for _, c := range r.Comments {
+		if c.Deleted || (c.Resolved && !includeResolved) {
+			continue
+		}
+		list.Comments = append(list.Comments, newComment(c))
+	}
+	return list, nil
+}
+
+// newComment converts a Drive API comment, leaving out deleted replies
+func newComment(c *drive.Comment) Comment {
+	comment := Comment{
+		ID:           c.Id,
+		Content:      c.Content,
+		Author:       newFileUser(c.Author),
+		CreatedTime:  c.CreatedTime,
+		ModifiedTime: c.ModifiedTime,
+		Resolved:     c.Resolved,
+	}
+	if c.QuotedFileContent != nil {
+		comment.QuotedText = c.QuotedFileContent.Value
+	}
+	for _, r := range c.Replies {
+		if r.Deleted {
+			continue
+		}
+		comment.Replies = append(comment.Replies, newCommentReply(r))
+	}
+	return comment
+}
+
+// newCommentReply converts a Drive API reply
+func newCommentReply(r *drive.Reply) CommentReply {
+	return CommentReply{
+		ID:          r.Id,
+		Content:     r.Content,
+		Author:      newFileUser(r.Author),
+		CreatedTime: r.CreatedTime,
+		Action:      r.Action,
+	}
+}
+
+// AddComment adds a comment on the whole file. For a shortcut, the comment is added to its target.
+func (ds *DriveService) AddComment(ctx context.Context, fileID, content string) (*Comment, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if content == "" {
+		return nil, errors.New("comment content is empty")
+	}
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, fileID); err != nil {
+		return nil, err
+	}
+
+	c, err := ds.driveService.Comments.Create(fileID, &drive.Comment{Content: content}).
+		Fields(googleapi.Field(commentFields)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to add comment: %w", err)
+	}
+
+	comment := newComment(c)
+	return &comment, nil
+}
+
+// ReplyToComment adds a reply to the thread of a comment. For a shortcut, the comment is looked up on its target.
+func (ds *DriveService) ReplyToComment(ctx context.Context, fileID, commentID, content string) (*CommentReply, error) {
+	if content == "" {
+		return nil, errors.New("reply content is empty")
+	}
+	return ds.createReply(ctx, fileID, commentID, &drive.Reply{Content: content})
+}
+
+// ResolveComment marks a comment thread as resolved by replying to it with the resolve action, with content as
+// the text of the reply when given. For a shortcut, the comment is looked up on its target.
+func (ds *DriveService) ResolveComment(ctx context.Context, fileID, commentID, content string) (*CommentReply, error) {
+	return ds.createReply(ctx, fileID, commentID, &drive.Reply{Content: content, Action: "resolve"})
+}
+
+// createReply adds reply to the thread of a comment
+func (ds *DriveService) createReply(ctx context.Context, fileID, commentID string, reply *drive.Reply) (*CommentReply, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if commentID == "" {
+		return nil, errors.New("comment ID is empty")
+	}
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, fileID); err != nil {
+		return nil, err
+	}
+
+	r, err := ds.driveService.Replies.Create(fileID, commentID, reply).
+		Fields("id, content, author(displayName, emailAddress), createdTime, action").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to reply to comment: %w", err)
+	}
+
+	created := newCommentReply(r)
+	return &created, nil
+}
@@ -0,0 +1,58 @@
+package gdrive
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultParallelism is the number of concurrent API calls made by batch operations
+const DefaultParallelism = 8
+
+// forEachConcurrent calls fn for every index in [0, n) using at most limit concurrent calls.
+// After the first error no further calls are started, the context passed to running calls
+// is canceled, and that error is returned.
+func forEachConcurrent(ctx context.Context, limit, n int, fn func(ctx context.Context, i int) error) error {
+	if limit < 1 {
+		limit = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, limit)
+
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(ctx, i); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
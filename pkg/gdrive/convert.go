@@ -0,0 +1,177 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// googleTypeForOffice maps Office MIME types to the Google Workspace type they are imported as
+var googleTypeForOffice = map[string]string{
+	docxMimeType:                    documentMimeType,
+	"application/msword":            documentMimeType,
+	xlsxMimeType:                    spreadsheetMimeType,
+	"application/vnd.ms-excel":      spreadsheetMimeType,
+	pptxMimeType:                    presentationMimeType,
+	"application/vnd.ms-powerpoint": presentationMimeType,
+}
+
+// officeTypeForGoogle maps Google Workspace MIME types to the Office type they are exported as
+var officeTypeForGoogle = map[string]string{
+	documentMimeType:     docxMimeType,
+	spreadsheetMimeType:  xlsxMimeType,
+	presentationMimeType: pptxMimeType,
+}
+
+// officeExtensions maps Office MIME types to their file name extension
+var officeExtensions = map[string]string{
+	docxMimeType: ".docx",
+	xlsxMimeType: ".xlsx",
+	pptxMimeType: ".pptx",
+}
+
+// ConvertibleMimeType returns the MIME type a file of mimeType converts to, or "" if it cannot be converted.
+// Office documents convert to the matching Google Workspace type and Workspace files to the matching Office format.
+func ConvertibleMimeType(mimeType string) string {
+	if googleType, ok := googleTypeForOffice[mimeType]; ok {
+		return googleType
+	}
+	return officeTypeForGoogle[mimeType]
+}
+
+// copiedFileFields are the extra fields returned for a copied file, so callers can see where it was placed
+var copiedFileFields = []string{"parents"}
+
+// CopyFile copies a file. An empty name keeps the original name, and an empty folderID keeps the original folders.
+// When an output folder is set, the copy is placed there instead.
+// A shortcut is resolved and its target is copied into the shortcut's folders. When convert is set, Office documents
+// are imported as native Google Docs, Sheets, or Slides and Workspace files are exported to .docx, .xlsx, or .pptx.
+func (ds *DriveService) CopyFile(ctx context.Context, fileID, name, folderID string, convert bool) (*DriveFile, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if err := ds.checkScope(ctx, fileID, folderID); err != nil {
+		return nil, err
+	}
+
+	source, err := ds.driveService.Files.Get(fileID).
+		Fields("id, name, mimeType, parents, shortcutDetails(targetId)").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	copied := &drive.File{Name: name}
+	if folderID != "" {
+		copied.Parents = []string{folderID}
+	}
+
+	// Copying a shortcut copies what it points to, placed where the shortcut is
+	if source.MimeType == shortcutMimeType && source.ShortcutDetails != nil {
+		if len(copied.Parents) == 0 {
+			copied.Parents = source.Parents
+		}
+		source, err = ds.driveService.Files.Get(source.ShortcutDetails.TargetId).
+			Fields("id, name, mimeType, parents").
+			SupportsAllDrives(true).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get shortcut target: %w", err)
+		}
+		if err := ds.checkScope(ctx, source.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	// Without a folder, the copy is placed next to the original
+	if len(copied.Parents) == 0 {
+		copied.Parents = source.Parents
+	}
+	if copied.Parents, err = ds.outputParents(ctx, copied.Parents); err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, copied.Parents...); err != nil {
+		return nil, err
+	}
+
+	if convert {
+		targetType := ConvertibleMimeType(source.MimeType)
+		if targetType == "" {
+			return nil, fmt.Errorf("file type %s cannot be converted", source.MimeType)
+		}
+		if _, ok := officeTypeForGoogle[source.MimeType]; ok {
+			return ds.exportCopy(ctx, source, copied, targetType)
+		}
+
+		// Drive imports an Office file when its copy is given a Workspace MIME type
+		copied.MimeType = targetType
+		if copied.Name == "" {
+			copied.Name = strings.TrimSuffix(source.Name, path.Ext(source.Name))
+		}
+	}
+
+	fields, err := fileFields(copiedFileFields)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Copy(source.Id, copied).
+		Fields(googleapi.Field(fields)).
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy file: %w", err)
+	}
+
+	driveFile, err := newDriveFile(file, copiedFileFields)
+	if err != nil {
+		return nil, err
+	}
+	return &driveFile, nil
+}
+
+// exportCopy exports a Workspace file to an Office format and uploads the result as a new file
+func (ds *DriveService) exportCopy(ctx context.Context, source, copied *drive.File, officeType string) (*DriveFile, error) {
+	resp, err := ds.driveService.Files.Export(source.Id, officeType).
+		Context(ctx).
+		Download()
+	if err != nil {
+		return nil, fmt.Errorf("failed to export file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	copied.MimeType = officeType
+	if copied.Name == "" {
+		copied.Name = source.Name + officeExtensions[officeType]
+	}
+
+	fields, err := fileFields(copiedFileFields)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Create(copied).
+		Media(resp.Body).
+		Fields(googleapi.Field(fields)).
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload exported file: %w", err)
+	}
+
+	driveFile, err := newDriveFile(file, copiedFileFields)
+	if err != nil {
+		return nil, err
+	}
+	return &driveFile, nil
+}
@@ -0,0 +1,250 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// CopiedFile is the outcome of copying one file of a folder
+type CopiedFile struct {
+	SourceID string `json:"sourceId"`
+	// ID is the copy's ID, empty when copying failed
+	ID string `json:"id,omitempty"`
+	// Path is the copy's path relative to the new folder
+	Path     string `json:"path"`
+	MimeType string `json:"mimeType"`
+	Error    string `json:"error,omitempty"`
+}
+
+// FolderCopy reports the folders and files created by CopyFolder
+type FolderCopy struct {
+	// Folder is the new top folder
+	Folder DriveFile `json:"folder"`
+	// Folders is the number of folders created, including Folder
+	Folders int          `json:"folders"`
+	Files   []CopiedFile `json:"files"`
+	Copied  int          `json:"copied"`
+	Failed  int          `json:"failed"`
+}
+
+// CopyFolder copies a folder and everything below it, recreating the subfolders and copying each file,
+// including Google Docs, Sheets, and Slides, with Files.Copy. Shortcuts are recreated pointing to the same
+// targets. An empty name keeps the folder's name, and an empty destinationFolderID places the copy next to
+// the original; when an output folder is set, the copy is placed there instead. Each key of replacements
+// found in the name of a copied folder or file, including the top folder unless name is given, is replaced
+// by its value, e.g. {"TEMPLATE": "Acme"} turns "TEMPLATE proposal" into "Acme proposal".
+// A file that fails to copy is reported in its result; failing to create a folder stops the copy.
+func (ds *DriveService) CopyFolder(ctx context.Context, folderID, name, destinationFolderID string, replacements map[string]string) (*FolderCopy, error) {
+	if folderID == "" {
+		return nil, errors.New("folder ID is empty")
+	}
+	if err := ds.checkScope(ctx, folderID, destinationFolderID); err != nil {
+		return nil, err
+	}
+
+	source, err := ds.driveService.Files.Get(folderID).
+		Fields("id, name, mimeType, parents").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get folder: %w", err)
+	}
+	if source.MimeType != folderMimeType {
+		return nil, fmt.Errorf("%s is not a folder; copy it with CopyFile", folderID)
+	}
+
+	rename := nameReplacer(replacements)
+	if name == "" {
+		name = rename(source.Name)
+	}
+
+	// Without a destination, the copy is placed next to the original
+	parents := source.Parents
+	if destinationFolderID != "" {
+		parents = []string{destinationFolderID}
+	}
+	if parents, err = ds.outputParents(ctx, parents); err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, parents...); err != nil {
+		return nil, err
+	}
+
+	root, err := ds.walkSubtree(ctx, source.Id, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the folders level by level, each level concurrently
+	copiedIDs := map[*folderNode]string{}
+	parentOf := map[*folderNode]*folderNode{}
+	paths := map[*folderNode]string{root: ""}
+	var mu sync.Mutex
+	var top *drive.File
+	level := []*folderNode{root}
+	for len(level) > 0 {
+		var next []*folderNode
+		err := forEachConcurrent(ctx, ds.parallelism, len(level), func(ctx context.Context, i int) error {
+			node := level[i]
+			folder := &drive.File{Name: name, MimeType: folderMimeType, Parents: parents}
+			if node != root {
+				mu.Lock()
+				folder.Name = rename(node.name)
+				folder.Parents = []string{copiedIDs[parentOf[node]]}
+				mu.Unlock()
+			}
+			created, err := ds.driveService.Files.Create(folder).
+				Fields("id, name, mimeType").
+				SupportsAllDrives(true).
+				Context(ctx).
+				Do()
+			if err != nil {
+				return fmt.Errorf("failed to create folder %s: %w", folder.Name, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			copiedIDs[node] = created.Id
+			if node == root {
+				top = created
+			}
+			for _, child := range node.children {
+				parentOf[child] = node
+				paths[child] = path.Join(paths[node], rename(child.name))
+				next = append(next, child)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		level = next
+	}
+
+	// List the files of every folder, then copy them all concurrently
+	nodes := make([]*folderNode, 0, len(copiedIDs))
+	for node := range copiedIDs {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return paths[nodes[i]] < paths[nodes[j]] })
+
+	type sourceFile struct {
+		file   *drive.File
+		folder *folderNode
+	}
+	var files []sourceFile
+	err = forEachConcurrent(ctx, ds.parallelism, len(nodes), func(ctx context.Context, i int) error {
+		return ds.driveService.Files.List().
+			Q(fmt.Sprintf("'%s' in parents and mimeType != '%s' and trashed = false", nodes[i].id, folderMimeType)).
+			PageSize(1000).
+			Fields("nextPageToken, files(id, name, mimeType, shortcutDetails(targetId))").
+			SupportsAllDrives(true).
+			IncludeItemsFromAllDrives(true).
+			Pages(ctx, func(r *drive.FileList) error {
+				mu.Lock()
+				defer mu.Unlock()
+				for _, file := range r.Files {
+					files = append(files, sourceFile{file: file, folder: nodes[i]})
+				}
+				return nil
+			})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if paths[files[i].folder] != paths[files[j].folder] {
+			return paths[files[i].folder] < paths[files[j].folder]
+		}
+		return files[i].file.Name < files[j].file.Name
+	})
+
+	result := &FolderCopy{
+		Folder:  DriveFile{ID: top.Id, Name: top.Name, Type: top.MimeType},
+		Folders: len(copiedIDs),
+		Files:   make([]CopiedFile, len(files)),
+	}
+	err = forEachConcurrent(ctx, ds.parallelism, len(files), func(ctx context.Context, i int) error {
+		file, folderID := files[i].file, copiedIDs[files[i].folder]
+		copied := &drive.File{Name: rename(file.Name), Parents: []string{folderID}}
+
+		var created *drive.File
+		var err error
+		if file.MimeType == shortcutMimeType && file.ShortcutDetails != nil {
+			// Shortcuts cannot be copied, so a new one is made to the same target
+			copied.MimeType = shortcutMimeType
+			copied.ShortcutDetails = &drive.FileShortcutDetails{TargetId: file.ShortcutDetails.TargetId}
+			created, err = ds.driveService.Files.Create(copied).
+				Fields("id").
+				SupportsAllDrives(true).
+				Context(ctx).
+				Do()
+		} else {
+			created, err = ds.driveService.Files.Copy(file.Id, copied).
+				Fields("id").
+				SupportsAllDrives(true).
+				Context(ctx).
+				Do()
+		}
+
+		outcome := CopiedFile{SourceID: file.Id, Path: path.Join(paths[files[i].folder], copied.Name), MimeType: file.MimeType}
+		if err != nil {
+			// The copy goes on without the file, unless the whole call was canceled
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			outcome.Error = fmt.Sprintf("failed to copy file: %v", err)
+		} else {
+			outcome.ID = created.Id
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		result.Files[i] = outcome
+		if outcome.Error == "" {
+			result.Copied++
+		} else {
+			result.Failed++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// nameReplacer returns a function applying replacements to a name, trying longer keys first so that a key
+// containing another one wins
+func nameReplacer(replacements map[string]string) func(string) string {
+	keys := make([]string, 0, len(replacements))
+	for key := range replacements {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return func(name string) string { return name }
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	pairs := make([]string, 0, 2*len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key, replacements[key])
+	}
+	return strings.NewReplacer(pairs...).Replace
+}
@@ -0,0 +1,149 @@
+package gdrive
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"cloud.google.com/go/compute/metadata"
+)
+
+// Credential sources, in order of precedence
+const (
+	// CredentialsFromFlag is a credentials file given explicitly on the command line
+	CredentialsFromFlag = "flag"
+	// CredentialsFromKeychain is credentials saved in the OS credential store
+	CredentialsFromKeychain = "keychain"
+	// CredentialsFromEnv is the file named by GOOGLE_APPLICATION_CREDENTIALS
+	CredentialsFromEnv = "env"
+	// CredentialsFromADC is gcloud's application-default credentials file
+	CredentialsFromADC = "adc"
+	// CredentialsFromMetadata is the service account of the Google Cloud compute environment
+	CredentialsFromMetadata = "metadata"
+)
+
+// credentialsEnv is the environment variable naming a credentials file
+const credentialsEnv = "GOOGLE_APPLICATION_CREDENTIALS"
+
+// ErrNoCredentials is returned by FindCredentials when no credential source is available
+var ErrNoCredentials = errors.New("no Google credentials found: pass --credentials, set " + credentialsEnv +
+	", or run 'gcloud auth application-default login'")
+
+// Credentials describes where the server's credentials come from
+type Credentials struct {
+	// Source is one of the CredentialsFrom constants
+	Source string `json:"source"`
+	// Path is the credentials file, if the source is a file
+	Path string `json:"path,omitempty"`
+	// Type is the credentials type, e.g. "authorized_user" or "service_account"
+	Type string `json:"type,omitempty"`
+	// Account is the service account email given in the credentials, if any
+	Account string `json:"account,omitempty"`
+
+	// JSON is the credentials JSON; nil for CredentialsFromMetadata
+	JSON []byte `json:"-"`
+}
+
+// String describes the credentials for diagnostics
+func (c *Credentials) String() string {
+	var s string
+	switch c.Source {
+	case CredentialsFromFlag:
+		s = "--credentials " + c.Path
+	case CredentialsFromKeychain:
+		s = "the OS credential store"
+	case CredentialsFromEnv:
+		s = credentialsEnv + "=" + c.Path
+	case CredentialsFromADC:
+		s = "application-default credentials " + c.Path
+	case CredentialsFromMetadata:
+		s = "the compute metadata server"
+	default:
+		s = c.Source
+	}
+	switch {
+	case c.Account != "":
+		s += " (" + c.Type + " " + c.Account + ")"
+	case c.Type != "":
+		s += " (" + c.Type + ")"
+	}
+	return s
+}
+
+// FindCredentials picks the credential source: the file at path when given, then the file named by
+// GOOGLE_APPLICATION_CREDENTIALS, then gcloud's application-default credentials file, and finally the
+// metadata server when running on Google Cloud. It returns ErrNoCredentials when none is available.
+func FindCredentials(path string) (*Credentials, error) {
+	if path != "" {
+		return credentialsFromFile(CredentialsFromFlag, path)
+	}
+	if path := os.Getenv(credentialsEnv); path != "" {
+		return credentialsFromFile(CredentialsFromEnv, path)
+	}
+	if path := adcPath(); path != "" {
+		if _, err := os.Stat(path); err == nil {
+			return credentialsFromFile(CredentialsFromADC, path)
+		}
+	}
+	if metadata.OnGCE() {
+		return &Credentials{Source: CredentialsFromMetadata}, nil
+	}
+	return nil, ErrNoCredentials
+}
+
+// KeychainCredentials returns the credentials saved in the OS credential store
+func KeychainCredentials() (*Credentials, error) {
+	data, err := LoadKeychainCredentials()
+	if err != nil {
+		return nil, err
+	}
+	return parseCredentials(CredentialsFromKeychain, "", data)
+}
+
+// credentialsFromFile reads a credentials JSON file
+func credentialsFromFile(source, path string) (*Credentials, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials from %s: %w", path, err)
+	}
+	return parseCredentials(source, path, data)
+}
+
+// parseCredentials checks credentials JSON and describes it
+func parseCredentials(source, path string, data []byte) (*Credentials, error) {
+	var file struct {
+		Type        string `json:"type"`
+		ClientEmail string `json:"client_email"`
+	}
+	c := &Credentials{Source: source, Path: path, JSON: data}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid credentials JSON in %s: %w", c, err)
+	}
+	if file.Type == "" {
+		return nil, fmt.Errorf("invalid credentials JSON in %s: missing type", c)
+	}
+	c.Type = file.Type
+	c.Account = file.ClientEmail
+	return c, nil
+}
+
+// adcPath returns where gcloud writes application-default credentials
+func adcPath() string {
+	if dir := os.Getenv("CLOUDSDK_CONFIG"); dir != "" {
+		return filepath.Join(dir, "application_default_credentials.json")
+	}
+	if runtime.GOOS == "windows" {
+		if dir := os.Getenv("APPDATA"); dir != "" {
+			return filepath.Join(dir, "gcloud", "application_default_credentials.json")
+		}
+		return ""
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "gcloud", "application_default_credentials.json")
+}
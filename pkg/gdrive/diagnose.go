@@ -0,0 +1,277 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/driveactivity/v2"
+	"google.golang.org/api/googleapi"
+)
+
+// Problems found by DiagnoseAuth
+const (
+	// ProblemCredentials means no usable credentials were found or the token could not be refreshed
+	ProblemCredentials = "invalid_credentials"
+	// ProblemScopeNotGranted means the credentials lack an OAuth scope the server needs
+	ProblemScopeNotGranted = "scope_not_granted"
+	// ProblemQuotaProject means the API requires a quota project and none is set
+	ProblemQuotaProject = "missing_quota_project"
+	// ProblemAPINotEnabled means the API is not enabled on the project the calls are billed to
+	ProblemAPINotEnabled = "api_not_enabled"
+	// ProblemPermissionDenied means the API rejected the account for another reason
+	ProblemPermissionDenied = "permission_denied"
+	// ProblemUnknown is any other failure
+	ProblemUnknown = "unknown"
+)
+
+// diagnoseID is a file ID that does not exist; a "not found" response to it shows the API is reachable and enabled
+const diagnoseID = "drive-mcp-diagnose-auth"
+
+// apiHosts are the service names of the APIs, as used in the Cloud Console
+var apiHosts = map[string]string{
+	APIDrive:    "drive.googleapis.com",
+	APIDocs:     "docs.googleapis.com",
+	APISlides:   "slides.googleapis.com",
+	APISheets:   "sheets.googleapis.com",
+	APILabels:   "drivelabels.googleapis.com",
+	APIActivity: "driveactivity.googleapis.com",
+}
+
+// loginCommand is the gcloud command granting all the scopes the server requests
+var loginCommand = "gcloud auth application-default login --scopes=https://www.googleapis.com/auth/cloud-platform," +
+	strings.Join(requestedScopes, ",")
+
+// AuthCheck is the result of one test performed by DiagnoseAuth
+type AuthCheck struct {
+	// Name is "credentials", "scopes", or an API name such as "drive"
+	Name string `json:"name"`
+	OK   bool   `json:"ok"`
+	// Problem is one of the Problem constants when the check failed
+	Problem string `json:"problem,omitempty"`
+	Error   string `json:"error,omitempty"`
+	// Remediation lists the steps that fix the problem, in order
+	Remediation []string `json:"remediation,omitempty"`
+}
+
+// AuthDiagnosis reports whether the server can reach every API it uses
+type AuthDiagnosis struct {
+	OK           bool        `json:"ok"`
+	Account      string      `json:"account,omitempty"`
+	QuotaProject string      `json:"quotaProject,omitempty"`
+	Checks       []AuthCheck `json:"checks"`
+}
+
+// DiagnoseAuth checks the credentials and their scopes, and makes a test call against each API, translating
+// common failures into the steps that fix them. Failed checks are reported in the result rather than as an error.
+func (ds *DriveService) DiagnoseAuth(ctx context.Context) (*AuthDiagnosis, error) {
+	diagnosis := &AuthDiagnosis{QuotaProject: os.Getenv("GOOGLE_CLOUD_QUOTA_PROJECT_ID")}
+
+	// A custom HTTP client handles authentication itself, so there is no token to inspect
+	if ds.httpClient == nil {
+		credentials := ds.checkCredentials(ctx)
+		diagnosis.Checks = append(diagnosis.Checks, credentials)
+		if !credentials.OK {
+			return diagnosis, nil
+		}
+		diagnosis.Checks = append(diagnosis.Checks, ds.checkScopes(ctx))
+	}
+
+	tests := []struct {
+		api  string
+		call func() error
+	}{
+		{APIDrive, func() error {
+			about, err := ds.driveService.About.Get().Fields("user(emailAddress)").Context(ctx).Do()
+			if err == nil && about.User != nil {
+				diagnosis.Account = about.User.EmailAddress
+			}
+			return err
+		}},
+		{APIDocs, func() error {
+			_, err := ds.docsService.Documents.Get(diagnoseID).Fields("documentId").Context(ctx).Do()
+			return err
+		}},
+		{APISlides, func() error {
+			_, err := ds.slidesService.Presentations.Get(diagnoseID).Fields("presentationId").Context(ctx).Do()
+			return err
+		}},
+		{APISheets, func() error {
+			_, err := ds.sheetsService.Spreadsheets.Get(diagnoseID).Fields("spreadsheetId").Context(ctx).Do()
+			return err
+		}},
+		{APILabels, func() error {
+			_, err := ds.labelsService.Labels.List().PageSize(1).Fields("labels(id)").Context(ctx).Do()
+			return err
+		}},
+		{APIActivity, func() error {
+			request := &driveactivity.QueryDriveActivityRequest{PageSize: 1}
+			_, err := ds.activityService.Activity.Query(request).Fields("activities(timestamp)").Context(ctx).Do()
+			return err
+		}},
+	}
+	for _, test := range tests {
+		check := AuthCheck{Name: test.api, OK: true}
+		if err := test.call(); err != nil && !isNotFound(err) {
+			check = diagnoseAPIError(test.api, diagnosis.QuotaProject, err)
+		}
+		diagnosis.Checks = append(diagnosis.Checks, check)
+	}
+
+	diagnosis.OK = true
+	for _, check := range diagnosis.Checks {
+		diagnosis.OK = diagnosis.OK && check.OK
+	}
+	return diagnosis, nil
+}
+
+// checkCredentials checks that credentials are found and yield an access token
+func (ds *DriveService) checkCredentials(ctx context.Context) AuthCheck {
+	check := AuthCheck{Name: "credentials", OK: true}
+	if _, err := ds.token(ctx); err != nil {
+		check.OK = false
+		check.Problem = ProblemCredentials
+		check.Error = err.Error()
+		check.Remediation = []string{
+			"Sign in again so application-default credentials are written: " + loginCommand,
+			"Or point GOOGLE_APPLICATION_CREDENTIALS, or the --credentials option, at a valid credentials JSON file",
+			"Call reload_credentials, or restart the MCP server, so it picks up the new credentials",
+		}
+	}
+	return check
+}
+
+// checkScopes checks that the access token holds every scope the server requests
+func (ds *DriveService) checkScopes(ctx context.Context) AuthCheck {
+	check := AuthCheck{Name: "scopes", OK: true}
+	granted, err := ds.grantedScopes(ctx)
+	if err != nil {
+		// Tokens of some credential types, e.g. external accounts, cannot be inspected
+		check.Error = err.Error()
+		return check
+	}
+
+	var missing []string
+	for _, scope := range requestedScopes {
+		if !slices.Contains(granted, scope) {
+			missing = append(missing, scope)
+		}
+	}
+	if len(missing) > 0 {
+		check.OK = false
+		check.Problem = ProblemScopeNotGranted
+		check.Error = "scopes not granted: " + strings.Join(missing, ", ")
+		check.Remediation = []string{
+			"Sign in again granting all scopes the server needs: " + loginCommand,
+			"For a service account or domain-wide delegation, add the missing scopes to the delegation in the Google Workspace admin console",
+			"Call reload_credentials, or restart the MCP server, so it picks up the new credentials",
+		}
+	}
+	return check
+}
+
+// diagnoseAPIError translates an error from a test call against api into a failed check with remediation steps
+func diagnoseAPIError(api, quotaProject string, err error) AuthCheck {
+	check := AuthCheck{Name: api, Problem: ProblemUnknown, Error: err.Error()}
+	host := apiHosts[api]
+	message := strings.ToLower(err.Error())
+
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) {
+		check.Remediation = []string{
+			"Check that " + host + " is reachable from this machine, including any proxy settings (--proxy, HTTPS_PROXY)",
+		}
+		return check
+	}
+
+	switch {
+	case apiErr.Code == http.StatusUnauthorized:
+		check.Problem = ProblemCredentials
+		check.Remediation = []string{
+			"The credentials were rejected, e.g. because they expired or were revoked. Sign in again: " + loginCommand,
+			"Call reload_credentials, or restart the MCP server, so it picks up the new credentials",
+		}
+	case strings.Contains(message, "quota project"):
+		check.Problem = ProblemQuotaProject
+		check.Remediation = []string{
+			"Choose a Google Cloud project to bill API quota to, with " + host + " enabled: https://console.cloud.google.com/apis/library/" + host,
+			"Set it for application-default credentials: gcloud auth application-default set-quota-project PROJECT_ID",
+			"Or set GOOGLE_CLOUD_QUOTA_PROJECT_ID=PROJECT_ID in the MCP server's environment",
+			"Make sure the account has the Service Usage Consumer role (roles/serviceusage.serviceUsageConsumer) on the project",
+			"Restart the MCP server",
+		}
+	case hasReason(apiErr, "accessNotConfigured", "SERVICE_DISABLED") || strings.Contains(message, "has not been used in project") ||
+		strings.Contains(message, "is disabled"):
+		check.Problem = ProblemAPINotEnabled
+		project, command := "the project", "gcloud services enable "+host
+		if consumer := strings.TrimPrefix(errorMetadata(apiErr, "consumer"), "projects/"); consumer != "" {
+			quotaProject = consumer
+		}
+		if quotaProject != "" {
+			project = "project " + quotaProject
+			command += " --project=" + quotaProject
+		}
+		check.Remediation = []string{
+			"Enable " + host + " on " + project + ": https://console.cloud.google.com/apis/library/" + host,
+			"Or from the command line: " + command,
+			"Wait a few minutes for the change to propagate, then retry",
+		}
+	case hasReason(apiErr, "insufficientPermissions", "ACCESS_TOKEN_SCOPE_INSUFFICIENT"):
+		check.Problem = ProblemScopeNotGranted
+		check.Remediation = []string{
+			"Sign in again granting all scopes the server needs: " + loginCommand,
+			"Call reload_credentials, or restart the MCP server, so it picks up the new credentials",
+		}
+	case apiErr.Code == http.StatusForbidden:
+		check.Problem = ProblemPermissionDenied
+		check.Remediation = []string{
+			"Check that the account is allowed to use " + host + ", e.g. that your Google Workspace administrator has not restricted API access for third-party apps",
+		}
+	default:
+		check.Remediation = []string{
+			fmt.Sprintf("Retry later; %s returned HTTP %d", host, apiErr.Code),
+		}
+	}
+	return check
+}
+
+// hasReason reports whether apiErr carries any of reasons, either as a legacy error reason or in its ErrorInfo details
+func hasReason(apiErr *googleapi.Error, reasons ...string) bool {
+	for _, item := range apiErr.Errors {
+		if slices.Contains(reasons, item.Reason) {
+			return true
+		}
+	}
+	for _, detail := range apiErr.Details {
+		if info, ok := detail.(map[string]any); ok {
+			if reason, ok := info["reason"].(string); ok && slices.Contains(reasons, reason) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// errorMetadata returns the value of key in the ErrorInfo details of apiErr, e.g. the "consumer" project
+func errorMetadata(apiErr *googleapi.Error, key string) string {
+	for _, detail := range apiErr.Details {
+		if info, ok := detail.(map[string]any); ok {
+			if metadata, ok := info["metadata"].(map[string]any); ok {
+				if value, ok := metadata[key].(string); ok {
+					return value
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// isNotFound reports whether err is a "not found" response from a Google API
+func isNotFound(err error) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound
+}
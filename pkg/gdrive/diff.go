@@ -0,0 +1,243 @@
+package gdrive
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxDiffEdits bounds the edit distance searched for a minimal diff. Beyond it, the remaining
+// differing lines are reported as one deletion and one insertion, keeping memory use bounded.
+const maxDiffEdits = 4096
+
+// Diff line operations
+const (
+	DiffEqual  = "equal"
+	DiffDelete = "delete"
+	DiffInsert = "insert"
+)
+
+// DiffLine is a line of a diff hunk
+type DiffLine struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// DiffHunk is a run of changed lines with surrounding context. Line numbers are 1-based.
+type DiffHunk struct {
+	BaseStart  int        `json:"baseStart"`
+	BaseLines  int        `json:"baseLines"`
+	OtherStart int        `json:"otherStart"`
+	OtherLines int        `json:"otherLines"`
+	Lines      []DiffLine `json:"lines"`
+}
+
+// splitLines splits text into lines, normalizing line endings. A trailing newline does not start a new line.
+func splitLines(text string) []string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.TrimSuffix(text, "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+// diffLines returns the edit script turning a into b, using Myers' algorithm
+func diffLines(a, b []string) []DiffLine {
+	// Common prefixes and suffixes need no search
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var lines []DiffLine
+	for _, line := range a[:prefix] {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: line})
+	}
+	lines = append(lines, myersDiff(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: line})
+	}
+	return lines
+}
+
+// myersDiff finds a shortest edit script turning a into b
+func myersDiff(a, b []string) []DiffLine {
+	n, m := len(a), len(b)
+
+	// trace[d] holds the furthest x reached on each diagonal k in [-d-1, d+1] before step d
+	var trace [][]int
+	v := map[int]int{1: 0}
+	found := false
+	for d := 0; d <= n+m && d <= maxDiffEdits; d++ {
+		snapshot := make([]int, 2*d+3)
+		for k := -d - 1; k <= d+1; k++ {
+			snapshot[k+d+1] = v[k]
+		}
+		trace = append(trace, snapshot)
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[k-1] < v[k+1]) {
+				x = v[k+1]
+			} else {
+				x = v[k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+		if found {
+			break
+		}
+	}
+
+	if !found {
+		var lines []DiffLine
+		for _, line := range a {
+			lines = append(lines, DiffLine{Op: DiffDelete, Text: line})
+		}
+		for _, line := range b {
+			lines = append(lines, DiffLine{Op: DiffInsert, Text: line})
+		}
+		return lines
+	}
+
+	// Walk the trace backwards from the end to recover the edits
+	var reversed []DiffLine
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		at := func(k int) int { return trace[d][k+d+1] }
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			reversed = append(reversed, DiffLine{Op: DiffEqual, Text: a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				reversed = append(reversed, DiffLine{Op: DiffInsert, Text: b[y-1]})
+			} else {
+				reversed = append(reversed, DiffLine{Op: DiffDelete, Text: a[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	lines := make([]DiffLine, len(reversed))
+	for i, line := range reversed {
+		lines[len(reversed)-1-i] = line
+	}
+	return lines
+}
+
+// diffHunks groups an edit script into hunks with up to contextLines unchanged lines around each change.
+// Changes separated by at most 2*contextLines unchanged lines share a hunk.
+func diffHunks(lines []DiffLine, contextLines int) []DiffHunk {
+	// Line numbers in base and other before each position of the script
+	baseAt := make([]int, len(lines)+1)
+	otherAt := make([]int, len(lines)+1)
+	baseAt[0], otherAt[0] = 1, 1
+	for i, line := range lines {
+		baseAt[i+1], otherAt[i+1] = baseAt[i], otherAt[i]
+		if line.Op != DiffInsert {
+			baseAt[i+1]++
+		}
+		if line.Op != DiffDelete {
+			otherAt[i+1]++
+		}
+	}
+
+	var hunks []DiffHunk
+	for i := 0; i < len(lines); {
+		if lines[i].Op == DiffEqual {
+			i++
+			continue
+		}
+
+		// Extend the hunk over changes until the unchanged gap is too wide
+		start := max(0, i-contextLines)
+		end := i
+		for end < len(lines) {
+			for end < len(lines) && lines[end].Op != DiffEqual {
+				end++
+			}
+			gap := end
+			for gap < len(lines) && lines[gap].Op == DiffEqual {
+				gap++
+			}
+			if gap == len(lines) || gap-end > 2*contextLines {
+				break
+			}
+			end = gap
+		}
+		end = min(len(lines), end+contextLines)
+
+		hunks = append(hunks, DiffHunk{
+			BaseStart:  baseAt[start],
+			BaseLines:  baseAt[end] - baseAt[start],
+			OtherStart: otherAt[start],
+			OtherLines: otherAt[end] - otherAt[start],
+			Lines:      lines[start:end],
+		})
+		i = end
+	}
+
+	return hunks
+}
+
+// unifiedDiff formats hunks as a unified diff between the files labeled base and other
+func unifiedDiff(base, other string, hunks []DiffHunk) string {
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", base, other)
+	for _, hunk := range hunks {
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(hunk.BaseStart, hunk.BaseLines), hunkRange(hunk.OtherStart, hunk.OtherLines))
+		for _, line := range hunk.Lines {
+			switch line.Op {
+			case DiffDelete:
+				sb.WriteString("-")
+			case DiffInsert:
+				sb.WriteString("+")
+			default:
+				sb.WriteString(" ")
+			}
+			sb.WriteString(line.Text)
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+// hunkRange formats a hunk's line range, which by convention starts before the hunk when it is empty
+func hunkRange(start, lines int) string {
+	if lines == 0 {
+		start--
+	}
+	if lines == 1 {
+		return fmt.Sprint(start)
+	}
+	return fmt.Sprintf("%d,%d", start, lines)
+}
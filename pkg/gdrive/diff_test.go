@@ -0,0 +1,254 @@
+package gdrive
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// script builds an edit script from lines prefixed with " ", "-", or "+" as in a unified diff
+func script(lines ...string) []DiffLine {
+	ops := map[byte]string{' ': DiffEqual, '-': DiffDelete, '+': DiffInsert}
+	var result []DiffLine
+	for _, line := range lines {
+		result = append(result, DiffLine{Op: ops[line[0]], Text: line[1:]})
+	}
+	return result
+}
+
+// applyScript returns the base and other texts an edit script turns into each other
+func applyScript(lines []DiffLine) (base, other []string) {
+	for _, line := range lines {
+		if line.Op != DiffInsert {
+			base = append(base, line.Text)
+		}
+		if line.Op != DiffDelete {
+			other = append(other, line.Text)
+		}
+	}
+	return base, other
+}
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      string
+		want      []DiffLine
+		wantEdits int
+	}{
+		{name: "empty", a: "", b: ""},
+		{name: "identical", a: "a\nb\nc", b: "a\nb\nc", want: script(" a", " b", " c")},
+		{name: "pure insert", a: "", b: "a\nb", want: script("+a", "+b"), wantEdits: 2},
+		{name: "pure delete", a: "a\nb", b: "", want: script("-a", "-b"), wantEdits: 2},
+		{name: "insert in the middle", a: "a\nc", b: "a\nb\nc", want: script(" a", "+b", " c"), wantEdits: 1},
+		{name: "change at the start", a: "a\nb\nc", b: "x\nb\nc", want: script("-a", "+x", " b", " c"), wantEdits: 2},
+		{name: "change at the end", a: "a\nb\nc", b: "a\nb\nx", want: script(" a", " b", "-c", "+x"), wantEdits: 2},
+		{name: "line endings", a: "a\r\nb\r\n", b: "a\nb", want: script(" a", " b")},
+		// The example from Myers' paper, whose shortest edit script has 5 edits
+		{name: "interleaved", a: "a\nb\nc\na\nb\nb\na", b: "c\nb\na\nb\na\nc", wantEdits: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := splitLines(tt.a), splitLines(tt.b)
+			got := diffLines(a, b)
+
+			if tt.want != nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffLines = %v, want %v", got, tt.want)
+			}
+			base, other := applyScript(got)
+			if !reflect.DeepEqual(base, a) || !reflect.DeepEqual(other, b) {
+				t.Errorf("diffLines = %v turns %q into %q, want %q into %q", got, base, other, a, b)
+			}
+			edits := 0
+			for _, line := range got {
+				if line.Op != DiffEqual {
+					edits++
+				}
+			}
+			if edits != tt.wantEdits {
+				t.Errorf("diffLines has %d edits, want %d", edits, tt.wantEdits)
+			}
+		})
+	}
+}
+
+func TestMyersDiffMaxEdits(t *testing.T) {
+	// Every other line differs, so a minimal diff keeps the common lines between the changes, but finding it
+	// takes more than maxDiffEdits edits
+	var a, b []string
+	for i := range maxDiffEdits/2 + 1 {
+		a = append(a, fmt.Sprintf("a%d", i), fmt.Sprintf("common%d", i))
+		b = append(b, fmt.Sprintf("b%d", i), fmt.Sprintf("common%d", i))
+	}
+	a, b = append(a, "a"), append(b, "b")
+
+	got := myersDiff(a, b)
+	if len(got) != len(a)+len(b) {
+		t.Fatalf("myersDiff has %d lines, want %d", len(got), len(a)+len(b))
+	}
+	for i, line := range got {
+		wantOp := DiffDelete
+		if i >= len(a) {
+			wantOp = DiffInsert
+		}
+		if line.Op != wantOp {
+			t.Fatalf("line %d is %s %q, want every line of a deleted and then every line of b inserted", i, line.Op, line.Text)
+		}
+	}
+	base, other := applyScript(got)
+	if !reflect.DeepEqual(base, a) || !reflect.DeepEqual(other, b) {
+		t.Error("myersDiff does not turn a into b")
+	}
+}
+
+func TestDiffHunks(t *testing.T) {
+	// numbered returns the lines "1" to "n"
+	numbered := func(n int) []string {
+		var lines []string
+		for i := 1; i <= n; i++ {
+			lines = append(lines, fmt.Sprint(i))
+		}
+		return lines
+	}
+	// replace returns lines with the given 1-based line numbers replaced
+	replace := func(lines []string, with map[int]string) []string {
+		lines = append([]string(nil), lines...)
+		for n, text := range with {
+			lines[n-1] = text
+		}
+		return lines
+	}
+
+	tests := []struct {
+		name         string
+		a, b         []string
+		contextLines int
+		want         []DiffHunk
+	}{
+		{name: "empty", contextLines: 3},
+		{name: "identical", a: numbered(10), b: numbered(10), contextLines: 3},
+		{
+			name:         "change at the start",
+			a:            numbered(10),
+			b:            replace(numbered(10), map[int]string{1: "x"}),
+			contextLines: 3,
+			want: []DiffHunk{
+				{BaseStart: 1, BaseLines: 4, OtherStart: 1, OtherLines: 4, Lines: script("-1", "+x", " 2", " 3", " 4")},
+			},
+		},
+		{
+			name:         "change at the end",
+			a:            numbered(10),
+			b:            replace(numbered(10), map[int]string{10: "x"}),
+			contextLines: 3,
+			want: []DiffHunk{
+				{BaseStart: 7, BaseLines: 4, OtherStart: 7, OtherLines: 4, Lines: script(" 7", " 8", " 9", "-10", "+x")},
+			},
+		},
+		{
+			name:         "pure insert",
+			a:            nil,
+			b:            []string{"a", "b"},
+			contextLines: 3,
+			want: []DiffHunk{
+				{BaseStart: 1, BaseLines: 0, OtherStart: 1, OtherLines: 2, Lines: script("+a", "+b")},
+			},
+		},
+		{
+			name:         "pure delete",
+			a:            []string{"a", "b"},
+			b:            nil,
+			contextLines: 3,
+			want: []DiffHunk{
+				{BaseStart: 1, BaseLines: 2, OtherStart: 1, OtherLines: 0, Lines: script("-a", "-b")},
+			},
+		},
+		{
+			name:         "overlapping context merges",
+			a:            numbered(10),
+			b:            replace(numbered(10), map[int]string{3: "x", 8: "y"}),
+			contextLines: 3,
+			want: []DiffHunk{
+				{BaseStart: 1, BaseLines: 10, OtherStart: 1, OtherLines: 10, Lines: script(" 1", " 2", "-3", "+x", " 4", " 5", " 6", " 7", "-8", "+y", " 9", " 10")},
+			},
+		},
+		{
+			name:         "context meeting exactly merges",
+			a:            numbered(10),
+			b:            replace(numbered(10), map[int]string{3: "x", 8: "y"}),
+			contextLines: 2,
+			want: []DiffHunk{
+				{BaseStart: 1, BaseLines: 10, OtherStart: 1, OtherLines: 10, Lines: script(" 1", " 2", "-3", "+x", " 4", " 5", " 6", " 7", "-8", "+y", " 9", " 10")},
+			},
+		},
+		{
+			name:         "distant changes stay apart",
+			a:            numbered(10),
+			b:            replace(numbered(10), map[int]string{3: "x", 8: "y"}),
+			contextLines: 1,
+			want: []DiffHunk{
+				{BaseStart: 2, BaseLines: 3, OtherStart: 2, OtherLines: 3, Lines: script(" 2", "-3", "+x", " 4")},
+				{BaseStart: 7, BaseLines: 3, OtherStart: 7, OtherLines: 3, Lines: script(" 7", "-8", "+y", " 9")},
+			},
+		},
+		{
+			name:         "no context",
+			a:            numbered(10),
+			b:            replace(numbered(10), map[int]string{3: "x", 4: "y", 8: "z"}),
+			contextLines: 0,
+			want: []DiffHunk{
+				{BaseStart: 3, BaseLines: 2, OtherStart: 3, OtherLines: 2, Lines: script("-3", "-4", "+x", "+y")},
+				{BaseStart: 8, BaseLines: 1, OtherStart: 8, OtherLines: 1, Lines: script("-8", "+z")},
+			},
+		},
+		{
+			name:         "insert without context",
+			a:            numbered(4),
+			b:            []string{"1", "2", "x", "3", "4"},
+			contextLines: 0,
+			want: []DiffHunk{
+				{BaseStart: 3, BaseLines: 0, OtherStart: 3, OtherLines: 1, Lines: script("+x")},
+			},
+		},
+		{
+			name:         "delete without context",
+			a:            numbered(4),
+			b:            []string{"1", "2", "4"},
+			contextLines: 0,
+			want: []DiffHunk{
+				{BaseStart: 3, BaseLines: 1, OtherStart: 3, OtherLines: 0, Lines: script("-3")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffHunks(diffLines(tt.a, tt.b), tt.contextLines)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffHunks = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	a := []string{"1", "2", "3", "4"}
+	b := []string{"1", "2", "x", "3"}
+
+	got := unifiedDiff("base", "other", diffHunks(diffLines(a, b), 0))
+	want := strings.Join([]string{
+		"--- base",
+		"+++ other",
+		"@@ -2,0 +3 @@",
+		"+x",
+		"@@ -4 +4,0 @@",
+		"-4",
+		"",
+	}, "\n")
+	if got != want {
+		t.Errorf("unifiedDiff =\n%s\nwant\n%s", got, want)
+	}
+	if got := unifiedDiff("base", "other", nil); got != "" {
+		t.Errorf("unifiedDiff without hunks = %q, want empty", got)
+	}
+}
@@ -0,0 +1,182 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DefaultDiffContext is the number of unchanged lines shown around each change
+const DefaultDiffContext = 3
+
+// DocumentVersion identifies a Google Document, optionally at a past revision
+type DocumentVersion struct {
+	DocumentID string `json:"documentId"`
+	// RevisionID selects a revision of the document; empty means the current content
+	RevisionID string `json:"revisionId,omitempty"`
+}
+
+// String returns the version as "documentId" or "documentId@revisionId"
+func (v DocumentVersion) String() string {
+	if v.RevisionID == "" {
+		return v.DocumentID
+	}
+	return v.DocumentID + "@" + v.RevisionID
+}
+
+// DocumentDiff is a line-based comparison of the text of two document versions
+type DocumentDiff struct {
+	Base         DocumentVersion `json:"base"`
+	Other        DocumentVersion `json:"other"`
+	Identical    bool            `json:"identical"`
+	LinesAdded   int             `json:"linesAdded"`
+	LinesRemoved int             `json:"linesRemoved"`
+	Hunks        []DiffHunk      `json:"hunks,omitempty"`
+}
+
+// Unified formats the diff as a unified diff. It is empty when the versions are identical.
+func (d *DocumentDiff) Unified() string {
+	return unifiedDiff(d.Base.String(), d.Other.String(), d.Hunks)
+}
+
+// DiffDocuments compares the plain text of two Google Documents, or two revisions of one document, line by line.
+// contextLines is the number of unchanged lines included around each change.
+func (ds *DriveService) DiffDocuments(ctx context.Context, base, other DocumentVersion, contextLines int) (*DocumentDiff, error) {
+	if base.DocumentID == "" || other.DocumentID == "" {
+		return nil, errors.New("document ID is empty")
+	}
+	if contextLines < 0 {
+		return nil, fmt.Errorf("context lines %d is negative", contextLines)
+	}
+
+	var err error
+	base.DocumentID, err = ds.resolveFileID(ctx, base.DocumentID)
+	if err != nil {
+		return nil, err
+	}
+	other.DocumentID, err = ds.resolveFileID(ctx, other.DocumentID)
+	if err != nil {
+		return nil, err
+	}
+	if base == other {
+		return nil, fmt.Errorf("both sides refer to the same version of %s", base)
+	}
+
+	// Read both versions concurrently
+	versions := []DocumentVersion{base, other}
+	texts := make([]string, len(versions))
+	err = forEachConcurrent(ctx, ds.parallelism, len(versions), func(ctx context.Context, i int) error {
+		var err error
+		texts[i], err = ds.documentVersionText(ctx, versions[i])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lines := diffLines(splitLines(texts[0]), splitLines(texts[1]))
+	diff := &DocumentDiff{
+		Base:  base,
+		Other: other,
+		Hunks: diffHunks(lines, contextLines),
+	}
+	for _, line := range lines {
+		switch line.Op {
+		case DiffInsert:
+			diff.LinesAdded++
+		case DiffDelete:
+			diff.LinesRemoved++
+		}
+	}
+	diff.Identical = diff.LinesAdded == 0 && diff.LinesRemoved == 0
+
+	return diff, nil
+}
+
+// documentVersionText exports a document version as plain text
+func (ds *DriveService) documentVersionText(ctx context.Context, version DocumentVersion) (string, error) {
+	var text string
+	var err error
+	if version.RevisionID == "" {
+		text, err = ds.exportText(ctx, version.DocumentID)
+	} else {
+		text, err = ds.exportRevisionText(ctx, version.DocumentID, version.RevisionID)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", version, err)
+	}
+
+	// Plain text exports start with a byte order mark
+	return strings.TrimPrefix(text, "\ufeff"), nil
+}
+
+// exportRevisionText exports a revision of a Google Workspace file as plain text
+func (ds *DriveService) exportRevisionText(ctx context.Context, fileID, revisionID string) (string, error) {
+	data, err := ds.exportRevision(ctx, fileID, revisionID, "text/plain")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// exportRevision exports a revision of a Google Workspace file as mimeType.
+// Revisions of Workspace files cannot be downloaded through the API, only through their export links.
+func (ds *DriveService) exportRevision(ctx context.Context, fileID, revisionID, mimeType string) ([]byte, error) {
+	revision, err := ds.driveService.Revisions.Get(fileID, revisionID).
+		Fields("id,exportLinks").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	link, ok := revision.ExportLinks[mimeType]
+	if !ok {
+		return nil, fmt.Errorf("revision %s cannot be exported as %s", revisionID, mimeType)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create export request: %w", err)
+	}
+
+	resp, err := ds.driveClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export revision: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to export revision: %s", resp.Status)
+	}
+
+	return readExport(resp.Body)
+}
+
+// exportFile exports the current content of a Google Workspace file as mimeType
+func (ds *DriveService) exportFile(ctx context.Context, fileID, mimeType string) ([]byte, error) {
+	resp, err := ds.driveService.Files.Export(fileID, mimeType).
+		Context(ctx).
+		Download()
+	if err != nil {
+		return nil, fmt.Errorf("failed to export file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return readExport(resp.Body)
+}
+
+// readExport reads an exported file, failing if it is larger than maxExportSize
+func readExport(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxExportSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read exported file: %w", err)
+	}
+	if len(data) > maxExportSize {
+		return nil, fmt.Errorf("exported file exceeds the maximum of %d bytes", maxExportSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,251 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/docs/v1"
+)
+
+// ImageLocator identifies an inline image in a Google Document, either by object ID or by position
+type ImageLocator struct {
+	// ObjectID is the image's inline object ID
+	ObjectID string
+	// Index is the 0-based position of the image among the document's inline images; used when ObjectID is empty
+	Index int
+}
+
+// ReplaceDocumentImage replaces an inline image with the image at imageURL, keeping the original image's size and
+// position. The new image is scaled and cropped to fill the original's bounds. It returns the replaced image's object ID.
+func (ds *DriveService) ReplaceDocumentImage(ctx context.Context, documentID string, locator ImageLocator, imageURL string) (string, error) {
+	if documentID == "" {
+		return "", errors.New("document ID is empty")
+	}
+	if u, err := url.Parse(imageURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", fmt.Errorf("invalid image URL %q: only public http and https URLs are supported", imageURL)
+	}
+
+	documentID, err := ds.resolveFileID(ctx, documentID)
+	if err != nil {
+		return "", err
+	}
+	if err := ds.checkWrite(ctx, documentID); err != nil {
+		return "", err
+	}
+
+	// Look the image up even when its ID is given, so a wrong ID fails with a clear message
+	doc, err := ds.docsService.Documents.Get(documentID).
+		Fields("body(content(paragraph(elements(inlineObjectElement(inlineObjectId))),table(tableRows(tableCells(content)))))").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return "", fmt.Errorf("failed to get document: %w", err)
+	}
+
+	objectIDs := inlineObjectIDs(doc.Body.Content)
+	objectID := locator.ObjectID
+	switch {
+	case objectID != "" && !slices.Contains(objectIDs, objectID):
+		return "", fmt.Errorf("document has no inline image %s", objectID)
+	case objectID == "" && (locator.Index < 0 || locator.Index >= len(objectIDs)):
+		return "", fmt.Errorf("image index %d is out of range (document has %d inline images)", locator.Index, len(objectIDs))
+	case objectID == "":
+		objectID = objectIDs[locator.Index]
+	}
+
+	_, err = ds.docsService.Documents.BatchUpdate(documentID, &docs.BatchUpdateDocumentRequest{
+		Requests: []*docs.Request{{
+			ReplaceImage: &docs.ReplaceImageRequest{
+				ImageObjectId:      objectID,
+				Uri:                imageURL,
+				ImageReplaceMethod: "CENTER_CROP",
+			},
+		}},
+	}).Context(ctx).Do()
+	if err != nil {
+		return "", fmt.Errorf("failed to replace image: %w", err)
+	}
+	ds.cache.invalidate(documentID)
+
+	return objectID, nil
+}
+
+// inlineObjectIDs returns the IDs of the inline objects in content in document order, including those inside tables
+func inlineObjectIDs(content []*docs.StructuralElement) []string {
+	var ids []string
+	for _, element := range content {
+		if element.Paragraph != nil {
+			for _, elem := range element.Paragraph.Elements {
+				if elem.InlineObjectElement != nil {
+					ids = append(ids, elem.InlineObjectElement.InlineObjectId)
+				}
+			}
+		}
+		if element.Table != nil {
+			for _, row := range element.Table.TableRows {
+				for _, cell := range row.TableCells {
+					ids = append(ids, inlineObjectIDs(cell.Content)...)
+				}
+			}
+		}
+	}
+	return ids
+}
+
+// maxImageSize bounds the bytes downloaded for each image
+const maxImageSize = 10 << 20
+
+// DocumentImage is an inline image in a Google Document
+type DocumentImage struct {
+	ObjectID string `json:"objectId"`
+	// Index is the 0-based position of the image among the document's inline objects, as used by ImageLocator
+	Index       int    `json:"index"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	// ContentURI is a short-lived URL of the image content, valid for about 30 minutes
+	ContentURI string `json:"contentUri,omitempty"`
+	// SourceURI is the URL the image was inserted from, if any
+	SourceURI string `json:"sourceUri,omitempty"`
+	// Width and Height are the displayed size in points
+	Width  float64 `json:"width,omitempty"`
+	Height float64 `json:"height,omitempty"`
+	// MimeType and Data hold the downloaded image content, if requested
+	MimeType string `json:"mimeType,omitempty"`
+	Data     []byte `json:"-"`
+	// Error explains why the image content could not be downloaded
+	Error string `json:"error,omitempty"`
+}
+
+// GetDocumentImages lists the inline images of a Google Document in document order.
+// If includeData is set, each image's content is downloaded too.
+func (ds *DriveService) GetDocumentImages(ctx context.Context, documentID string, includeData bool) ([]DocumentImage, error) {
+	if documentID == "" {
+		return nil, errors.New("document ID is empty")
+	}
+
+	documentID, err := ds.resolveFileID(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := ds.docsService.Documents.Get(documentID).
+		Fields("body(content(paragraph(elements(inlineObjectElement(inlineObjectId))),table(tableRows(tableCells(content))))),inlineObjects").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+
+	var images []DocumentImage
+	for i, objectID := range inlineObjectIDs(doc.Body.Content) {
+		object, ok := doc.InlineObjects[objectID]
+		if !ok || object.InlineObjectProperties == nil || object.InlineObjectProperties.EmbeddedObject == nil {
+			continue
+		}
+		embedded := object.InlineObjectProperties.EmbeddedObject
+		if embedded.ImageProperties == nil {
+			// Embedded drawings and charts without an image rendering are skipped
+			continue
+		}
+
+		image := DocumentImage{
+			ObjectID:    objectID,
+			Index:       i,
+			Title:       embedded.Title,
+			Description: embedded.Description,
+			ContentURI:  embedded.ImageProperties.ContentUri,
+			SourceURI:   embedded.ImageProperties.SourceUri,
+		}
+		if embedded.Size != nil {
+			image.Width = pointSize(embedded.Size.Width)
+			image.Height = pointSize(embedded.Size.Height)
+		}
+		images = append(images, image)
+	}
+
+	if !includeData {
+		return images, nil
+	}
+
+	// Download the images concurrently; failures are reported per image
+	err = forEachConcurrent(ctx, ds.parallelism, len(images), func(ctx context.Context, i int) error {
+		data, mimeType, err := fetchImage(ctx, http.DefaultClient, images[i].ContentURI)
+		if err != nil {
+			images[i].Error = err.Error()
+			return nil
+		}
+		images[i].Data = data
+		images[i].MimeType = mimeType
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// pointSize converts a Docs dimension to points
+func pointSize(dimension *docs.Dimension) float64 {
+	if dimension == nil {
+		return 0
+	}
+	return toPoints(dimension.Magnitude, dimension.Unit)
+}
+
+// toPoints converts a length in unit (PT or EMU) to points
+func toPoints(magnitude float64, unit string) float64 {
+	if unit == "EMU" {
+		// There are 12700 EMUs per point
+		return magnitude / 12700
+	}
+	return magnitude
+}
+
+// fetchImage downloads an image from a content URI with client. Content URIs of document and slide images carry
+// their own authorization, so those are fetched with http.DefaultClient.
+func fetchImage(ctx context.Context, client *http.Client, contentURI string) ([]byte, string, error) {
+	if contentURI == "" {
+		return nil, "", errors.New("image has no content URI")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, contentURI, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create image request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to download image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("failed to download image: %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImageSize+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read image: %w", err)
+	}
+	if len(data) > maxImageSize {
+		return nil, "", fmt.Errorf("image exceeds the maximum of %d bytes", maxImageSize)
+	}
+
+	mimeType := resp.Header.Get("Content-Type")
+	if mediaType, _, err := mime.ParseMediaType(mimeType); err == nil {
+		mimeType = mediaType
+	}
+	if !strings.HasPrefix(mimeType, "image/") {
+		mimeType = http.DetectContentType(data)
+	}
+
+	return data, mimeType, nil
+}
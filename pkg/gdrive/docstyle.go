@@ -0,0 +1,260 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/docs/v1"
+)
+
+// PageSizes are the standard page sizes in points, portrait orientation
+var PageSizes = map[string][2]float64{
+	"LETTER":  {612, 792},
+	"LEGAL":   {612, 1008},
+	"TABLOID": {792, 1224},
+	"A3":      {841.89, 1190.55},
+	"A4":      {595.28, 841.89},
+	"A5":      {419.53, 595.28},
+}
+
+// DocumentStyleSettings is the page setup and named styles of a Google Document. Lengths are in points.
+type DocumentStyleSettings struct {
+	DocumentID string `json:"documentId"`
+	// PageSize is the name of the matching standard page size, if any
+	PageSize   string  `json:"pageSize,omitempty"`
+	PageWidth  float64 `json:"pageWidth"`
+	PageHeight float64 `json:"pageHeight"`
+	// Orientation is "portrait" or "landscape"
+	Orientation  string       `json:"orientation"`
+	MarginTop    float64      `json:"marginTop"`
+	MarginBottom float64      `json:"marginBottom"`
+	MarginLeft   float64      `json:"marginLeft"`
+	MarginRight  float64      `json:"marginRight"`
+	NamedStyles  []NamedStyle `json:"namedStyles,omitempty"`
+}
+
+// NamedStyle summarizes a named paragraph style such as NORMAL_TEXT or HEADING_1
+type NamedStyle struct {
+	Type            string  `json:"type"`
+	FontFamily      string  `json:"fontFamily,omitempty"`
+	FontSize        float64 `json:"fontSize,omitempty"`
+	Bold            bool    `json:"bold,omitempty"`
+	Italic          bool    `json:"italic,omitempty"`
+	ForegroundColor string  `json:"foregroundColor,omitempty"`
+	Alignment       string  `json:"alignment,omitempty"`
+	LineSpacing     float64 `json:"lineSpacing,omitempty"`
+	SpaceAbove      float64 `json:"spaceAbove,omitempty"`
+	SpaceBelow      float64 `json:"spaceBelow,omitempty"`
+}
+
+// DocumentStyleUpdate holds the page setup to change. Nil fields are left unchanged. Lengths are in points.
+type DocumentStyleUpdate struct {
+	// PageSize is one of PageSizes; PageWidth and PageHeight override its dimensions
+	PageSize     string
+	PageWidth    *float64
+	PageHeight   *float64
+	Landscape    *bool
+	MarginTop    *float64
+	MarginBottom *float64
+	MarginLeft   *float64
+	MarginRight  *float64
+}
+
+const documentStyleFields = "documentStyle(pageSize,flipPageOrientation,marginTop,marginBottom,marginLeft,marginRight),namedStyles"
+
+// GetDocumentStyle returns the page setup and named styles of a Google Document
+func (ds *DriveService) GetDocumentStyle(ctx context.Context, documentID string) (*DocumentStyleSettings, error) {
+	if documentID == "" {
+		return nil, errors.New("document ID is empty")
+	}
+
+	documentID, err := ds.resolveFileID(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := ds.docsService.Documents.Get(documentID).
+		Fields(documentStyleFields).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+
+	return newDocumentStyleSettings(documentID, doc), nil
+}
+
+// UpdateDocumentStyle changes the page size, orientation, and margins of a Google Document and returns the new settings.
+// Named styles cannot be changed, as the Docs API has no request for it.
+func (ds *DriveService) UpdateDocumentStyle(ctx context.Context, documentID string, update DocumentStyleUpdate) (*DocumentStyleSettings, error) {
+	if documentID == "" {
+		return nil, errors.New("document ID is empty")
+	}
+
+	documentID, err := ds.resolveFileID(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, documentID); err != nil {
+		return nil, err
+	}
+
+	// The current page size is needed to change one dimension or the orientation
+	current, err := ds.GetDocumentStyle(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	style := &docs.DocumentStyle{}
+	var fields []string
+
+	width, height := current.PageWidth, current.PageHeight
+	if update.PageSize != "" {
+		size, ok := PageSizes[strings.ToUpper(update.PageSize)]
+		if !ok {
+			return nil, fmt.Errorf("unknown page size %q", update.PageSize)
+		}
+		width, height = size[0], size[1]
+	}
+	if update.PageWidth != nil {
+		width = *update.PageWidth
+	}
+	if update.PageHeight != nil {
+		height = *update.PageHeight
+	}
+	if width != current.PageWidth || height != current.PageHeight {
+		if width <= 0 || height <= 0 {
+			return nil, fmt.Errorf("page size %gx%g must be positive", width, height)
+		}
+		style.PageSize = &docs.Size{Width: points(width), Height: points(height)}
+		fields = append(fields, "pageSize")
+	}
+
+	if update.Landscape != nil {
+		// Orientation is stored as a flip of the page size, so it depends on which side is longer
+		style.FlipPageOrientation = *update.Landscape != (width > height)
+		style.ForceSendFields = append(style.ForceSendFields, "FlipPageOrientation")
+		fields = append(fields, "flipPageOrientation")
+	}
+
+	margins := []struct {
+		field string
+		value *float64
+		set   func(*docs.Dimension)
+	}{
+		{"marginTop", update.MarginTop, func(d *docs.Dimension) { style.MarginTop = d }},
+		{"marginBottom", update.MarginBottom, func(d *docs.Dimension) { style.MarginBottom = d }},
+		{"marginLeft", update.MarginLeft, func(d *docs.Dimension) { style.MarginLeft = d }},
+		{"marginRight", update.MarginRight, func(d *docs.Dimension) { style.MarginRight = d }},
+	}
+	for _, margin := range margins {
+		if margin.value == nil {
+			continue
+		}
+		if *margin.value < 0 {
+			return nil, fmt.Errorf("%s %g is negative", margin.field, *margin.value)
+		}
+		margin.set(points(*margin.value))
+		fields = append(fields, margin.field)
+	}
+
+	if len(fields) == 0 {
+		return current, nil
+	}
+
+	_, err = ds.docsService.Documents.BatchUpdate(documentID, &docs.BatchUpdateDocumentRequest{
+		Requests: []*docs.Request{{
+			UpdateDocumentStyle: &docs.UpdateDocumentStyleRequest{
+				DocumentStyle: style,
+				Fields:        strings.Join(fields, ","),
+			},
+		}},
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to update document style: %w", err)
+	}
+	ds.cache.invalidate(documentID)
+
+	return ds.GetDocumentStyle(ctx, documentID)
+}
+
+// newDocumentStyleSettings converts the style of a document read with documentStyleFields
+func newDocumentStyleSettings(documentID string, doc *docs.Document) *DocumentStyleSettings {
+	settings := &DocumentStyleSettings{DocumentID: documentID, Orientation: "portrait"}
+
+	if style := doc.DocumentStyle; style != nil {
+		if style.PageSize != nil {
+			settings.PageWidth = pointSize(style.PageSize.Width)
+			settings.PageHeight = pointSize(style.PageSize.Height)
+		}
+		if style.FlipPageOrientation != (settings.PageWidth > settings.PageHeight) {
+			settings.Orientation = "landscape"
+		}
+		settings.MarginTop = pointSize(style.MarginTop)
+		settings.MarginBottom = pointSize(style.MarginBottom)
+		settings.MarginLeft = pointSize(style.MarginLeft)
+		settings.MarginRight = pointSize(style.MarginRight)
+	}
+
+	// Name the page size if it matches a standard size in either orientation
+	for name, size := range PageSizes {
+		if nearlyEqual(settings.PageWidth, size[0]) && nearlyEqual(settings.PageHeight, size[1]) ||
+			nearlyEqual(settings.PageWidth, size[1]) && nearlyEqual(settings.PageHeight, size[0]) {
+			settings.PageSize = name
+		}
+	}
+
+	if doc.NamedStyles != nil {
+		for _, style := range doc.NamedStyles.Styles {
+			settings.NamedStyles = append(settings.NamedStyles, newNamedStyle(style))
+		}
+	}
+	slices.SortFunc(settings.NamedStyles, func(a, b NamedStyle) int {
+		return strings.Compare(a.Type, b.Type)
+	})
+
+	return settings
+}
+
+// newNamedStyle summarizes a named style's text and paragraph style
+func newNamedStyle(style *docs.NamedStyle) NamedStyle {
+	named := NamedStyle{Type: style.NamedStyleType}
+	if text := style.TextStyle; text != nil {
+		if text.WeightedFontFamily != nil {
+			named.FontFamily = text.WeightedFontFamily.FontFamily
+		}
+		named.FontSize = pointSize(text.FontSize)
+		named.Bold = text.Bold
+		named.Italic = text.Italic
+		if text.ForegroundColor != nil && text.ForegroundColor.Color != nil && text.ForegroundColor.Color.RgbColor != nil {
+			rgb := text.ForegroundColor.Color.RgbColor
+			named.ForegroundColor = fmt.Sprintf("#%02x%02x%02x", colorByte(rgb.Red), colorByte(rgb.Green), colorByte(rgb.Blue))
+		}
+	}
+	if paragraph := style.ParagraphStyle; paragraph != nil {
+		named.Alignment = paragraph.Alignment
+		named.LineSpacing = paragraph.LineSpacing
+		named.SpaceAbove = pointSize(paragraph.SpaceAbove)
+		named.SpaceBelow = pointSize(paragraph.SpaceBelow)
+	}
+	return named
+}
+
+// points returns a Docs dimension of value points
+func points(value float64) *docs.Dimension {
+	return &docs.Dimension{Magnitude: value, Unit: "PT", ForceSendFields: []string{"Magnitude"}}
+}
+
+// colorByte converts a color component in [0, 1] to a byte
+func colorByte(component float64) int {
+	return int(math.Round(math.Max(0, math.Min(1, component)) * 255))
+}
+
+// nearlyEqual reports whether two lengths in points differ by less than a point
+func nearlyEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1
+}
@@ -0,0 +1,190 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/docs/v1"
+)
+
+// TableLocator identifies a table in a Google Document, either by position or by the heading it follows
+type TableLocator struct {
+	// Index is the 0-based position of the table among the document's top-level tables; used when Heading is empty
+	Index int
+	// Heading selects the first table after the first heading whose text contains it (case-insensitive)
+	Heading string
+}
+
+// TableAppendResult describes a table after rows were appended to it
+type TableAppendResult struct {
+	TableIndex   int `json:"tableIndex"`
+	RowsAppended int `json:"rowsAppended"`
+	RowCount     int `json:"rowCount"`
+	ColumnCount  int `json:"columnCount"`
+}
+
+// tableFields is the field mask for locating tables and the headings before them
+const tableFields = "body(content(startIndex,paragraph(paragraphStyle(namedStyleType),elements(textRun(content))),table(rows,columns,tableRows(tableCells(content(startIndex))))))"
+
+// AppendTableRows appends rows to the end of an existing table in a Google Document.
+// Each row may have at most as many values as the table has columns; missing values leave cells empty.
+func (ds *DriveService) AppendTableRows(ctx context.Context, documentID string, locator TableLocator, rows [][]string) (*TableAppendResult, error) {
+	if documentID == "" {
+		return nil, errors.New("document ID is empty")
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("rows are empty")
+	}
+
+	documentID, err := ds.resolveFileID(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, documentID); err != nil {
+		return nil, err
+	}
+	if err := ds.budget.spend(BudgetCharacters, countCharacters(slices.Concat(rows...)...)); err != nil {
+		return nil, err
+	}
+
+	doc, err := ds.docsService.Documents.Get(documentID).
+		Fields(tableFields).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+
+	tableIndex, element, err := findTable(doc, locator)
+	if err != nil {
+		return nil, err
+	}
+	table := element.Table
+	for i, row := range rows {
+		if len(row) > int(table.Columns) {
+			return nil, fmt.Errorf("row %d has %d values but the table has %d columns", i, len(row), table.Columns)
+		}
+	}
+
+	// Add the empty rows below the last row
+	tableStart := &docs.Location{Index: element.StartIndex}
+	var requests []*docs.Request
+	for range rows {
+		requests = append(requests, &docs.Request{
+			InsertTableRow: &docs.InsertTableRowRequest{
+				TableCellLocation: &docs.TableCellLocation{
+					TableStartLocation: tableStart,
+					RowIndex:           table.Rows - 1,
+				},
+				InsertBelow: true,
+			},
+		})
+	}
+	_, err = ds.docsService.Documents.BatchUpdate(documentID, &docs.BatchUpdateDocumentRequest{Requests: requests}).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert table rows: %w", err)
+	}
+	ds.cache.invalidate(documentID)
+
+	// Read the new cell positions, then fill the cells from the end so earlier indexes stay valid
+	doc, err = ds.docsService.Documents.Get(documentID).
+		Fields(tableFields).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document: %w", err)
+	}
+	_, element, err = findTable(doc, TableLocator{Index: tableIndex})
+	if err != nil {
+		return nil, err
+	}
+	table = element.Table
+
+	var inserts []*docs.InsertTextRequest
+	firstNewRow := len(table.TableRows) - len(rows)
+	for i, row := range rows {
+		cells := table.TableRows[firstNewRow+i].TableCells
+		for j, value := range row {
+			if value == "" || j >= len(cells) || len(cells[j].Content) == 0 {
+				continue
+			}
+			inserts = append(inserts, &docs.InsertTextRequest{
+				Location: &docs.Location{Index: cells[j].Content[0].StartIndex},
+				Text:     value,
+			})
+		}
+	}
+	sort.Slice(inserts, func(i, j int) bool {
+		return inserts[i].Location.Index > inserts[j].Location.Index
+	})
+
+	if len(inserts) > 0 {
+		requests = make([]*docs.Request, len(inserts))
+		for i, insert := range inserts {
+			requests[i] = &docs.Request{InsertText: insert}
+		}
+		_, err = ds.docsService.Documents.BatchUpdate(documentID, &docs.BatchUpdateDocumentRequest{Requests: requests}).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fill table rows: %w", err)
+		}
+	}
+
+	return &TableAppendResult{
+		TableIndex:   tableIndex,
+		RowsAppended: len(rows),
+		RowCount:     int(table.Rows),
+		ColumnCount:  int(table.Columns),
+	}, nil
+}
+
+// findTable returns the position among the document's tables and the element of the table selected by locator
+func findTable(doc *docs.Document, locator TableLocator) (int, *docs.StructuralElement, error) {
+	heading := strings.ToLower(locator.Heading)
+	afterHeading := heading == ""
+
+	tableIndex := 0
+	for _, element := range doc.Body.Content {
+		switch {
+		case element.Paragraph != nil && !afterHeading && isHeading(element.Paragraph):
+			afterHeading = strings.Contains(strings.ToLower(paragraphText(element.Paragraph)), heading)
+		case element.Table != nil:
+			if afterHeading && (locator.Heading != "" || tableIndex == locator.Index) {
+				return tableIndex, element, nil
+			}
+			tableIndex++
+		}
+	}
+
+	if locator.Heading != "" {
+		return 0, nil, fmt.Errorf("no table found after a heading containing %q", locator.Heading)
+	}
+	return 0, nil, fmt.Errorf("table index %d is out of range (document has %d tables)", locator.Index, tableIndex)
+}
+
+// isHeading reports whether a paragraph uses a title or heading style
+func isHeading(paragraph *docs.Paragraph) bool {
+	if paragraph.ParagraphStyle == nil {
+		return false
+	}
+	style := paragraph.ParagraphStyle.NamedStyleType
+	return strings.HasPrefix(style, "HEADING_") || style == "TITLE" || style == "SUBTITLE"
+}
+
+// paragraphText concatenates the text runs of a paragraph
+func paragraphText(paragraph *docs.Paragraph) string {
+	var text string
+	for _, elem := range paragraph.Elements {
+		if elem.TextRun != nil {
+			text += elem.TextRun.Content
+		}
+	}
+	return text
+}
@@ -0,0 +1,245 @@
+package gdrive
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultChunkSize is the number of bytes returned per download chunk when no size is given
+const DefaultChunkSize = 1 << 20
+
+// MaxChunkSize bounds the bytes returned per download chunk
+const MaxChunkSize = 8 << 20
+
+// FileChunk is a byte range of a file's content
+type FileChunk struct {
+	FileID    string `json:"fileId"`
+	Name      string `json:"name"`
+	MimeType  string `json:"mimeType"`
+	Offset    int64  `json:"offset"`
+	Size      int64  `json:"size"`
+	TotalSize int64  `json:"totalSize"`
+	// Content is encoded as base64 in JSON
+	Content []byte `json:"content"`
+	// ContinuationToken fetches the next chunk; empty when the end was reached
+	ContinuationToken string `json:"continuationToken,omitempty"`
+}
+
+// downloadToken is the decoded form of a FileChunk continuation token
+type downloadToken struct {
+	FileID string `json:"f"`
+	Offset int64  `json:"o"`
+	MD5    string `json:"m"`
+}
+
+func encodeDownloadToken(token downloadToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeDownloadToken(s string) (downloadToken, error) {
+	var token downloadToken
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return token, errors.New("invalid continuation token")
+	}
+	if err := json.Unmarshal(data, &token); err != nil {
+		return token, errors.New("invalid continuation token")
+	}
+	return token, nil
+}
+
+// DownloadFileChunk downloads up to chunkSize bytes of a binary file's content using a ranged request.
+// Pass the continuation token of the previous chunk to continue; an empty token starts at the beginning.
+// If the file changes between chunks, an error is returned so a mixed download is never assembled.
+func (ds *DriveService) DownloadFileChunk(ctx context.Context, fileID, continuationToken string, chunkSize int64) (*FileChunk, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	if chunkSize > MaxChunkSize {
+		return nil, fmt.Errorf("chunk size %d exceeds the maximum of %d bytes", chunkSize, MaxChunkSize)
+	}
+
+	var token downloadToken
+	if continuationToken != "" {
+		var err error
+		token, err = decodeDownloadToken(continuationToken)
+		if err != nil {
+			return nil, err
+		}
+		if token.FileID != fileID {
+			return nil, errors.New("continuation token belongs to a different file")
+		}
+	}
+
+	file, err := ds.driveService.Files.Get(fileID).
+		Fields("id, name, mimeType, size, md5Checksum").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	if strings.HasPrefix(file.MimeType, "application/vnd.google-apps.") {
+		return nil, fmt.Errorf("%s is a Google Workspace file (%s) and has no binary content; export it instead", fileID, file.MimeType)
+	}
+	if continuationToken != "" && token.MD5 != file.Md5Checksum {
+		return nil, errors.New("file changed since the download started; restart without a continuation token")
+	}
+
+	chunk := &FileChunk{
+		FileID:    file.Id,
+		Name:      file.Name,
+		MimeType:  file.MimeType,
+		Offset:    token.Offset,
+		TotalSize: file.Size,
+		Content:   []byte{},
+	}
+	if token.Offset >= file.Size {
+		return chunk, nil
+	}
+
+	call := ds.driveService.Files.Get(fileID).SupportsAllDrives(true).Context(ctx)
+	call.Header().Set("Range", fmt.Sprintf("bytes=%d-%d", token.Offset, token.Offset+chunkSize-1))
+	resp, err := call.Download()
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// A server ignoring the range returns the whole file, so skip to the offset ourselves
+	body := io.Reader(resp.Body)
+	if resp.StatusCode == http.StatusOK && token.Offset > 0 {
+		if _, err := io.CopyN(io.Discard, resp.Body, token.Offset); err != nil {
+			return nil, fmt.Errorf("failed to download file: %w", err)
+		}
+	}
+
+	chunk.Content, err = io.ReadAll(io.LimitReader(body, chunkSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file: %w", err)
+	}
+	chunk.Size = int64(len(chunk.Content))
+
+	if next := token.Offset + chunk.Size; next < file.Size {
+		chunk.ContinuationToken = encodeDownloadToken(downloadToken{FileID: fileID, Offset: next, MD5: file.Md5Checksum})
+	}
+
+	return chunk, nil
+}
+
+// SavedFile is a Drive file written to the local file system by SaveFile
+type SavedFile struct {
+	FileID string `json:"fileId"`
+	Name   string `json:"name"`
+	// MimeType is the type of the saved content, which is the export format for Google Workspace files
+	MimeType string `json:"mimeType"`
+	// Path is the absolute local path the content was written to
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// SaveFile downloads a file's whole content to localPath on the machine the server runs on. Google Docs, Sheets,
+// Slides, and Drawings have no content of their own, so they are exported as format, as with ExportFile; format must
+// be empty for other files. When localPath is an existing directory, the file is saved inside it under its Drive
+// name, with the export format's extension. An existing file is only replaced when overwrite is set. The content is
+// written to a temporary file next to the destination and renamed into place, so an interrupted download never
+// leaves a partial file behind.
+func (ds *DriveService) SaveFile(ctx context.Context, fileID, localPath, format string, overwrite bool) (*SavedFile, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if localPath == "" {
+		return nil, errors.New("local path is empty")
+	}
+
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Get(fileID).
+		Fields("id, name, mimeType").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	saved := &SavedFile{FileID: file.Id, Name: file.Name, MimeType: file.MimeType}
+	var body io.Reader
+	if _, ok := exportFormats[file.MimeType]; ok {
+		exported, err := ds.ExportFile(ctx, file.Id, format)
+		if err != nil {
+			return nil, err
+		}
+		saved.Name, saved.MimeType = exported.Name, exported.MimeType
+		body = bytes.NewReader(exported.Content)
+	} else if strings.HasPrefix(file.MimeType, "application/vnd.google-apps.") {
+		return nil, fmt.Errorf("%s is a Google Workspace file (%s) that can neither be downloaded nor exported", fileID, file.MimeType)
+	} else if format != "" {
+		return nil, fmt.Errorf("%s is not a Google Workspace file, so it is saved as is and cannot be exported as %s", fileID, format)
+	}
+
+	dest, err := filepath.Abs(localPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid local path: %w", err)
+	}
+	if info, err := os.Stat(dest); err == nil && info.IsDir() {
+		dest = filepath.Join(dest, filepath.Base(saved.Name))
+	}
+	if _, err := os.Lstat(dest); err == nil && !overwrite {
+		return nil, fmt.Errorf("%s already exists; set overwrite to replace it", dest)
+	}
+
+	if body == nil {
+		resp, err := ds.driveService.Files.Get(fileID).SupportsAllDrives(true).Context(ctx).Download()
+		if err != nil {
+			return nil, fmt.Errorf("failed to download file: %w", err)
+		}
+		defer resp.Body.Close()
+		body = resp.Body
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(dest), "."+filepath.Base(dest)+".*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create local file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	saved.Size, err = io.Copy(tmp, body)
+	if err == nil {
+		// CreateTemp makes the file private; give it the permissions of a normally created file
+		err = tmp.Chmod(0o644)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), dest); err != nil {
+		return nil, fmt.Errorf("failed to save file: %w", err)
+	}
+	saved.Path = dest
+
+	return saved, nil
+}
@@ -0,0 +1,692 @@
+// Package gdrive wraps the Google Drive, Docs, Slides, and Sheets APIs used by the Drive MCP tools.
+package gdrive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/driveactivity/v2"
+	"google.golang.org/api/drivelabels/v2"
+	"google.golang.org/api/option"
+	"google.golang.org/api/sheets/v4"
+	"google.golang.org/api/slides/v1"
+)
+
+// DriveFile represents information about a Google Drive file
+type DriveFile struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"mimeType"`
+
+	// Fields holds additional metadata requested by Drive API field name, e.g. "size" or "owners"
+	Fields map[string]any `json:"-"`
+}
+
+// AccountInfo describes the Google account the server acts as and the OAuth scopes it holds
+type AccountInfo struct {
+	EmailAddress    string   `json:"emailAddress"`
+	DisplayName     string   `json:"displayName"`
+	RequestedScopes []string `json:"requestedScopes"`
+	GrantedScopes   []string `json:"grantedScopes,omitempty"`
+}
+
+// requestedScopes are the OAuth scopes requested for all Google API calls
+var requestedScopes = []string{drive.DriveScope, docs.DocumentsScope, slides.PresentationsScope, sheets.SpreadsheetsScope, drivelabels.DriveLabelsReadonlyScope, driveactivity.DriveActivityReadonlyScope}
+
+// tokenInfoURL is the OAuth2 endpoint reporting the scopes granted to an access token
+const tokenInfoURL = "https://oauth2.googleapis.com/tokeninfo"
+
+// DriveService manages Google Drive, Docs, Slides, and Sheets API services
+type DriveService struct {
+	driveService    *drive.Service
+	docsService     *docs.Service
+	slidesService   *slides.Service
+	sheetsService   *sheets.Service
+	labelsService   *drivelabels.Service
+	activityService *driveactivity.Service
+
+	// parallelism bounds the number of concurrent API calls made by batch operations
+	parallelism int
+
+	// endpoint and httpClient override how the Google APIs are reached, e.g. for testing
+	endpoint   string
+	httpClient *http.Client
+
+	// auth is the authenticated transport shared by all API clients
+	auth *authTransport
+
+	// driveClient is the Drive API's HTTP client, also used for Drive URLs outside the API
+	driveClient *http.Client
+
+	// credentialsJSON replaces application-default credentials when set
+	credentialsJSON []byte
+	// credentialsLoader looks up credentials JSON on every credentials (re)load when set
+	credentialsLoader func() ([]byte, error)
+
+	// rateLimits holds the requests per second allowed for each API
+	rateLimits map[string]float64
+
+	// tracer records a span per Google API request when set
+	tracer trace.Tracer
+
+	// breakerThreshold consecutive failures of an API make its calls fail fast for breakerCooldown
+	breakerThreshold int
+	breakerCooldown  time.Duration
+
+	// budget limits the API calls made and the cells and characters written over the service's life
+	budget budget
+
+	// cache holds content reads keyed by file version; nil disables caching
+	cache     *contentCache
+	cacheSize int
+
+	// uploadLimits restrict what upload_from_url may fetch
+	uploadLimits UploadLimits
+
+	// resolveShortcuts replaces shortcut IDs with their targets' IDs in content reads and updates
+	resolveShortcuts bool
+	shortcuts        shortcutCache
+
+	// rootFolder confines all operations to the folder's subtree; empty allows all of Drive.
+	// rootID is its canonical ID, looked up on first use.
+	rootFolder string
+	rootID     string
+	rootMu     sync.Mutex
+	scope      scopeCache
+
+	// accessPolicy restricts which files may be changed; nil allows any
+	accessPolicy *AccessPolicy
+
+	// outputFolder receives every created file regardless of the requested folder; empty creates files where requested
+	outputFolder string
+}
+
+// Option configures a DriveService
+type Option func(*DriveService)
+
+// WithParallelism sets the maximum number of concurrent API calls made by batch operations
+func WithParallelism(n int) Option {
+	return func(ds *DriveService) {
+		ds.parallelism = n
+	}
+}
+
+// WithCacheSize sets the number of document, presentation, and spreadsheet reads to cache.
+// Cached reads are reused while the file's version is unchanged. 0 disables the cache.
+func WithCacheSize(n int) Option {
+	return func(ds *DriveService) {
+		ds.cacheSize = n
+	}
+}
+
+// WithEndpoint sets the base URL used for all Google APIs instead of the Google endpoints.
+// Drive requests are sent under "drive/v3/" relative to the base URL, mirroring www.googleapis.com.
+func WithEndpoint(baseURL string) Option {
+	return func(ds *DriveService) {
+		ds.endpoint = baseURL
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for all Google APIs.
+// The client is used as is, so it must handle authentication itself.
+func WithHTTPClient(client *http.Client) Option {
+	return func(ds *DriveService) {
+		ds.httpClient = client
+	}
+}
+
+// NewDriveService creates a new DriveService
+func NewDriveService(ctx context.Context, opts ...Option) (*DriveService, error) {
+	ds := &DriveService{
+		parallelism: DefaultParallelism,
+		cacheSize:   DefaultCacheSize,
+		uploadLimits: UploadLimits{
+			MaxSize: DefaultMaxUploadSize,
+		},
+		resolveShortcuts: true,
+		breakerThreshold: DefaultBreakerThreshold,
+		breakerCooldown:  DefaultBreakerCooldown,
+	}
+	for _, opt := range opts {
+		opt(ds)
+	}
+	ds.cache = newContentCache(ds.cacheSize)
+
+	// All APIs share one authenticated transport, so credentials are looked up and tokens refreshed once.
+	// Credentials are looked up on first use, so the server can start before they are available.
+	ds.auth = &authTransport{ds: ds}
+
+	// Drive is served under a path prefix while the other APIs use the host root
+	var driveEndpoint, apiEndpoint []option.ClientOption
+	if ds.endpoint != "" {
+		baseURL := strings.TrimSuffix(ds.endpoint, "/") + "/"
+		driveEndpoint = []option.ClientOption{option.WithEndpoint(baseURL + "drive/v3/")}
+		apiEndpoint = []option.ClientOption{option.WithEndpoint(baseURL)}
+	}
+
+	ds.driveClient = ds.apiHTTPClient(APIDrive)
+	var err error
+	ds.driveService, err = drive.NewService(ctx, append(driveEndpoint, option.WithHTTPClient(ds.driveClient))...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create drive service: %w", err)
+	}
+
+	ds.docsService, err = docs.NewService(ctx, append(apiEndpoint, option.WithHTTPClient(ds.apiHTTPClient(APIDocs)))...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create docs service: %w", err)
+	}
+
+	ds.slidesService, err = slides.NewService(ctx, append(apiEndpoint, option.WithHTTPClient(ds.apiHTTPClient(APISlides)))...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create slides service: %w", err)
+	}
+
+	ds.sheetsService, err = sheets.NewService(ctx, append(apiEndpoint, option.WithHTTPClient(ds.apiHTTPClient(APISheets)))...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sheets service: %w", err)
+	}
+
+	ds.labelsService, err = drivelabels.NewService(ctx, append(apiEndpoint, option.WithHTTPClient(ds.apiHTTPClient(APILabels)))...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create drive labels service: %w", err)
+	}
+
+	ds.activityService, err = driveactivity.NewService(ctx, append(apiEndpoint, option.WithHTTPClient(ds.apiHTTPClient(APIActivity)))...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create drive activity service: %w", err)
+	}
+
+	return ds, nil
+}
+
+// GetAccountInfo returns the authenticated account and the scopes granted to its credentials
+func (ds *DriveService) GetAccountInfo(ctx context.Context) (*AccountInfo, error) {
+	about, err := ds.driveService.About.Get().Fields("user(displayName, emailAddress)").Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	info := &AccountInfo{
+		RequestedScopes: requestedScopes,
+	}
+	if about.User != nil {
+		info.EmailAddress = about.User.EmailAddress
+		info.DisplayName = about.User.DisplayName
+	}
+
+	// A custom HTTP client handles authentication itself, so there is no token to inspect
+	if ds.httpClient == nil {
+		grantedScopes, err := ds.grantedScopes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		info.GrantedScopes = grantedScopes
+	}
+
+	return info, nil
+}
+
+// grantedScopes asks the OAuth2 token info endpoint which scopes the current access token holds
+func (ds *DriveService) grantedScopes(ctx context.Context) ([]string, error) {
+	token, err := ds.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenInfoURL+"?access_token="+url.QueryEscape(token.AccessToken), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token info request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token info: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get token info: %s", resp.Status)
+	}
+
+	var tokenInfo struct {
+		Scope string `json:"scope"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenInfo); err != nil {
+		return nil, fmt.Errorf("failed to decode token info: %w", err)
+	}
+
+	return strings.Fields(tokenInfo.Scope), nil
+}
+
+// SearchFiles searches for files in Google Drive by name (DriveService method), narrowed by filter. The query may be
+// empty when the filter sets a condition. opts.Fields are additional Drive file fields to return besides id, name,
+// and mimeType.
+func (ds *DriveService) SearchFiles(ctx context.Context, query string, filter SearchFilter, opts ListOptions) (*FileList, error) {
+	var nameClause string
+	if query != "" {
+		nameClause = "name contains " + quoteQuery(query)
+	}
+	searchQuery, err := ds.searchQuery(ctx, nameClause, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute search with Google Drive API
+	list, err := ds.listFiles(ctx, ds.driveService.Files.List().Q(searchQuery), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search files: %w", err)
+	}
+
+	return list, nil
+}
+
+// ListFiles lists files in a Google Drive folder. Without a folder, it lists My Drive root, or the top of the shared
+// drive opts.DriveID when set. opts.Fields are additional Drive file fields to return besides id, name, and mimeType.
+func (ds *DriveService) ListFiles(ctx context.Context, folderID string, opts ListOptions) (*FileList, error) {
+	folderID = ds.folderOrRoot(folderID)
+	if err := ds.checkScope(ctx, folderID); err != nil {
+		return nil, err
+	}
+
+	// Build query for listing files in folder
+	var query string
+	if folderID == "" && opts.DriveID != "" {
+		// List files at the top of the shared drive
+		query = fmt.Sprintf("'%s' in parents and trashed = false", opts.DriveID)
+	} else if folderID == "" {
+		// List files in root folder (My Drive)
+		query = "'root' in parents and trashed = false"
+	} else {
+		// List files in specific folder
+		query = fmt.Sprintf("'%s' in parents and trashed = false", folderID)
+	}
+
+	// Execute list with Google Drive API
+	list, err := ds.listFiles(ctx, ds.driveService.Files.List().Q(query), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+
+	return list, nil
+}
+
+// GetDocumentContent retrieves the content of a Google Document
+func (ds *DriveService) GetDocumentContent(ctx context.Context, documentID string) (string, error) {
+	if documentID == "" {
+		return "", errors.New("document ID is empty")
+	}
+
+	documentID, err := ds.resolveFileID(ctx, documentID)
+	if err != nil {
+		return "", err
+	}
+
+	return cachedRead(ctx, ds, documentID, "document:"+documentID, func() (string, error) {
+		return ds.fetchDocumentContent(ctx, documentID)
+	})
+}
+
+// fetchDocumentContent retrieves the content of a Google Document without caching
+func (ds *DriveService) fetchDocumentContent(ctx context.Context, documentID string) (string, error) {
+	doc, err := ds.docsService.Documents.Get(documentID).
+		Fields("body(content(paragraph(elements(" + paragraphElementFields + "))))").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return "", fmt.Errorf("failed to get document: %w", err)
+	}
+
+	var content string
+	for _, element := range doc.Body.Content {
+		if element.Paragraph != nil {
+			for _, elem := range element.Paragraph.Elements {
+				content += elementText(elem)
+			}
+		}
+	}
+
+	return content, nil
+}
+
+// DocumentChunk is a range of a Google Document's text, measured in characters
+type DocumentChunk struct {
+	Content    string `json:"content"`
+	StartIndex int    `json:"startIndex"`
+	TotalChars int    `json:"totalChars"`
+	// NextStartIndex is the startIndex of the following chunk, or nil when the end was reached
+	NextStartIndex *int `json:"nextStartIndex,omitempty"`
+}
+
+// GetDocumentChunk retrieves up to maxChars characters of a Google Document's text starting at startIndex.
+// maxChars <= 0 returns everything from startIndex to the end.
+func (ds *DriveService) GetDocumentChunk(ctx context.Context, documentID string, startIndex, maxChars int) (*DocumentChunk, error) {
+	if startIndex < 0 {
+		return nil, fmt.Errorf("start index %d is negative", startIndex)
+	}
+
+	content, err := ds.GetDocumentContent(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	text := []rune(content)
+	if startIndex > len(text) {
+		return nil, fmt.Errorf("start index %d is out of range (0-%d)", startIndex, len(text))
+	}
+
+	endIndex := len(text)
+	if maxChars > 0 && startIndex+maxChars < endIndex {
+		endIndex = startIndex + maxChars
+	}
+
+	chunk := &DocumentChunk{
+		Content:    string(text[startIndex:endIndex]),
+		StartIndex: startIndex,
+		TotalChars: len(text),
+	}
+	if endIndex < len(text) {
+		chunk.NextStartIndex = &endIndex
+	}
+
+	return chunk, nil
+}
+
+// UpdateDocumentContent updates the content of a Google Document
+func (ds *DriveService) UpdateDocumentContent(ctx context.Context, documentID, content string) error {
+	if documentID == "" {
+		return errors.New("document ID is empty")
+	}
+
+	documentID, err := ds.resolveFileID(ctx, documentID)
+	if err != nil {
+		return err
+	}
+	if err := ds.checkWrite(ctx, documentID); err != nil {
+		return err
+	}
+	if err := ds.budget.spend(BudgetCharacters, countCharacters(content)); err != nil {
+		return err
+	}
+
+	// First, get the current document to determine the end index
+	doc, err := ds.docsService.Documents.Get(documentID).
+		Fields("body(content(endIndex))").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return fmt.Errorf("failed to get document: %w", err)
+	}
+
+	// Calculate the end index of the document content
+	endIndex := int64(1)
+	for _, element := range doc.Body.Content {
+		if element.EndIndex > endIndex {
+			endIndex = element.EndIndex
+		}
+	}
+
+	// Create batch update requests
+	requests := []*docs.Request{
+		// Delete all existing content (except the last character which is always a newline)
+		{
+			DeleteContentRange: &docs.DeleteContentRangeRequest{
+				Range: &docs.Range{
+					StartIndex: 1,
+					EndIndex:   endIndex - 1,
+				},
+			},
+		},
+		// Insert new content
+		{
+			InsertText: &docs.InsertTextRequest{
+				Location: &docs.Location{
+					Index: 1,
+				},
+				Text: content,
+			},
+		},
+	}
+
+	// Execute the batch update
+	batchUpdateRequest := &docs.BatchUpdateDocumentRequest{
+		Requests: requests,
+	}
+
+	_, err = ds.docsService.Documents.BatchUpdate(documentID, batchUpdateRequest).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("failed to update document: %w", err)
+	}
+	ds.cache.invalidate(documentID)
+
+	return nil
+}
+
+// GetPresentationContent retrieves the content of a Google Slides presentation
+func (ds *DriveService) GetPresentationContent(ctx context.Context, presentationID string) (string, error) {
+	if presentationID == "" {
+		return "", errors.New("presentation ID is empty")
+	}
+
+	presentationID, err := ds.resolveFileID(ctx, presentationID)
+	if err != nil {
+		return "", err
+	}
+
+	return cachedRead(ctx, ds, presentationID, "presentation:"+presentationID, func() (string, error) {
+		return ds.fetchPresentationContent(ctx, presentationID)
+	})
+}
+
+// fetchPresentationContent retrieves the content of a Google Slides presentation without caching
+func (ds *DriveService) fetchPresentationContent(ctx context.Context, presentationID string) (string, error) {
+	presentation, err := ds.slidesService.Presentations.Get(presentationID).
+		Fields("title,slides(pageElements(shape(shapeType,text(textElements(textRun(content))))))").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return "", fmt.Errorf("failed to get presentation: %w", err)
+	}
+
+	var content string
+	content += fmt.Sprintf("Title: %s\n\n", presentation.Title)
+
+	for i, slide := range presentation.Slides {
+		content += fmt.Sprintf("--- Slide %d ---\n", i+1)
+
+		for _, element := range slide.PageElements {
+			if element.Shape != nil && element.Shape.Text != nil {
+				for _, textElement := range element.Shape.Text.TextElements {
+					if textElement.TextRun != nil {
+						content += textElement.TextRun.Content
+					}
+				}
+				content += "\n"
+			}
+		}
+		content += "\n"
+	}
+
+	return content, nil
+}
+
+// UpdatePresentationSlide updates a specific slide in a Google Slides presentation
+func (ds *DriveService) UpdatePresentationSlide(ctx context.Context, presentationID string, slideIndex int, title, content string) error {
+	if presentationID == "" {
+		return errors.New("presentation ID is empty")
+	}
+
+	presentationID, err := ds.resolveFileID(ctx, presentationID)
+	if err != nil {
+		return err
+	}
+	if err := ds.checkWrite(ctx, presentationID); err != nil {
+		return err
+	}
+	if err := ds.budget.spend(BudgetCharacters, countCharacters(title, content)); err != nil {
+		return err
+	}
+
+	presentation, err := ds.slidesService.Presentations.Get(presentationID).
+		Fields("slides(pageElements(objectId,shape(shapeType,text(textElements(textRun(content))))))").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return fmt.Errorf("failed to get presentation: %w", err)
+	}
+
+	if slideIndex < 0 || slideIndex >= len(presentation.Slides) {
+		return fmt.Errorf("slide index %d is out of range (0-%d)", slideIndex, len(presentation.Slides)-1)
+	}
+
+	slide := presentation.Slides[slideIndex]
+	var requests []*slides.Request
+
+	// Clear existing text elements
+	for _, element := range slide.PageElements {
+		if element.Shape != nil && element.Shape.Text != nil {
+			requests = append(requests, &slides.Request{
+				DeleteText: &slides.DeleteTextRequest{
+					ObjectId: element.ObjectId,
+					TextRange: &slides.Range{
+						Type: "ALL",
+					},
+				},
+			})
+		}
+	}
+
+	// Find title and content text boxes, or create new ones if needed
+	var titleObjectId, contentObjectId string
+
+	for _, element := range slide.PageElements {
+		if element.Shape != nil {
+			// Assume first text box is title, second is content
+			if titleObjectId == "" {
+				titleObjectId = element.ObjectId
+			} else if contentObjectId == "" {
+				contentObjectId = element.ObjectId
+				break
+			}
+		}
+	}
+
+	// Insert new content
+	if titleObjectId != "" && title != "" {
+		requests = append(requests, &slides.Request{
+			InsertText: &slides.InsertTextRequest{
+				ObjectId:       titleObjectId,
+				Text:           title,
+				InsertionIndex: 0,
+			},
+		})
+	}
+
+	if contentObjectId != "" && content != "" {
+		requests = append(requests, &slides.Request{
+			InsertText: &slides.InsertTextRequest{
+				ObjectId:       contentObjectId,
+				Text:           content,
+				InsertionIndex: 0,
+			},
+		})
+	}
+
+	if len(requests) > 0 {
+		batchUpdateRequest := &slides.BatchUpdatePresentationRequest{
+			Requests: requests,
+		}
+
+		_, err = ds.slidesService.Presentations.BatchUpdate(presentationID, batchUpdateRequest).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("failed to update presentation: %w", err)
+		}
+		ds.cache.invalidate(presentationID)
+	}
+
+	return nil
+}
+
+// GetSpreadsheetValues retrieves values from a Google Spreadsheet, rendered as options asks
+func (ds *DriveService) GetSpreadsheetValues(ctx context.Context, spreadsheetID, rangeName string, options SpreadsheetValuesOptions) ([][]interface{}, error) {
+	if spreadsheetID == "" {
+		return nil, errors.New("spreadsheet ID is empty")
+	}
+	if rangeName == "" {
+		return nil, errors.New("range name is empty")
+	}
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+
+	spreadsheetID, err := ds.resolveFileID(ctx, spreadsheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	key := "values:" + spreadsheetID + ":" + rangeName + ":" + options.ValueRenderOption + ":" + options.DateTimeRenderOption
+	return cachedRead(ctx, ds, spreadsheetID, key, func() ([][]interface{}, error) {
+		if options.DateTimeRenderOption == DateTimeISO8601 {
+			return ds.isoSpreadsheetValues(ctx, spreadsheetID, rangeName, options.ValueRenderOption)
+		}
+
+		call := ds.sheetsService.Spreadsheets.Values.Get(spreadsheetID, rangeName)
+		if options.ValueRenderOption != "" {
+			call = call.ValueRenderOption(options.ValueRenderOption)
+		}
+		if options.DateTimeRenderOption != "" {
+			call = call.DateTimeRenderOption(options.DateTimeRenderOption)
+		}
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get spreadsheet values: %w", err)
+		}
+
+		return resp.Values, nil
+	})
+}
+
+// UpdateSpreadsheetValues updates values in a Google Spreadsheet
+func (ds *DriveService) UpdateSpreadsheetValues(ctx context.Context, spreadsheetID, rangeName string, values [][]interface{}) error {
+	if spreadsheetID == "" {
+		return errors.New("spreadsheet ID is empty")
+	}
+	if rangeName == "" {
+		return errors.New("range name is empty")
+	}
+
+	spreadsheetID, err := ds.resolveFileID(ctx, spreadsheetID)
+	if err != nil {
+		return err
+	}
+	if err := ds.checkWrite(ctx, spreadsheetID); err != nil {
+		return err
+	}
+	if err := ds.budget.spend(BudgetCells, countCells(values)); err != nil {
+		return err
+	}
+
+	valueRange := &sheets.ValueRange{
+		Values: values,
+	}
+
+	_, err = ds.sheetsService.Spreadsheets.Values.Update(spreadsheetID, rangeName, valueRange).
+		ValueInputOption("USER_ENTERED").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return fmt.Errorf("failed to update spreadsheet values: %w", err)
+	}
+	ds.cache.invalidate(spreadsheetID)
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// DefaultDuplicateSetLimit is the number of duplicate sets FindDuplicates reports when no limit is given
+const DefaultDuplicateSetLimit = 100
+
+// Ways FindDuplicates can match files
+const (
+	// DuplicatesByChecksum matches files with identical content
+	DuplicatesByChecksum = "checksum"
+	// DuplicatesByName matches files with the same name
+	DuplicatesByName = "name"
+	// DuplicatesByNameAndSize matches files with the same name and size
+	DuplicatesByNameAndSize = "nameAndSize"
+)
+
+// DuplicatesQuery selects where FindDuplicates looks and how it matches files
+type DuplicatesQuery struct {
+	FolderID string
+	// Recursive also scans the folders anywhere below FolderID
+	Recursive bool
+	// MatchBy is one of the DuplicatesBy constants; empty matches by checksum
+	MatchBy string
+	// Limit bounds the number of sets reported; 0 uses DefaultDuplicateSetLimit
+	Limit int
+}
+
+// DuplicateFile is one copy in a set of duplicates
+type DuplicateFile struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	MimeType string `json:"mimeType"`
+	// Path is the file's path relative to the scanned folder
+	Path         string `json:"path"`
+	Size         int64  `json:"size,omitempty"`
+	MD5Checksum  string `json:"md5Checksum,omitempty"`
+	CreatedTime  string `json:"createdTime"`
+	ModifiedTime string `json:"modifiedTime"`
+}
+
+// DuplicateSet is a group of files matching each other, oldest first
+type DuplicateSet struct {
+	// Key is what the files share: their checksum, their name, or their name and size
+	Key   string          `json:"key"`
+	Files []DuplicateFile `json:"files"`
+	// WastedBytes is the storage used by all the copies but one
+	WastedBytes int64 `json:"wastedBytes"`
+}
+
+// DuplicatesReport lists the sets of duplicate files found in a folder, those wasting the most storage first
+type DuplicatesReport struct {
+	ScannedFiles int            `json:"scannedFiles"`
+	Sets         []DuplicateSet `json:"sets"`
+	// TotalSets is the number of sets found, which may be more than reported
+	TotalSets int `json:"totalSets"`
+	// WastedBytes is the storage used by all the copies but one of every set found
+	WastedBytes int64 `json:"wastedBytes"`
+}
+
+// FindDuplicates scans a folder, and with query.Recursive the folders below it, for sets of files that duplicate
+// each other. Files match by md5Checksum by default, by name, or by name and size. Checksums and sizes are only
+// known for files with binary content, so Google Docs, Sheets, and Slides are only matched by name. Folders and
+// shortcuts are never matched.
+func (ds *DriveService) FindDuplicates(ctx context.Context, query DuplicatesQuery) (*DuplicatesReport, error) {
+	if query.FolderID == "" {
+		return nil, errors.New("folder ID is empty")
+	}
+	matchBy := query.MatchBy
+	if matchBy == "" {
+		matchBy = DuplicatesByChecksum
+	}
+	if matchBy != DuplicatesByChecksum && matchBy != DuplicatesByName && matchBy != DuplicatesByNameAndSize {
+		return nil, fmt.Errorf("unsupported match %q; use checksum, name, or nameAndSize", matchBy)
+	}
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultDuplicateSetLimit
+	}
+	if err := ds.checkScope(ctx, query.FolderID); err != nil {
+		return nil, err
+	}
+
+	folders := []*folderNode{{id: query.FolderID}}
+	if query.Recursive {
+		root, err := ds.walkSubtree(ctx, query.FolderID, true)
+		if err != nil {
+			return nil, err
+		}
+		var collect func(node *folderNode)
+		collect = func(node *folderNode) {
+			for _, child := range node.children {
+				folders = append(folders, child)
+				collect(child)
+			}
+		}
+		collect(root)
+	}
+
+	var (
+		mu    sync.Mutex
+		files []DuplicateFile
+		// seen skips files reached through several parents
+		seen = make(map[string]bool)
+	)
+	err := forEachConcurrent(ctx, ds.parallelism, len(folders), func(ctx context.Context, i int) error {
+		folder := folders[i]
+		err := ds.driveService.Files.List().
+			Q(fmt.Sprintf("'%s' in parents and mimeType != '%s' and mimeType != '%s' and trashed = false", folder.id, folderMimeType, shortcutMimeType)).
+			PageSize(1000).
+			Fields("nextPageToken, files(id, name, mimeType, size, md5Checksum, createdTime, modifiedTime)").
+			SupportsAllDrives(true).
+			IncludeItemsFromAllDrives(true).
+			Pages(ctx, func(r *drive.FileList) error {
+				mu.Lock()
+				defer mu.Unlock()
+				for _, file := range r.Files {
+					if seen[file.Id] {
+						continue
+					}
+					seen[file.Id] = true
+
+					path := file.Name
+					if folder.path != "" {
+						path = folder.path + "/" + file.Name
+					}
+					files = append(files, DuplicateFile{
+						ID:           file.Id,
+						Name:         file.Name,
+						MimeType:     file.MimeType,
+						Path:         path,
+						Size:         file.Size,
+						MD5Checksum:  file.Md5Checksum,
+						CreatedTime:  file.CreatedTime,
+						ModifiedTime: file.ModifiedTime,
+					})
+				}
+				return nil
+			})
+		if err != nil {
+			return fmt.Errorf("failed to list files: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string][]DuplicateFile)
+	for _, file := range files {
+		var key string
+		switch matchBy {
+		case DuplicatesByChecksum:
+			key = file.MD5Checksum
+		case DuplicatesByName:
+			key = file.Name
+		case DuplicatesByNameAndSize:
+			// Only files with binary content have a size, and those all have a checksum
+			if file.MD5Checksum != "" {
+				key = file.Name + " (" + strconv.FormatInt(file.Size, 10) + " bytes)"
+			}
+		}
+		if key == "" {
+			continue
+		}
+		groups[key] = append(groups[key], file)
+	}
+
+	report := &DuplicatesReport{ScannedFiles: len(files), Sets: []DuplicateSet{}}
+	for key, group := range groups {
+		if len(group) < 2 {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool {
+			if group[i].CreatedTime != group[j].CreatedTime {
+				return group[i].CreatedTime < group[j].CreatedTime
+			}
+			return group[i].ID < group[j].ID
+		})
+		set := DuplicateSet{Key: key, Files: group}
+		for _, file := range group[1:] {
+			set.WastedBytes += file.Size
+		}
+		report.Sets = append(report.Sets, set)
+		report.WastedBytes += set.WastedBytes
+	}
+	sort.Slice(report.Sets, func(i, j int) bool {
+		if report.Sets[i].WastedBytes != report.Sets[j].WastedBytes {
+			return report.Sets[i].WastedBytes > report.Sets[j].WastedBytes
+		}
+		return report.Sets[i].Key < report.Sets[j].Key
+	})
+	report.TotalSets = len(report.Sets)
+	report.Sets = report.Sets[:min(limit, len(report.Sets))]
+
+	return report, nil
+}
@@ -0,0 +1,109 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// EmptyFolder is a folder that contains nothing but other empty folders
+type EmptyFolder struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Path is the folder's path relative to the scanned folder
+	Path string `json:"path"`
+}
+
+// EmptyFoldersReport lists the empty folders found below a folder
+type EmptyFoldersReport struct {
+	Folders []EmptyFolder `json:"folders"`
+	// Trashed holds the outcome of trashing each outermost empty folder; nested
+	// empty folders are trashed along with them. Empty for a dry run.
+	Trashed []FileResult `json:"trashed,omitempty"`
+}
+
+// FindEmptyFolders scans the subtree below folderID for folders that contain nothing but
+// other empty folders. Unless dryRun is set, the outermost empty folders are moved to the
+// trash. The scanned folder itself is never trashed.
+func (ds *DriveService) FindEmptyFolders(ctx context.Context, folderID string, dryRun bool) (*EmptyFoldersReport, error) {
+	if folderID == "" {
+		return nil, errors.New("folder ID is empty")
+	}
+	if err := ds.checkScope(ctx, folderID); err != nil {
+		return nil, err
+	}
+
+	root, err := ds.walkSubtree(ctx, folderID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// A folder is empty when it holds no files and all of its subfolders are empty
+	empty := make(map[*folderNode]bool)
+	var markEmpty func(node *folderNode) bool
+	markEmpty = func(node *folderNode) bool {
+		isEmpty := !node.hasFiles
+		for _, child := range node.children {
+			if !markEmpty(child) {
+				isEmpty = false
+			}
+		}
+		empty[node] = isEmpty
+		return isEmpty
+	}
+	markEmpty(root)
+
+	report := &EmptyFoldersReport{Folders: []EmptyFolder{}}
+	var outermost []*folderNode
+	var collect func(node *folderNode, inEmpty bool)
+	collect = func(node *folderNode, inEmpty bool) {
+		for _, child := range node.children {
+			if empty[child] {
+				report.Folders = append(report.Folders, EmptyFolder{ID: child.id, Name: child.name, Path: child.path})
+				if !inEmpty {
+					outermost = append(outermost, child)
+				}
+			}
+			collect(child, empty[child])
+		}
+	}
+	collect(root, false)
+
+	if dryRun || len(outermost) == 0 {
+		return report, nil
+	}
+
+	report.Trashed = make([]FileResult, len(outermost))
+	err = forEachConcurrent(ctx, ds.parallelism, len(outermost), func(ctx context.Context, i int) error {
+		report.Trashed[i].ID = outermost[i].id
+		if err := ds.trashFile(ctx, outermost[i].id); err != nil {
+			report.Trashed[i].Error = err.Error()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// trashFile moves a file or folder to the trash
+func (ds *DriveService) trashFile(ctx context.Context, fileID string) error {
+	if err := ds.checkWrite(ctx, fileID); err != nil {
+		return err
+	}
+
+	_, err := ds.driveService.Files.Update(fileID, &drive.File{Trashed: true}).
+		Fields("id").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return fmt.Errorf("failed to trash file: %w", err)
+	}
+
+	return nil
+}
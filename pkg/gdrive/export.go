@@ -0,0 +1,94 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ExportedFile is a Google Workspace file converted to another format
+type ExportedFile struct {
+	FileID string `json:"fileId"`
+	// Name is the file's name with the extension of the format it was exported as
+	Name     string `json:"name"`
+	MimeType string `json:"mimeType"`
+	Size     int64  `json:"size"`
+	// Content is encoded as base64 in JSON
+	Content []byte `json:"content"`
+}
+
+// ExportFile converts a Google Docs, Sheets, Slides, or Drawings file to format, given as a file name extension
+// such as "pdf", "docx", "xlsx", or "csv" (which holds the first sheet only). An empty format uses the matching
+// Office format, or PNG for drawings. Drive exports at most 10MB.
+func (ds *DriveService) ExportFile(ctx context.Context, fileID, format string) (*ExportedFile, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Get(fileID).
+		Fields("id, name, mimeType").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	format, mimeType, err := exportTarget(file.MimeType, file.Id, format, defaultExportFormats)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := ds.exportFile(ctx, file.Id, mimeType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportedFile{
+		FileID:   file.Id,
+		Name:     file.Name + "." + format,
+		MimeType: mimeType,
+		Size:     int64(len(content)),
+		Content:  content,
+	}, nil
+}
+
+// exportTarget returns the format, given as a file name extension, and MIME type a Google Workspace file of mimeType
+// is exported as. An empty format uses the file type's format in defaults.
+func exportTarget(mimeType, fileID, format string, defaults map[string]string) (string, string, error) {
+	formats, ok := exportFormats[mimeType]
+	if !ok {
+		return "", "", fmt.Errorf("%s is not a Google Docs, Sheets, Slides, or Drawings file (%s) and cannot be exported", fileID, mimeType)
+	}
+	format = strings.TrimPrefix(strings.ToLower(format), ".")
+	if format == "" {
+		format = defaults[mimeType]
+	}
+	exportType, ok := formats[format]
+	if !ok {
+		names := make([]string, 0, len(formats))
+		for name := range formats {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return "", "", fmt.Errorf("a %s cannot be exported as %q (supported: %s)", exportKind(mimeType), format, strings.Join(names, ", "))
+	}
+	return format, exportType, nil
+}
+
+// exportKind returns the kind of Google Workspace file of mimeType, as named in FolderArchiveOptions.Formats
+func exportKind(mimeType string) string {
+	for kind, kindType := range workspaceKinds {
+		if kindType == mimeType {
+			return kind
+		}
+	}
+	return mimeType
+}
@@ -0,0 +1,82 @@
+package gdrive
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// defaultFileFields are the file fields always requested by listing calls
+const defaultFileFields = "id, name, mimeType"
+
+// fileFieldPattern matches a Drive file field name with an optional sub-selection, e.g. "owners(emailAddress)"
+var fileFieldPattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*(\([a-zA-Z0-9, ()/]*\))?$`)
+
+// fileFields builds a file field mask from the default and the requested extra fields
+func fileFields(extraFields []string) (string, error) {
+	fields := defaultFileFields
+	for _, field := range extraFields {
+		field = strings.TrimSpace(field)
+		if !fileFieldPattern.MatchString(field) {
+			return "", fmt.Errorf("invalid file field %q", field)
+		}
+		fields += ", " + field
+	}
+	return fields, nil
+}
+
+// fileFieldsMask builds the files(...) part of a list field mask from the default and the requested extra fields
+func fileFieldsMask(extraFields []string) (string, error) {
+	fields, err := fileFields(extraFields)
+	if err != nil {
+		return "", err
+	}
+	return "files(" + fields + ")", nil
+}
+
+// newDriveFile converts a Drive API file to a DriveFile, copying the requested extra fields
+func newDriveFile(file *drive.File, extraFields []string) (DriveFile, error) {
+	driveFile := DriveFile{
+		ID:   file.Id,
+		Name: file.Name,
+		Type: file.MimeType,
+	}
+	if len(extraFields) == 0 {
+		return driveFile, nil
+	}
+
+	// Round-trip through JSON to look up fields by their API names
+	data, err := file.MarshalJSON()
+	if err != nil {
+		return DriveFile{}, fmt.Errorf("failed to encode file: %w", err)
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return DriveFile{}, fmt.Errorf("failed to decode file: %w", err)
+	}
+
+	driveFile.Fields = make(map[string]any)
+	for _, field := range extraFields {
+		name, _, _ := strings.Cut(strings.TrimSpace(field), "(")
+		if value, ok := raw[name]; ok {
+			driveFile.Fields[name] = value
+		}
+	}
+
+	return driveFile, nil
+}
+
+// MarshalJSON encodes the file with its extra fields flattened next to id, name, and mimeType
+func (f DriveFile) MarshalJSON() ([]byte, error) {
+	result := make(map[string]any, len(f.Fields)+3)
+	for name, value := range f.Fields {
+		result[name] = value
+	}
+	result["id"] = f.ID
+	result["name"] = f.Name
+	result["mimeType"] = f.Type
+	return json.Marshal(result)
+}
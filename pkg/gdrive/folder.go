@@ -0,0 +1,60 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// createdFolderFields are the extra fields returned for a created folder, so callers can open it and see where it was placed
+var createdFolderFields = []string{"webViewLink", "parents"}
+
+// CreateFolder creates a folder named name inside parentID. An empty parentID creates it in My Drive root, or in the
+// root folder the server is confined to; when an output folder is set, the folder is created there instead.
+func (ds *DriveService) CreateFolder(ctx context.Context, name, parentID string) (*DriveFile, error) {
+	if name == "" {
+		return nil, errors.New("folder name is empty")
+	}
+	if err := ds.checkScope(ctx, parentID); err != nil {
+		return nil, err
+	}
+
+	var parents []string
+	if parent := ds.folderOrRoot(parentID); parent != "" {
+		parents = []string{parent}
+	}
+	parents, err := ds.outputParents(ctx, parents)
+	if err != nil {
+		return nil, err
+	}
+	writeTo := parents
+	if len(writeTo) == 0 {
+		writeTo = []string{"root"}
+	}
+	if err := ds.checkWrite(ctx, writeTo...); err != nil {
+		return nil, err
+	}
+
+	fields, err := fileFields(createdFolderFields)
+	if err != nil {
+		return nil, err
+	}
+
+	folder, err := ds.driveService.Files.Create(&drive.File{Name: name, MimeType: folderMimeType, Parents: parents}).
+		Fields(googleapi.Field(fields)).
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	driveFile, err := newDriveFile(folder, createdFolderFields)
+	if err != nil {
+		return nil, err
+	}
+	return &driveFile, nil
+}
@@ -0,0 +1,5089 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package gdrivemock
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/slides/v1"
+	"sync"
+)
+
+// Ensure, that FileStoreMock does implement gdrive.FileStore.
+// If this is not the case, regenerate this file with moq.
+var _ gdrive.FileStore = &FileStoreMock{}
+
+// FileStoreMock is a mock implementation of gdrive.FileStore.
+//
+//	func TestSomethingThatUsesFileStore(t *testing.T) {
+//
+//		// make and configure a mocked gdrive.FileStore
+//		mockedFileStore := &FileStoreMock{
+//			DownloadFileChunkFunc: func(ctx context.Context, fileID string, continuationToken string, chunkSize int64) (*gdrive.FileChunk, error) {
+//				panic("mock out the DownloadFileChunk method")
+//			},
+//			ExportFileFunc: func(ctx context.Context, fileID string, format string) (*gdrive.ExportedFile, error) {
+//				panic("mock out the ExportFile method")
+//			},
+//			ExtractPDFTextFunc: func(ctx context.Context, fileID string, ocrLanguage string, perPage bool) (*gdrive.PDFText, error) {
+//				panic("mock out the ExtractPDFText method")
+//			},
+//			FindDuplicatesFunc: func(ctx context.Context, query gdrive.DuplicatesQuery) (*gdrive.DuplicatesReport, error) {
+//				panic("mock out the FindDuplicates method")
+//			},
+//			GetFileActivityFunc: func(ctx context.Context, fileID string, query gdrive.ActivityQuery, opts gdrive.ListOptions) (*gdrive.ActivityList, error) {
+//				panic("mock out the GetFileActivity method")
+//			},
+//			GetFileCapabilitiesFunc: func(ctx context.Context, fileID string) (*gdrive.FileCapabilities, error) {
+//				panic("mock out the GetFileCapabilities method")
+//			},
+//			GetFileLabelsFunc: func(ctx context.Context, fileID string) (*gdrive.FileLabels, error) {
+//				panic("mock out the GetFileLabels method")
+//			},
+//			GetFileMetadataFunc: func(ctx context.Context, fileID string) (*gdrive.FileMetadata, error) {
+//				panic("mock out the GetFileMetadata method")
+//			},
+//			GetFileParentsFunc: func(ctx context.Context, fileID string) (*gdrive.FileParents, error) {
+//				panic("mock out the GetFileParents method")
+//			},
+//			GetFilePropertiesFunc: func(ctx context.Context, fileID string) (*gdrive.FileProperties, error) {
+//				panic("mock out the GetFileProperties method")
+//			},
+//			GetFilesMetadataFunc: func(ctx context.Context, fileIDs []string, extraFields []string) ([]gdrive.FileResult, error) {
+//				panic("mock out the GetFilesMetadata method")
+//			},
+//			GetRevisionContentFunc: func(ctx context.Context, fileID string, revisionID string, format string) (*gdrive.RevisionContent, error) {
+//				panic("mock out the GetRevisionContent method")
+//			},
+//			GetStartPageTokenFunc: func(ctx context.Context) (string, error) {
+//				panic("mock out the GetStartPageToken method")
+//			},
+//			GetThumbnailFunc: func(ctx context.Context, fileID string, slideIndex *int, size string) (*gdrive.Thumbnail, error) {
+//				panic("mock out the GetThumbnail method")
+//			},
+//			ListChangesFunc: func(ctx context.Context, opts gdrive.ListOptions) (*gdrive.ChangeList, error) {
+//				panic("mock out the ListChanges method")
+//			},
+//			ListCommentsFunc: func(ctx context.Context, fileID string, includeResolved bool, opts gdrive.ListOptions) (*gdrive.CommentList, error) {
+//				panic("mock out the ListComments method")
+//			},
+//			ListFilesFunc: func(ctx context.Context, folderID string, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+//				panic("mock out the ListFiles method")
+//			},
+//			ListLabelsFunc: func(ctx context.Context, opts gdrive.ListOptions) (*gdrive.LabelList, error) {
+//				panic("mock out the ListLabels method")
+//			},
+//			ListLargestFilesFunc: func(ctx context.Context, query gdrive.LargestFilesQuery, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+//				panic("mock out the ListLargestFiles method")
+//			},
+//			ListModifiedFilesFunc: func(ctx context.Context, query gdrive.ModifiedFilesQuery, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+//				panic("mock out the ListModifiedFiles method")
+//			},
+//			ListPermissionsFunc: func(ctx context.Context, fileID string, opts gdrive.ListOptions) (*gdrive.PermissionList, error) {
+//				panic("mock out the ListPermissions method")
+//			},
+//			ListRecentFilesFunc: func(ctx context.Context, viewed bool, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+//				panic("mock out the ListRecentFiles method")
+//			},
+//			ListRevisionsFunc: func(ctx context.Context, fileID string, opts gdrive.ListOptions) (*gdrive.RevisionList, error) {
+//				panic("mock out the ListRevisions method")
+//			},
+//			ListSharedDrivesFunc: func(ctx context.Context, opts gdrive.ListOptions) (*gdrive.SharedDriveList, error) {
+//				panic("mock out the ListSharedDrives method")
+//			},
+//			ListStarredFilesFunc: func(ctx context.Context, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+//				panic("mock out the ListStarredFiles method")
+//			},
+//			ListTrashedFilesFunc: func(ctx context.Context, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+//				panic("mock out the ListTrashedFiles method")
+//			},
+//			ResolvePathFunc: func(ctx context.Context, path string, driveID string) (*gdrive.ResolvedPath, error) {
+//				panic("mock out the ResolvePath method")
+//			},
+//			ResolveShortcutFunc: func(ctx context.Context, fileID string) (*gdrive.ShortcutInfo, error) {
+//				panic("mock out the ResolveShortcut method")
+//			},
+//			SaveFileFunc: func(ctx context.Context, fileID string, localPath string, format string, overwrite bool) (*gdrive.SavedFile, error) {
+//				panic("mock out the SaveFile method")
+//			},
+//			SearchFilesFunc: func(ctx context.Context, query string, filter gdrive.SearchFilter, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+//				panic("mock out the SearchFiles method")
+//			},
+//			SearchFilesByPropertiesFunc: func(ctx context.Context, properties map[string]string, appProperties bool, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+//				panic("mock out the SearchFilesByProperties method")
+//			},
+//			SearchFilesWithSnippetsFunc: func(ctx context.Context, query string, filter gdrive.SearchFilter, opts gdrive.ListOptions) (*gdrive.SearchResultList, error) {
+//				panic("mock out the SearchFilesWithSnippets method")
+//			},
+//			VerifyFileFunc: func(ctx context.Context, fileID string, expectedHash string) (*gdrive.FileIntegrity, error) {
+//				panic("mock out the VerifyFile method")
+//			},
+//		}
+//
+//		// use mockedFileStore in code that requires gdrive.FileStore
+//		// and then make assertions.
+//
+//	}
+type FileStoreMock struct {
+	// DownloadFileChunkFunc mocks the DownloadFileChunk method.
+	DownloadFileChunkFunc func(ctx context.Context, fileID string, continuationToken string, chunkSize int64) (*gdrive.FileChunk, error)
+
+	// ExportFileFunc mocks the ExportFile method.
+	ExportFileFunc func(ctx context.Context, fileID string, format string) (*gdrive.ExportedFile, error)
+
+	// ExtractPDFTextFunc mocks the ExtractPDFText method.
+	ExtractPDFTextFunc func(ctx context.Context, fileID string, ocrLanguage string, perPage bool) (*gdrive.PDFText, error)
+
+	// FindDuplicatesFunc mocks the FindDuplicates method.
+	FindDuplicatesFunc func(ctx context.Context, query gdrive.DuplicatesQuery) (*gdrive.DuplicatesReport, error)
+
+	// GetFileActivityFunc mocks the GetFileActivity method.
+	GetFileActivityFunc func(ctx context.Context, fileID string, query gdrive.ActivityQuery, opts gdrive.ListOptions) (*gdrive.ActivityList, error)
+
+	// GetFileCapabilitiesFunc mocks the GetFileCapabilities method.
+	GetFileCapabilitiesFunc func(ctx context.Context, fileID string) (*gdrive.FileCapabilities, error)
+
+	// GetFileLabelsFunc mocks the GetFileLabels method.
+	GetFileLabelsFunc func(ctx context.Context, fileID string) (*gdrive.FileLabels, error)
+
+	// GetFileMetadataFunc mocks the GetFileMetadata method.
+	GetFileMetadataFunc func(ctx context.Context, fileID string) (*gdrive.FileMetadata, error)
+
+	// GetFileParentsFunc mocks the GetFileParents method.
+	GetFileParentsFunc func(ctx context.Context, fileID string) (*gdrive.FileParents, error)
+
+	// GetFilePropertiesFunc mocks the GetFileProperties method.
+	GetFilePropertiesFunc func(ctx context.Context, fileID string) (*gdrive.FileProperties, error)
+
+	// GetFilesMetadataFunc mocks the GetFilesMetadata method.
+	GetFilesMetadataFunc func(ctx context.Context, fileIDs []string, extraFields []string) ([]gdrive.FileResult, error)
+
+	// GetRevisionContentFunc mocks the GetRevisionContent method.
+	GetRevisionContentFunc func(ctx context.Context, fileID string, revisionID string, format string) (*gdrive.RevisionContent, error)
+
+	// GetStartPageTokenFunc mocks the GetStartPageToken method.
+	GetStartPageTokenFunc func(ctx context.Context) (string, error)
+
+	// GetThumbnailFunc mocks the GetThumbnail method.
+	GetThumbnailFunc func(ctx context.Context, fileID string, slideIndex *int, size string) (*gdrive.Thumbnail, error)
+
+	// ListChangesFunc mocks the ListChanges method.
+	ListChangesFunc func(ctx context.Context, opts gdrive.ListOptions) (*gdrive.ChangeList, error)
+
+	// ListCommentsFunc mocks the ListComments method.
+	ListCommentsFunc func(ctx context.Context, fileID string, includeResolved bool, opts gdrive.ListOptions) (*gdrive.CommentList, error)
+
+	// ListFilesFunc mocks the ListFiles method.
+	ListFilesFunc func(ctx context.Context, folderID string, opts gdrive.ListOptions) (*gdrive.FileList, error)
+
+	// ListLabelsFunc mocks the ListLabels method.
+	ListLabelsFunc func(ctx context.Context, opts gdrive.ListOptions) (*gdrive.LabelList, error)
+
+	// ListLargestFilesFunc mocks the ListLargestFiles method.
+	ListLargestFilesFunc func(ctx context.Context, query gdrive.LargestFilesQuery, opts gdrive.ListOptions) (*gdrive.FileList, error)
+
+	// ListModifiedFilesFunc mocks the ListModifiedFiles method.
+	ListModifiedFilesFunc func(ctx context.Context, query gdrive.ModifiedFilesQuery, opts gdrive.ListOptions) (*gdrive.FileList, error)
+
+	// ListPermissionsFunc mocks the ListPermissions method.
+	ListPermissionsFunc func(ctx context.Context, fileID string, opts gdrive.ListOptions) (*gdrive.PermissionList, error)
+
+	// ListRecentFilesFunc mocks the ListRecentFiles method.
+	ListRecentFilesFunc func(ctx context.Context, viewed bool, opts gdrive.ListOptions) (*gdrive.FileList, error)
+
+	// ListRevisionsFunc mocks the ListRevisions method.
+	ListRevisionsFunc func(ctx context.Context, fileID string, opts gdrive.ListOptions) (*gdrive.RevisionList, error)
+
+	// ListSharedDrivesFunc mocks the ListSharedDrives method.
+	ListSharedDrivesFunc func(ctx context.Context, opts gdrive.ListOptions) (*gdrive.SharedDriveList, error)
+
+	// ListStarredFilesFunc mocks the ListStarredFiles method.
+	ListStarredFilesFunc func(ctx context.Context, opts gdrive.ListOptions) (*gdrive.FileList, error)
+
+	// ListTrashedFilesFunc mocks the ListTrashedFiles method.
+	ListTrashedFilesFunc func(ctx context.Context, opts gdrive.ListOptions) (*gdrive.FileList, error)
+
+	// ResolvePathFunc mocks the ResolvePath method.
+	ResolvePathFunc func(ctx context.Context, path string, driveID string) (*gdrive.ResolvedPath, error)
+
+	// ResolveShortcutFunc mocks the ResolveShortcut method.
+	ResolveShortcutFunc func(ctx context.Context, fileID string) (*gdrive.ShortcutInfo, error)
+
+	// SaveFileFunc mocks the SaveFile method.
+	SaveFileFunc func(ctx context.Context, fileID string, localPath string, format string, overwrite bool) (*gdrive.SavedFile, error)
+
+	// SearchFilesFunc mocks the SearchFiles method.
+	SearchFilesFunc func(ctx context.Context, query string, filter gdrive.SearchFilter, opts gdrive.ListOptions) (*gdrive.FileList, error)
+
+	// SearchFilesByPropertiesFunc mocks the SearchFilesByProperties method.
+	SearchFilesByPropertiesFunc func(ctx context.Context, properties map[string]string, appProperties bool, opts gdrive.ListOptions) (*gdrive.FileList, error)
+
+	// SearchFilesWithSnippetsFunc mocks the SearchFilesWithSnippets method.
+	SearchFilesWithSnippetsFunc func(ctx context.Context, query string, filter gdrive.SearchFilter, opts gdrive.ListOptions) (*gdrive.SearchResultList, error)
+
+	// VerifyFileFunc mocks the VerifyFile method.
+	VerifyFileFunc func(ctx context.Context, fileID string, expectedHash string) (*gdrive.FileIntegrity, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DownloadFileChunk holds details about calls to the DownloadFileChunk method.
+		DownloadFileChunk []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// ContinuationToken is the continuationToken argument value.
+			ContinuationToken string
+			// ChunkSize is the chunkSize argument value.
+			ChunkSize int64
+		}
+		// ExportFile holds details about calls to the ExportFile method.
+		ExportFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// Format is the format argument value.
+			Format string
+		}
+		// ExtractPDFText holds details about calls to the ExtractPDFText method.
+		ExtractPDFText []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// OcrLanguage is the ocrLanguage argument value.
+			OcrLanguage string
+			// PerPage is the perPage argument value.
+			PerPage bool
+		}
+		// FindDuplicates holds details about calls to the FindDuplicates method.
+		FindDuplicates []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query gdrive.DuplicatesQuery
+		}
+		// GetFileActivity holds details about calls to the GetFileActivity method.
+		GetFileActivity []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// Query is the query argument value.
+			Query gdrive.ActivityQuery
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// GetFileCapabilities holds details about calls to the GetFileCapabilities method.
+		GetFileCapabilities []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+		}
+		// GetFileLabels holds details about calls to the GetFileLabels method.
+		GetFileLabels []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+		}
+		// GetFileMetadata holds details about calls to the GetFileMetadata method.
+		GetFileMetadata []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+		}
+		// GetFileParents holds details about calls to the GetFileParents method.
+		GetFileParents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+		}
+		// GetFileProperties holds details about calls to the GetFileProperties method.
+		GetFileProperties []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+		}
+		// GetFilesMetadata holds details about calls to the GetFilesMetadata method.
+		GetFilesMetadata []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileIDs is the fileIDs argument value.
+			FileIDs []string
+			// ExtraFields is the extraFields argument value.
+			ExtraFields []string
+		}
+		// GetRevisionContent holds details about calls to the GetRevisionContent method.
+		GetRevisionContent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// RevisionID is the revisionID argument value.
+			RevisionID string
+			// Format is the format argument value.
+			Format string
+		}
+		// GetStartPageToken holds details about calls to the GetStartPageToken method.
+		GetStartPageToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetThumbnail holds details about calls to the GetThumbnail method.
+		GetThumbnail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// SlideIndex is the slideIndex argument value.
+			SlideIndex *int
+			// Size is the size argument value.
+			Size string
+		}
+		// ListChanges holds details about calls to the ListChanges method.
+		ListChanges []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// ListComments holds details about calls to the ListComments method.
+		ListComments []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// IncludeResolved is the includeResolved argument value.
+			IncludeResolved bool
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// ListFiles holds details about calls to the ListFiles method.
+		ListFiles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FolderID is the folderID argument value.
+			FolderID string
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// ListLabels holds details about calls to the ListLabels method.
+		ListLabels []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// ListLargestFiles holds details about calls to the ListLargestFiles method.
+		ListLargestFiles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query gdrive.LargestFilesQuery
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// ListModifiedFiles holds details about calls to the ListModifiedFiles method.
+		ListModifiedFiles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query gdrive.ModifiedFilesQuery
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// ListPermissions holds details about calls to the ListPermissions method.
+		ListPermissions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// ListRecentFiles holds details about calls to the ListRecentFiles method.
+		ListRecentFiles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Viewed is the viewed argument value.
+			Viewed bool
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// ListRevisions holds details about calls to the ListRevisions method.
+		ListRevisions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// ListSharedDrives holds details about calls to the ListSharedDrives method.
+		ListSharedDrives []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// ListStarredFiles holds details about calls to the ListStarredFiles method.
+		ListStarredFiles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// ListTrashedFiles holds details about calls to the ListTrashedFiles method.
+		ListTrashedFiles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// ResolvePath holds details about calls to the ResolvePath method.
+		ResolvePath []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Path is the path argument value.
+			Path string
+			// DriveID is the driveID argument value.
+			DriveID string
+		}
+		// ResolveShortcut holds details about calls to the ResolveShortcut method.
+		ResolveShortcut []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+		}
+		// SaveFile holds details about calls to the SaveFile method.
+		SaveFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// LocalPath is the localPath argument value.
+			LocalPath string
+			// Format is the format argument value.
+			Format string
+			// Overwrite is the overwrite argument value.
+			Overwrite bool
+		}
+		// SearchFiles holds details about calls to the SearchFiles method.
+		SearchFiles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+			// Filter is the filter argument value.
+			Filter gdrive.SearchFilter
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// SearchFilesByProperties holds details about calls to the SearchFilesByProperties method.
+		SearchFilesByProperties []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Properties is the properties argument value.
+			Properties map[string]string
+			// AppProperties is the appProperties argument value.
+			AppProperties bool
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// SearchFilesWithSnippets holds details about calls to the SearchFilesWithSnippets method.
+		SearchFilesWithSnippets []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+			// Filter is the filter argument value.
+			Filter gdrive.SearchFilter
+			// Opts is the opts argument value.
+			Opts gdrive.ListOptions
+		}
+		// VerifyFile holds details about calls to the VerifyFile method.
+		VerifyFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// ExpectedHash is the expectedHash argument value.
+			ExpectedHash string
+		}
+	}
+	lockDownloadFileChunk       sync.RWMutex
+	lockExportFile              sync.RWMutex
+	lockExtractPDFText          sync.RWMutex
+	lockFindDuplicates          sync.RWMutex
+	lockGetFileActivity         sync.RWMutex
+	lockGetFileCapabilities     sync.RWMutex
+	lockGetFileLabels           sync.RWMutex
+	lockGetFileMetadata         sync.RWMutex
+	lockGetFileParents          sync.RWMutex
+	lockGetFileProperties       sync.RWMutex
+	lockGetFilesMetadata        sync.RWMutex
+	lockGetRevisionContent      sync.RWMutex
+	lockGetStartPageToken       sync.RWMutex
+	lockGetThumbnail            sync.RWMutex
+	lockListChanges             sync.RWMutex
+	lockListComments            sync.RWMutex
+	lockListFiles               sync.RWMutex
+	lockListLabels              sync.RWMutex
+	lockListLargestFiles        sync.RWMutex
+	lockListModifiedFiles       sync.RWMutex
+	lockListPermissions         sync.RWMutex
+	lockListRecentFiles         sync.RWMutex
+	lockListRevisions           sync.RWMutex
+	lockListSharedDrives        sync.RWMutex
+	lockListStarredFiles        sync.RWMutex
+	lockListTrashedFiles        sync.RWMutex
+	lockResolvePath             sync.RWMutex
+	lockResolveShortcut         sync.RWMutex
+	lockSaveFile                sync.RWMutex
+	lockSearchFiles             sync.RWMutex
+	lockSearchFilesByProperties sync.RWMutex
+	lockSearchFilesWithSnippets sync.RWMutex
+	lockVerifyFile              sync.RWMutex
+}
+
+// DownloadFileChunk calls DownloadFileChunkFunc.
+func (mock *FileStoreMock) DownloadFileChunk(ctx context.Context, fileID string, continuationToken string, chunkSize int64) (*gdrive.FileChunk, error) {
+	if mock.DownloadFileChunkFunc == nil {
+		panic("FileStoreMock.DownloadFileChunkFunc: method is nil but FileStore.DownloadFileChunk was just called")
+	}
+	callInfo := struct {
+		Ctx               context.Context
+		FileID            string
+		ContinuationToken string
+		ChunkSize         int64
+	}{
+		Ctx:               ctx,
+		FileID:            fileID,
+		ContinuationToken: continuationToken,
+		ChunkSize:         chunkSize,
+	}
+	mock.lockDownloadFileChunk.Lock()
+	mock.calls.DownloadFileChunk = append(mock.calls.DownloadFileChunk, callInfo)
+	mock.lockDownloadFileChunk.Unlock()
+	return mock.DownloadFileChunkFunc(ctx, fileID, continuationToken, chunkSize)
+}
+
+// DownloadFileChunkCalls gets all the calls that were made to DownloadFileChunk.
+// Check the length with:
+//
+//	len(mockedFileStore.DownloadFileChunkCalls())
+func (mock *FileStoreMock) DownloadFileChunkCalls() []struct {
+	Ctx               context.Context
+	FileID            string
+	ContinuationToken string
+	ChunkSize         int64
+} {
+	var calls []struct {
+		Ctx               context.Context
+		FileID            string
+		ContinuationToken string
+		ChunkSize         int64
+	}
+	mock.lockDownloadFileChunk.RLock()
+	calls = mock.calls.DownloadFileChunk
+	mock.lockDownloadFileChunk.RUnlock()
+	return calls
+}
+
+// ExportFile calls ExportFileFunc.
+func (mock *FileStoreMock) ExportFile(ctx context.Context, fileID string, format string) (*gdrive.ExportedFile, error) {
+	if mock.ExportFileFunc == nil {
+		panic("FileStoreMock.ExportFileFunc: method is nil but FileStore.ExportFile was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID string
+		Format string
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+		Format: format,
+	}
+	mock.lockExportFile.Lock()
+	mock.calls.ExportFile = append(mock.calls.ExportFile, callInfo)
+	mock.lockExportFile.Unlock()
+	return mock.ExportFileFunc(ctx, fileID, format)
+}
+
+// ExportFileCalls gets all the calls that were made to ExportFile.
+// Check the length with:
+//
+//	len(mockedFileStore.ExportFileCalls())
+func (mock *FileStoreMock) ExportFileCalls() []struct {
+	Ctx    context.Context
+	FileID string
+	Format string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID string
+		Format string
+	}
+	mock.lockExportFile.RLock()
+	calls = mock.calls.ExportFile
+	mock.lockExportFile.RUnlock()
+	return calls
+}
+
+// ExtractPDFText calls ExtractPDFTextFunc.
+func (mock *FileStoreMock) ExtractPDFText(ctx context.Context, fileID string, ocrLanguage string, perPage bool) (*gdrive.PDFText, error) {
+	if mock.ExtractPDFTextFunc == nil {
+		panic("FileStoreMock.ExtractPDFTextFunc: method is nil but FileStore.ExtractPDFText was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		FileID      string
+		OcrLanguage string
+		PerPage     bool
+	}{
+		Ctx:         ctx,
+		FileID:      fileID,
+		OcrLanguage: ocrLanguage,
+		PerPage:     perPage,
+	}
+	mock.lockExtractPDFText.Lock()
+	mock.calls.ExtractPDFText = append(mock.calls.ExtractPDFText, callInfo)
+	mock.lockExtractPDFText.Unlock()
+	return mock.ExtractPDFTextFunc(ctx, fileID, ocrLanguage, perPage)
+}
+
+// ExtractPDFTextCalls gets all the calls that were made to ExtractPDFText.
+// Check the length with:
+//
+//	len(mockedFileStore.ExtractPDFTextCalls())
+func (mock *FileStoreMock) ExtractPDFTextCalls() []struct {
+	Ctx         context.Context
+	FileID      string
+	OcrLanguage string
+	PerPage     bool
+} {
+	var calls []struct {
+		Ctx         context.Context
+		FileID      string
+		OcrLanguage string
+		PerPage     bool
+	}
+	mock.lockExtractPDFText.RLock()
+	calls = mock.calls.ExtractPDFText
+	mock.lockExtractPDFText.RUnlock()
+	return calls
+}
+
+// FindDuplicates calls FindDuplicatesFunc.
+func (mock *FileStoreMock) FindDuplicates(ctx context.Context, query gdrive.DuplicatesQuery) (*gdrive.DuplicatesReport, error) {
+	if mock.FindDuplicatesFunc == nil {
+		panic("FileStoreMock.FindDuplicatesFunc: method is nil but FileStore.FindDuplicates was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Query gdrive.DuplicatesQuery
+	}{
+		Ctx:   ctx,
+		Query: query,
+	}
+	mock.lockFindDuplicates.Lock()
+	mock.calls.FindDuplicates = append(mock.calls.FindDuplicates, callInfo)
+	mock.lockFindDuplicates.Unlock()
+	return mock.FindDuplicatesFunc(ctx, query)
+}
+
+// FindDuplicatesCalls gets all the calls that were made to FindDuplicates.
+// Check the length with:
+//
+//	len(mockedFileStore.FindDuplicatesCalls())
+func (mock *FileStoreMock) FindDuplicatesCalls() []struct {
+	Ctx   context.Context
+	Query gdrive.DuplicatesQuery
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Query gdrive.DuplicatesQuery
+	}
+	mock.lockFindDuplicates.RLock()
+	calls = mock.calls.FindDuplicates
+	mock.lockFindDuplicates.RUnlock()
+	return calls
+}
+
+// GetFileActivity calls GetFileActivityFunc.
+func (mock *FileStoreMock) GetFileActivity(ctx context.Context, fileID string, query gdrive.ActivityQuery, opts gdrive.ListOptions) (*gdrive.ActivityList, error) {
+	if mock.GetFileActivityFunc == nil {
+		panic("FileStoreMock.GetFileActivityFunc: method is nil but FileStore.GetFileActivity was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID string
+		Query  gdrive.ActivityQuery
+		Opts   gdrive.ListOptions
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+		Query:  query,
+		Opts:   opts,
+	}
+	mock.lockGetFileActivity.Lock()
+	mock.calls.GetFileActivity = append(mock.calls.GetFileActivity, callInfo)
+	mock.lockGetFileActivity.Unlock()
+	return mock.GetFileActivityFunc(ctx, fileID, query, opts)
+}
+
+// GetFileActivityCalls gets all the calls that were made to GetFileActivity.
+// Check the length with:
+//
+//	len(mockedFileStore.GetFileActivityCalls())
+func (mock *FileStoreMock) GetFileActivityCalls() []struct {
+	Ctx    context.Context
+	FileID string
+	Query  gdrive.ActivityQuery
+	Opts   gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID string
+		Query  gdrive.ActivityQuery
+		Opts   gdrive.ListOptions
+	}
+	mock.lockGetFileActivity.RLock()
+	calls = mock.calls.GetFileActivity
+	mock.lockGetFileActivity.RUnlock()
+	return calls
+}
+
+// GetFileCapabilities calls GetFileCapabilitiesFunc.
+func (mock *FileStoreMock) GetFileCapabilities(ctx context.Context, fileID string) (*gdrive.FileCapabilities, error) {
+	if mock.GetFileCapabilitiesFunc == nil {
+		panic("FileStoreMock.GetFileCapabilitiesFunc: method is nil but FileStore.GetFileCapabilities was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID string
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+	}
+	mock.lockGetFileCapabilities.Lock()
+	mock.calls.GetFileCapabilities = append(mock.calls.GetFileCapabilities, callInfo)
+	mock.lockGetFileCapabilities.Unlock()
+	return mock.GetFileCapabilitiesFunc(ctx, fileID)
+}
+
+// GetFileCapabilitiesCalls gets all the calls that were made to GetFileCapabilities.
+// Check the length with:
+//
+//	len(mockedFileStore.GetFileCapabilitiesCalls())
+func (mock *FileStoreMock) GetFileCapabilitiesCalls() []struct {
+	Ctx    context.Context
+	FileID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID string
+	}
+	mock.lockGetFileCapabilities.RLock()
+	calls = mock.calls.GetFileCapabilities
+	mock.lockGetFileCapabilities.RUnlock()
+	return calls
+}
+
+// GetFileLabels calls GetFileLabelsFunc.
+func (mock *FileStoreMock) GetFileLabels(ctx context.Context, fileID string) (*gdrive.FileLabels, error) {
+	if mock.GetFileLabelsFunc == nil {
+		panic("FileStoreMock.GetFileLabelsFunc: method is nil but FileStore.GetFileLabels was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID string
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+	}
+	mock.lockGetFileLabels.Lock()
+	mock.calls.GetFileLabels = append(mock.calls.GetFileLabels, callInfo)
+	mock.lockGetFileLabels.Unlock()
+	return mock.GetFileLabelsFunc(ctx, fileID)
+}
+
+// GetFileLabelsCalls gets all the calls that were made to GetFileLabels.
+// Check the length with:
+//
+//	len(mockedFileStore.GetFileLabelsCalls())
+func (mock *FileStoreMock) GetFileLabelsCalls() []struct {
+	Ctx    context.Context
+	FileID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID string
+	}
+	mock.lockGetFileLabels.RLock()
+	calls = mock.calls.GetFileLabels
+	mock.lockGetFileLabels.RUnlock()
+	return calls
+}
+
+// GetFileMetadata calls GetFileMetadataFunc.
+func (mock *FileStoreMock) GetFileMetadata(ctx context.Context, fileID string) (*gdrive.FileMetadata, error) {
+	if mock.GetFileMetadataFunc == nil {
+		panic("FileStoreMock.GetFileMetadataFunc: method is nil but FileStore.GetFileMetadata was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID string
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+	}
+	mock.lockGetFileMetadata.Lock()
+	mock.calls.GetFileMetadata = append(mock.calls.GetFileMetadata, callInfo)
+	mock.lockGetFileMetadata.Unlock()
+	return mock.GetFileMetadataFunc(ctx, fileID)
+}
+
+// GetFileMetadataCalls gets all the calls that were made to GetFileMetadata.
+// Check the length with:
+//
+//	len(mockedFileStore.GetFileMetadataCalls())
+func (mock *FileStoreMock) GetFileMetadataCalls() []struct {
+	Ctx    context.Context
+	FileID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID string
+	}
+	mock.lockGetFileMetadata.RLock()
+	calls = mock.calls.GetFileMetadata
+	mock.lockGetFileMetadata.RUnlock()
+	return calls
+}
+
+// GetFileParents calls GetFileParentsFunc.
+func (mock *FileStoreMock) GetFileParents(ctx context.Context, fileID string) (*gdrive.FileParents, error) {
+	if mock.GetFileParentsFunc == nil {
+		panic("FileStoreMock.GetFileParentsFunc: method is nil but FileStore.GetFileParents was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID string
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+	}
+	mock.lockGetFileParents.Lock()
+	mock.calls.GetFileParents = append(mock.calls.GetFileParents, callInfo)
+	mock.lockGetFileParents.Unlock()
+	return mock.GetFileParentsFunc(ctx, fileID)
+}
+
+// GetFileParentsCalls gets all the calls that were made to GetFileParents.
+// Check the length with:
+//
+//	len(mockedFileStore.GetFileParentsCalls())
+func (mock *FileStoreMock) GetFileParentsCalls() []struct {
+	Ctx    context.Context
+	FileID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID string
+	}
+	mock.lockGetFileParents.RLock()
+	calls = mock.calls.GetFileParents
+	mock.lockGetFileParents.RUnlock()
+	return calls
+}
+
+// GetFileProperties calls GetFilePropertiesFunc.
+func (mock *FileStoreMock) GetFileProperties(ctx context.Context, fileID string) (*gdrive.FileProperties, error) {
+	if mock.GetFilePropertiesFunc == nil {
+		panic("FileStoreMock.GetFilePropertiesFunc: method is nil but FileStore.GetFileProperties was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID string
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+	}
+	mock.lockGetFileProperties.Lock()
+	mock.calls.GetFileProperties = append(mock.calls.GetFileProperties, callInfo)
+	mock.lockGetFileProperties.Unlock()
+	return mock.GetFilePropertiesFunc(ctx, fileID)
+}
+
+// GetFilePropertiesCalls gets all the calls that were made to GetFileProperties.
+// Check the length with:
+//
+//	len(mockedFileStore.GetFilePropertiesCalls())
+func (mock *FileStoreMock) GetFilePropertiesCalls() []struct {
+	Ctx    context.Context
+	FileID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID string
+	}
+	mock.lockGetFileProperties.RLock()
+	calls = mock.calls.GetFileProperties
+	mock.lockGetFileProperties.RUnlock()
+	return calls
+}
+
+// GetFilesMetadata calls GetFilesMetadataFunc.
+func (mock *FileStoreMock) GetFilesMetadata(ctx context.Context, fileIDs []string, extraFields []string) ([]gdrive.FileResult, error) {
+	if mock.GetFilesMetadataFunc == nil {
+		panic("FileStoreMock.GetFilesMetadataFunc: method is nil but FileStore.GetFilesMetadata was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		FileIDs     []string
+		ExtraFields []string
+	}{
+		Ctx:         ctx,
+		FileIDs:     fileIDs,
+		ExtraFields: extraFields,
+	}
+	mock.lockGetFilesMetadata.Lock()
+	mock.calls.GetFilesMetadata = append(mock.calls.GetFilesMetadata, callInfo)
+	mock.lockGetFilesMetadata.Unlock()
+	return mock.GetFilesMetadataFunc(ctx, fileIDs, extraFields)
+}
+
+// GetFilesMetadataCalls gets all the calls that were made to GetFilesMetadata.
+// Check the length with:
+//
+//	len(mockedFileStore.GetFilesMetadataCalls())
+func (mock *FileStoreMock) GetFilesMetadataCalls() []struct {
+	Ctx         context.Context
+	FileIDs     []string
+	ExtraFields []string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		FileIDs     []string
+		ExtraFields []string
+	}
+	mock.lockGetFilesMetadata.RLock()
+	calls = mock.calls.GetFilesMetadata
+	mock.lockGetFilesMetadata.RUnlock()
+	return calls
+}
+
+// GetRevisionContent calls GetRevisionContentFunc.
+func (mock *FileStoreMock) GetRevisionContent(ctx context.Context, fileID string, revisionID string, format string) (*gdrive.RevisionContent, error) {
+	if mock.GetRevisionContentFunc == nil {
+		panic("FileStoreMock.GetRevisionContentFunc: method is nil but FileStore.GetRevisionContent was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		FileID     string
+		RevisionID string
+		Format     string
+	}{
+		Ctx:        ctx,
+		FileID:     fileID,
+		RevisionID: revisionID,
+		Format:     format,
+	}
+	mock.lockGetRevisionContent.Lock()
+	mock.calls.GetRevisionContent = append(mock.calls.GetRevisionContent, callInfo)
+	mock.lockGetRevisionContent.Unlock()
+	return mock.GetRevisionContentFunc(ctx, fileID, revisionID, format)
+}
+
+// GetRevisionContentCalls gets all the calls that were made to GetRevisionContent.
+// Check the length with:
+//
+//	len(mockedFileStore.GetRevisionContentCalls())
+func (mock *FileStoreMock) GetRevisionContentCalls() []struct {
+	Ctx        context.Context
+	FileID     string
+	RevisionID string
+	Format     string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		FileID     string
+		RevisionID string
+		Format     string
+	}
+	mock.lockGetRevisionContent.RLock()
+	calls = mock.calls.GetRevisionContent
+	mock.lockGetRevisionContent.RUnlock()
+	return calls
+}
+
+// GetStartPageToken calls GetStartPageTokenFunc.
+func (mock *FileStoreMock) GetStartPageToken(ctx context.Context) (string, error) {
+	if mock.GetStartPageTokenFunc == nil {
+		panic("FileStoreMock.GetStartPageTokenFunc: method is nil but FileStore.GetStartPageToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetStartPageToken.Lock()
+	mock.calls.GetStartPageToken = append(mock.calls.GetStartPageToken, callInfo)
+	mock.lockGetStartPageToken.Unlock()
+	return mock.GetStartPageTokenFunc(ctx)
+}
+
+// GetStartPageTokenCalls gets all the calls that were made to GetStartPageToken.
+// Check the length with:
+//
+//	len(mockedFileStore.GetStartPageTokenCalls())
+func (mock *FileStoreMock) GetStartPageTokenCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetStartPageToken.RLock()
+	calls = mock.calls.GetStartPageToken
+	mock.lockGetStartPageToken.RUnlock()
+	return calls
+}
+
+// GetThumbnail calls GetThumbnailFunc.
+func (mock *FileStoreMock) GetThumbnail(ctx context.Context, fileID string, slideIndex *int, size string) (*gdrive.Thumbnail, error) {
+	if mock.GetThumbnailFunc == nil {
+		panic("FileStoreMock.GetThumbnailFunc: method is nil but FileStore.GetThumbnail was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		FileID     string
+		SlideIndex *int
+		Size       string
+	}{
+		Ctx:        ctx,
+		FileID:     fileID,
+		SlideIndex: slideIndex,
+		Size:       size,
+	}
+	mock.lockGetThumbnail.Lock()
+	mock.calls.GetThumbnail = append(mock.calls.GetThumbnail, callInfo)
+	mock.lockGetThumbnail.Unlock()
+	return mock.GetThumbnailFunc(ctx, fileID, slideIndex, size)
+}
+
+// GetThumbnailCalls gets all the calls that were made to GetThumbnail.
+// Check the length with:
+//
+//	len(mockedFileStore.GetThumbnailCalls())
+func (mock *FileStoreMock) GetThumbnailCalls() []struct {
+	Ctx        context.Context
+	FileID     string
+	SlideIndex *int
+	Size       string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		FileID     string
+		SlideIndex *int
+		Size       string
+	}
+	mock.lockGetThumbnail.RLock()
+	calls = mock.calls.GetThumbnail
+	mock.lockGetThumbnail.RUnlock()
+	return calls
+}
+
+// ListChanges calls ListChangesFunc.
+func (mock *FileStoreMock) ListChanges(ctx context.Context, opts gdrive.ListOptions) (*gdrive.ChangeList, error) {
+	if mock.ListChangesFunc == nil {
+		panic("FileStoreMock.ListChangesFunc: method is nil but FileStore.ListChanges was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts gdrive.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockListChanges.Lock()
+	mock.calls.ListChanges = append(mock.calls.ListChanges, callInfo)
+	mock.lockListChanges.Unlock()
+	return mock.ListChangesFunc(ctx, opts)
+}
+
+// ListChangesCalls gets all the calls that were made to ListChanges.
+// Check the length with:
+//
+//	len(mockedFileStore.ListChangesCalls())
+func (mock *FileStoreMock) ListChangesCalls() []struct {
+	Ctx  context.Context
+	Opts gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts gdrive.ListOptions
+	}
+	mock.lockListChanges.RLock()
+	calls = mock.calls.ListChanges
+	mock.lockListChanges.RUnlock()
+	return calls
+}
+
+// ListComments calls ListCommentsFunc.
+func (mock *FileStoreMock) ListComments(ctx context.Context, fileID string, includeResolved bool, opts gdrive.ListOptions) (*gdrive.CommentList, error) {
+	if mock.ListCommentsFunc == nil {
+		panic("FileStoreMock.ListCommentsFunc: method is nil but FileStore.ListComments was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		FileID          string
+		IncludeResolved bool
+		Opts            gdrive.ListOptions
+	}{
+		Ctx:             ctx,
+		FileID:          fileID,
+		IncludeResolved: includeResolved,
+		Opts:            opts,
+	}
+	mock.lockListComments.Lock()
+	mock.calls.ListComments = append(mock.calls.ListComments, callInfo)
+	mock.lockListComments.Unlock()
+	return mock.ListCommentsFunc(ctx, fileID, includeResolved, opts)
+}
+
+// ListCommentsCalls gets all the calls that were made to ListComments.
+// Check the length with:
+//
+//	len(mockedFileStore.ListCommentsCalls())
+func (mock *FileStoreMock) ListCommentsCalls() []struct {
+	Ctx             context.Context
+	FileID          string
+	IncludeResolved bool
+	Opts            gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx             context.Context
+		FileID          string
+		IncludeResolved bool
+		Opts            gdrive.ListOptions
+	}
+	mock.lockListComments.RLock()
+	calls = mock.calls.ListComments
+	mock.lockListComments.RUnlock()
+	return calls
+}
+
+// ListFiles calls ListFilesFunc.
+func (mock *FileStoreMock) ListFiles(ctx context.Context, folderID string, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+	if mock.ListFilesFunc == nil {
+		panic("FileStoreMock.ListFilesFunc: method is nil but FileStore.ListFiles was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		FolderID string
+		Opts     gdrive.ListOptions
+	}{
+		Ctx:      ctx,
+		FolderID: folderID,
+		Opts:     opts,
+	}
+	mock.lockListFiles.Lock()
+	mock.calls.ListFiles = append(mock.calls.ListFiles, callInfo)
+	mock.lockListFiles.Unlock()
+	return mock.ListFilesFunc(ctx, folderID, opts)
+}
+
+// ListFilesCalls gets all the calls that were made to ListFiles.
+// Check the length with:
+//
+//	len(mockedFileStore.ListFilesCalls())
+func (mock *FileStoreMock) ListFilesCalls() []struct {
+	Ctx      context.Context
+	FolderID string
+	Opts     gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx      context.Context
+		FolderID string
+		Opts     gdrive.ListOptions
+	}
+	mock.lockListFiles.RLock()
+	calls = mock.calls.ListFiles
+	mock.lockListFiles.RUnlock()
+	return calls
+}
+
+// ListLabels calls ListLabelsFunc.
+func (mock *FileStoreMock) ListLabels(ctx context.Context, opts gdrive.ListOptions) (*gdrive.LabelList, error) {
+	if mock.ListLabelsFunc == nil {
+		panic("FileStoreMock.ListLabelsFunc: method is nil but FileStore.ListLabels was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts gdrive.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockListLabels.Lock()
+	mock.calls.ListLabels = append(mock.calls.ListLabels, callInfo)
+	mock.lockListLabels.Unlock()
+	return mock.ListLabelsFunc(ctx, opts)
+}
+
+// ListLabelsCalls gets all the calls that were made to ListLabels.
+// Check the length with:
+//
+//	len(mockedFileStore.ListLabelsCalls())
+func (mock *FileStoreMock) ListLabelsCalls() []struct {
+	Ctx  context.Context
+	Opts gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts gdrive.ListOptions
+	}
+	mock.lockListLabels.RLock()
+	calls = mock.calls.ListLabels
+	mock.lockListLabels.RUnlock()
+	return calls
+}
+
+// ListLargestFiles calls ListLargestFilesFunc.
+func (mock *FileStoreMock) ListLargestFiles(ctx context.Context, query gdrive.LargestFilesQuery, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+	if mock.ListLargestFilesFunc == nil {
+		panic("FileStoreMock.ListLargestFilesFunc: method is nil but FileStore.ListLargestFiles was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Query gdrive.LargestFilesQuery
+		Opts  gdrive.ListOptions
+	}{
+		Ctx:   ctx,
+		Query: query,
+		Opts:  opts,
+	}
+	mock.lockListLargestFiles.Lock()
+	mock.calls.ListLargestFiles = append(mock.calls.ListLargestFiles, callInfo)
+	mock.lockListLargestFiles.Unlock()
+	return mock.ListLargestFilesFunc(ctx, query, opts)
+}
+
+// ListLargestFilesCalls gets all the calls that were made to ListLargestFiles.
+// Check the length with:
+//
+//	len(mockedFileStore.ListLargestFilesCalls())
+func (mock *FileStoreMock) ListLargestFilesCalls() []struct {
+	Ctx   context.Context
+	Query gdrive.LargestFilesQuery
+	Opts  gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Query gdrive.LargestFilesQuery
+		Opts  gdrive.ListOptions
+	}
+	mock.lockListLargestFiles.RLock()
+	calls = mock.calls.ListLargestFiles
+	mock.lockListLargestFiles.RUnlock()
+	return calls
+}
+
+// ListModifiedFiles calls ListModifiedFilesFunc.
+func (mock *FileStoreMock) ListModifiedFiles(ctx context.Context, query gdrive.ModifiedFilesQuery, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+	if mock.ListModifiedFilesFunc == nil {
+		panic("FileStoreMock.ListModifiedFilesFunc: method is nil but FileStore.ListModifiedFiles was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Query gdrive.ModifiedFilesQuery
+		Opts  gdrive.ListOptions
+	}{
+		Ctx:   ctx,
+		Query: query,
+		Opts:  opts,
+	}
+	mock.lockListModifiedFiles.Lock()
+	mock.calls.ListModifiedFiles = append(mock.calls.ListModifiedFiles, callInfo)
+	mock.lockListModifiedFiles.Unlock()
+	return mock.ListModifiedFilesFunc(ctx, query, opts)
+}
+
+// ListModifiedFilesCalls gets all the calls that were made to ListModifiedFiles.
+// Check the length with:
+//
+//	len(mockedFileStore.ListModifiedFilesCalls())
+func (mock *FileStoreMock) ListModifiedFilesCalls() []struct {
+	Ctx   context.Context
+	Query gdrive.ModifiedFilesQuery
+	Opts  gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Query gdrive.ModifiedFilesQuery
+		Opts  gdrive.ListOptions
+	}
+	mock.lockListModifiedFiles.RLock()
+	calls = mock.calls.ListModifiedFiles
+	mock.lockListModifiedFiles.RUnlock()
+	return calls
+}
+
+// ListPermissions calls ListPermissionsFunc.
+func (mock *FileStoreMock) ListPermissions(ctx context.Context, fileID string, opts gdrive.ListOptions) (*gdrive.PermissionList, error) {
+	if mock.ListPermissionsFunc == nil {
+		panic("FileStoreMock.ListPermissionsFunc: method is nil but FileStore.ListPermissions was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID string
+		Opts   gdrive.ListOptions
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+		Opts:   opts,
+	}
+	mock.lockListPermissions.Lock()
+	mock.calls.ListPermissions = append(mock.calls.ListPermissions, callInfo)
+	mock.lockListPermissions.Unlock()
+	return mock.ListPermissionsFunc(ctx, fileID, opts)
+}
+
+// ListPermissionsCalls gets all the calls that were made to ListPermissions.
+// Check the length with:
+//
+//	len(mockedFileStore.ListPermissionsCalls())
+func (mock *FileStoreMock) ListPermissionsCalls() []struct {
+	Ctx    context.Context
+	FileID string
+	Opts   gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID string
+		Opts   gdrive.ListOptions
+	}
+	mock.lockListPermissions.RLock()
+	calls = mock.calls.ListPermissions
+	mock.lockListPermissions.RUnlock()
+	return calls
+}
+
+// ListRecentFiles calls ListRecentFilesFunc.
+func (mock *FileStoreMock) ListRecentFiles(ctx context.Context, viewed bool, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+	if mock.ListRecentFilesFunc == nil {
+		panic("FileStoreMock.ListRecentFilesFunc: method is nil but FileStore.ListRecentFiles was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Viewed bool
+		Opts   gdrive.ListOptions
+	}{
+		Ctx:    ctx,
+		Viewed: viewed,
+		Opts:   opts,
+	}
+	mock.lockListRecentFiles.Lock()
+	mock.calls.ListRecentFiles = append(mock.calls.ListRecentFiles, callInfo)
+	mock.lockListRecentFiles.Unlock()
+	return mock.ListRecentFilesFunc(ctx, viewed, opts)
+}
+
+// ListRecentFilesCalls gets all the calls that were made to ListRecentFiles.
+// Check the length with:
+//
+//	len(mockedFileStore.ListRecentFilesCalls())
+func (mock *FileStoreMock) ListRecentFilesCalls() []struct {
+	Ctx    context.Context
+	Viewed bool
+	Opts   gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Viewed bool
+		Opts   gdrive.ListOptions
+	}
+	mock.lockListRecentFiles.RLock()
+	calls = mock.calls.ListRecentFiles
+	mock.lockListRecentFiles.RUnlock()
+	return calls
+}
+
+// ListRevisions calls ListRevisionsFunc.
+func (mock *FileStoreMock) ListRevisions(ctx context.Context, fileID string, opts gdrive.ListOptions) (*gdrive.RevisionList, error) {
+	if mock.ListRevisionsFunc == nil {
+		panic("FileStoreMock.ListRevisionsFunc: method is nil but FileStore.ListRevisions was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID string
+		Opts   gdrive.ListOptions
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+		Opts:   opts,
+	}
+	mock.lockListRevisions.Lock()
+	mock.calls.ListRevisions = append(mock.calls.ListRevisions, callInfo)
+	mock.lockListRevisions.Unlock()
+	return mock.ListRevisionsFunc(ctx, fileID, opts)
+}
+
+// ListRevisionsCalls gets all the calls that were made to ListRevisions.
+// Check the length with:
+//
+//	len(mockedFileStore.ListRevisionsCalls())
+func (mock *FileStoreMock) ListRevisionsCalls() []struct {
+	Ctx    context.Context
+	FileID string
+	Opts   gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID string
+		Opts   gdrive.ListOptions
+	}
+	mock.lockListRevisions.RLock()
+	calls = mock.calls.ListRevisions
+	mock.lockListRevisions.RUnlock()
+	return calls
+}
+
+// ListSharedDrives calls ListSharedDrivesFunc.
+func (mock *FileStoreMock) ListSharedDrives(ctx context.Context, opts gdrive.ListOptions) (*gdrive.SharedDriveList, error) {
+	if mock.ListSharedDrivesFunc == nil {
+		panic("FileStoreMock.ListSharedDrivesFunc: method is nil but FileStore.ListSharedDrives was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts gdrive.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockListSharedDrives.Lock()
+	mock.calls.ListSharedDrives = append(mock.calls.ListSharedDrives, callInfo)
+	mock.lockListSharedDrives.Unlock()
+	return mock.ListSharedDrivesFunc(ctx, opts)
+}
+
+// ListSharedDrivesCalls gets all the calls that were made to ListSharedDrives.
+// Check the length with:
+//
+//	len(mockedFileStore.ListSharedDrivesCalls())
+func (mock *FileStoreMock) ListSharedDrivesCalls() []struct {
+	Ctx  context.Context
+	Opts gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts gdrive.ListOptions
+	}
+	mock.lockListSharedDrives.RLock()
+	calls = mock.calls.ListSharedDrives
+	mock.lockListSharedDrives.RUnlock()
+	return calls
+}
+
+// ListStarredFiles calls ListStarredFilesFunc.
+func (mock *FileStoreMock) ListStarredFiles(ctx context.Context, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+	if mock.ListStarredFilesFunc == nil {
+		panic("FileStoreMock.ListStarredFilesFunc: method is nil but FileStore.ListStarredFiles was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts gdrive.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockListStarredFiles.Lock()
+	mock.calls.ListStarredFiles = append(mock.calls.ListStarredFiles, callInfo)
+	mock.lockListStarredFiles.Unlock()
+	return mock.ListStarredFilesFunc(ctx, opts)
+}
+
+// ListStarredFilesCalls gets all the calls that were made to ListStarredFiles.
+// Check the length with:
+//
+//	len(mockedFileStore.ListStarredFilesCalls())
+func (mock *FileStoreMock) ListStarredFilesCalls() []struct {
+	Ctx  context.Context
+	Opts gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts gdrive.ListOptions
+	}
+	mock.lockListStarredFiles.RLock()
+	calls = mock.calls.ListStarredFiles
+	mock.lockListStarredFiles.RUnlock()
+	return calls
+}
+
+// ListTrashedFiles calls ListTrashedFilesFunc.
+func (mock *FileStoreMock) ListTrashedFiles(ctx context.Context, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+	if mock.ListTrashedFilesFunc == nil {
+		panic("FileStoreMock.ListTrashedFilesFunc: method is nil but FileStore.ListTrashedFiles was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Opts gdrive.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockListTrashedFiles.Lock()
+	mock.calls.ListTrashedFiles = append(mock.calls.ListTrashedFiles, callInfo)
+	mock.lockListTrashedFiles.Unlock()
+	return mock.ListTrashedFilesFunc(ctx, opts)
+}
+
+// ListTrashedFilesCalls gets all the calls that were made to ListTrashedFiles.
+// Check the length with:
+//
+//	len(mockedFileStore.ListTrashedFilesCalls())
+func (mock *FileStoreMock) ListTrashedFilesCalls() []struct {
+	Ctx  context.Context
+	Opts gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Opts gdrive.ListOptions
+	}
+	mock.lockListTrashedFiles.RLock()
+	calls = mock.calls.ListTrashedFiles
+	mock.lockListTrashedFiles.RUnlock()
+	return calls
+}
+
+// ResolvePath calls ResolvePathFunc.
+func (mock *FileStoreMock) ResolvePath(ctx context.Context, path string, driveID string) (*gdrive.ResolvedPath, error) {
+	if mock.ResolvePathFunc == nil {
+		panic("FileStoreMock.ResolvePathFunc: method is nil but FileStore.ResolvePath was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Path    string
+		DriveID string
+	}{
+		Ctx:     ctx,
+		Path:    path,
+		DriveID: driveID,
+	}
+	mock.lockResolvePath.Lock()
+	mock.calls.ResolvePath = append(mock.calls.ResolvePath, callInfo)
+	mock.lockResolvePath.Unlock()
+	return mock.ResolvePathFunc(ctx, path, driveID)
+}
+
+// ResolvePathCalls gets all the calls that were made to ResolvePath.
+// Check the length with:
+//
+//	len(mockedFileStore.ResolvePathCalls())
+func (mock *FileStoreMock) ResolvePathCalls() []struct {
+	Ctx     context.Context
+	Path    string
+	DriveID string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Path    string
+		DriveID string
+	}
+	mock.lockResolvePath.RLock()
+	calls = mock.calls.ResolvePath
+	mock.lockResolvePath.RUnlock()
+	return calls
+}
+
+// ResolveShortcut calls ResolveShortcutFunc.
+func (mock *FileStoreMock) ResolveShortcut(ctx context.Context, fileID string) (*gdrive.ShortcutInfo, error) {
+	if mock.ResolveShortcutFunc == nil {
+		panic("FileStoreMock.ResolveShortcutFunc: method is nil but FileStore.ResolveShortcut was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID string
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+	}
+	mock.lockResolveShortcut.Lock()
+	mock.calls.ResolveShortcut = append(mock.calls.ResolveShortcut, callInfo)
+	mock.lockResolveShortcut.Unlock()
+	return mock.ResolveShortcutFunc(ctx, fileID)
+}
+
+// ResolveShortcutCalls gets all the calls that were made to ResolveShortcut.
+// Check the length with:
+//
+//	len(mockedFileStore.ResolveShortcutCalls())
+func (mock *FileStoreMock) ResolveShortcutCalls() []struct {
+	Ctx    context.Context
+	FileID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID string
+	}
+	mock.lockResolveShortcut.RLock()
+	calls = mock.calls.ResolveShortcut
+	mock.lockResolveShortcut.RUnlock()
+	return calls
+}
+
+// SaveFile calls SaveFileFunc.
+func (mock *FileStoreMock) SaveFile(ctx context.Context, fileID string, localPath string, format string, overwrite bool) (*gdrive.SavedFile, error) {
+	if mock.SaveFileFunc == nil {
+		panic("FileStoreMock.SaveFileFunc: method is nil but FileStore.SaveFile was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		FileID    string
+		LocalPath string
+		Format    string
+		Overwrite bool
+	}{
+		Ctx:       ctx,
+		FileID:    fileID,
+		LocalPath: localPath,
+		Format:    format,
+		Overwrite: overwrite,
+	}
+	mock.lockSaveFile.Lock()
+	mock.calls.SaveFile = append(mock.calls.SaveFile, callInfo)
+	mock.lockSaveFile.Unlock()
+	return mock.SaveFileFunc(ctx, fileID, localPath, format, overwrite)
+}
+
+// SaveFileCalls gets all the calls that were made to SaveFile.
+// Check the length with:
+//
+//	len(mockedFileStore.SaveFileCalls())
+func (mock *FileStoreMock) SaveFileCalls() []struct {
+	Ctx       context.Context
+	FileID    string
+	LocalPath string
+	Format    string
+	Overwrite bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		FileID    string
+		LocalPath string
+		Format    string
+		Overwrite bool
+	}
+	mock.lockSaveFile.RLock()
+	calls = mock.calls.SaveFile
+	mock.lockSaveFile.RUnlock()
+	return calls
+}
+
+// SearchFiles calls SearchFilesFunc.
+func (mock *FileStoreMock) SearchFiles(ctx context.Context, query string, filter gdrive.SearchFilter, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+	if mock.SearchFilesFunc == nil {
+		panic("FileStoreMock.SearchFilesFunc: method is nil but FileStore.SearchFiles was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Query  string
+		Filter gdrive.SearchFilter
+		Opts   gdrive.ListOptions
+	}{
+		Ctx:    ctx,
+		Query:  query,
+		Filter: filter,
+		Opts:   opts,
+	}
+	mock.lockSearchFiles.Lock()
+	mock.calls.SearchFiles = append(mock.calls.SearchFiles, callInfo)
+	mock.lockSearchFiles.Unlock()
+	return mock.SearchFilesFunc(ctx, query, filter, opts)
+}
+
+// SearchFilesCalls gets all the calls that were made to SearchFiles.
+// Check the length with:
+//
+//	len(mockedFileStore.SearchFilesCalls())
+func (mock *FileStoreMock) SearchFilesCalls() []struct {
+	Ctx    context.Context
+	Query  string
+	Filter gdrive.SearchFilter
+	Opts   gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Query  string
+		Filter gdrive.SearchFilter
+		Opts   gdrive.ListOptions
+	}
+	mock.lockSearchFiles.RLock()
+	calls = mock.calls.SearchFiles
+	mock.lockSearchFiles.RUnlock()
+	return calls
+}
+
+// SearchFilesByProperties calls SearchFilesByPropertiesFunc.
+func (mock *FileStoreMock) SearchFilesByProperties(ctx context.Context, properties map[string]string, appProperties bool, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+	if mock.SearchFilesByPropertiesFunc == nil {
+		panic("FileStoreMock.SearchFilesByPropertiesFunc: method is nil but FileStore.SearchFilesByProperties was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Properties    map[string]string
+		AppProperties bool
+		Opts          gdrive.ListOptions
+	}{
+		Ctx:           ctx,
+		Properties:    properties,
+		AppProperties: appProperties,
+		Opts:          opts,
+	}
+	mock.lockSearchFilesByProperties.Lock()
+	mock.calls.SearchFilesByProperties = append(mock.calls.SearchFilesByProperties, callInfo)
+	mock.lockSearchFilesByProperties.Unlock()
+	return mock.SearchFilesByPropertiesFunc(ctx, properties, appProperties, opts)
+}
+
+// SearchFilesByPropertiesCalls gets all the calls that were made to SearchFilesByProperties.
+// Check the length with:
+//
+//	len(mockedFileStore.SearchFilesByPropertiesCalls())
+func (mock *FileStoreMock) SearchFilesByPropertiesCalls() []struct {
+	Ctx           context.Context
+	Properties    map[string]string
+	AppProperties bool
+	Opts          gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Properties    map[string]string
+		AppProperties bool
+		Opts          gdrive.ListOptions
+	}
+	mock.lockSearchFilesByProperties.RLock()
+	calls = mock.calls.SearchFilesByProperties
+	mock.lockSearchFilesByProperties.RUnlock()
+	return calls
+}
+
+// SearchFilesWithSnippets calls SearchFilesWithSnippetsFunc.
+func (mock *FileStoreMock) SearchFilesWithSnippets(ctx context.Context, query string, filter gdrive.SearchFilter, opts gdrive.ListOptions) (*gdrive.SearchResultList, error) {
+	if mock.SearchFilesWithSnippetsFunc == nil {
+		panic("FileStoreMock.SearchFilesWithSnippetsFunc: method is nil but FileStore.SearchFilesWithSnippets was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Query  string
+		Filter gdrive.SearchFilter
+		Opts   gdrive.ListOptions
+	}{
+		Ctx:    ctx,
+		Query:  query,
+		Filter: filter,
+		Opts:   opts,
+	}
+	mock.lockSearchFilesWithSnippets.Lock()
+	mock.calls.SearchFilesWithSnippets = append(mock.calls.SearchFilesWithSnippets, callInfo)
+	mock.lockSearchFilesWithSnippets.Unlock()
+	return mock.SearchFilesWithSnippetsFunc(ctx, query, filter, opts)
+}
+
+// SearchFilesWithSnippetsCalls gets all the calls that were made to SearchFilesWithSnippets.
+// Check the length with:
+//
+//	len(mockedFileStore.SearchFilesWithSnippetsCalls())
+func (mock *FileStoreMock) SearchFilesWithSnippetsCalls() []struct {
+	Ctx    context.Context
+	Query  string
+	Filter gdrive.SearchFilter
+	Opts   gdrive.ListOptions
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Query  string
+		Filter gdrive.SearchFilter
+		Opts   gdrive.ListOptions
+	}
+	mock.lockSearchFilesWithSnippets.RLock()
+	calls = mock.calls.SearchFilesWithSnippets
+	mock.lockSearchFilesWithSnippets.RUnlock()
+	return calls
+}
+
+// VerifyFile calls VerifyFileFunc.
+func (mock *FileStoreMock) VerifyFile(ctx context.Context, fileID string, expectedHash string) (*gdrive.FileIntegrity, error) {
+	if mock.VerifyFileFunc == nil {
+		panic("FileStoreMock.VerifyFileFunc: method is nil but FileStore.VerifyFile was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		FileID       string
+		ExpectedHash string
+	}{
+		Ctx:          ctx,
+		FileID:       fileID,
+		ExpectedHash: expectedHash,
+	}
+	mock.lockVerifyFile.Lock()
+	mock.calls.VerifyFile = append(mock.calls.VerifyFile, callInfo)
+	mock.lockVerifyFile.Unlock()
+	return mock.VerifyFileFunc(ctx, fileID, expectedHash)
+}
+
+// VerifyFileCalls gets all the calls that were made to VerifyFile.
+// Check the length with:
+//
+//	len(mockedFileStore.VerifyFileCalls())
+func (mock *FileStoreMock) VerifyFileCalls() []struct {
+	Ctx          context.Context
+	FileID       string
+	ExpectedHash string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		FileID       string
+		ExpectedHash string
+	}
+	mock.lockVerifyFile.RLock()
+	calls = mock.calls.VerifyFile
+	mock.lockVerifyFile.RUnlock()
+	return calls
+}
+
+// Ensure, that DocEditorMock does implement gdrive.DocEditor.
+// If this is not the case, regenerate this file with moq.
+var _ gdrive.DocEditor = &DocEditorMock{}
+
+// DocEditorMock is a mock implementation of gdrive.DocEditor.
+//
+//	func TestSomethingThatUsesDocEditor(t *testing.T) {
+//
+//		// make and configure a mocked gdrive.DocEditor
+//		mockedDocEditor := &DocEditorMock{
+//			AppendTableRowsFunc: func(ctx context.Context, documentID string, locator gdrive.TableLocator, rows [][]string) (*gdrive.TableAppendResult, error) {
+//				panic("mock out the AppendTableRows method")
+//			},
+//			BatchUpdateDocumentFunc: func(ctx context.Context, documentID string, requests []json.RawMessage, requiredRevisionID string) (*docs.BatchUpdateDocumentResponse, error) {
+//				panic("mock out the BatchUpdateDocument method")
+//			},
+//			DiffDocumentsFunc: func(ctx context.Context, base gdrive.DocumentVersion, other gdrive.DocumentVersion, contextLines int) (*gdrive.DocumentDiff, error) {
+//				panic("mock out the DiffDocuments method")
+//			},
+//			GetDocumentChunkFunc: func(ctx context.Context, documentID string, startIndex int, maxChars int) (*gdrive.DocumentChunk, error) {
+//				panic("mock out the GetDocumentChunk method")
+//			},
+//			GetDocumentContentFunc: func(ctx context.Context, documentID string) (string, error) {
+//				panic("mock out the GetDocumentContent method")
+//			},
+//			GetDocumentImagesFunc: func(ctx context.Context, documentID string, includeData bool) ([]gdrive.DocumentImage, error) {
+//				panic("mock out the GetDocumentImages method")
+//			},
+//			GetDocumentParagraphsFunc: func(ctx context.Context, documentID string) ([]gdrive.DocumentParagraph, error) {
+//				panic("mock out the GetDocumentParagraphs method")
+//			},
+//			GetDocumentStyleFunc: func(ctx context.Context, documentID string) (*gdrive.DocumentStyleSettings, error) {
+//				panic("mock out the GetDocumentStyle method")
+//			},
+//			ReplaceDocumentImageFunc: func(ctx context.Context, documentID string, locator gdrive.ImageLocator, imageURL string) (string, error) {
+//				panic("mock out the ReplaceDocumentImage method")
+//			},
+//			UpdateDocumentContentFunc: func(ctx context.Context, documentID string, content string) error {
+//				panic("mock out the UpdateDocumentContent method")
+//			},
+//			UpdateDocumentStyleFunc: func(ctx context.Context, documentID string, update gdrive.DocumentStyleUpdate) (*gdrive.DocumentStyleSettings, error) {
+//				panic("mock out the UpdateDocumentStyle method")
+//			},
+//		}
+//
+//		// use mockedDocEditor in code that requires gdrive.DocEditor
+//		// and then make assertions.
+//
+//	}
+type DocEditorMock struct {
+	// AppendTableRowsFunc mocks the AppendTableRows method.
+	AppendTableRowsFunc func(ctx context.Context, documentID string, locator gdrive.TableLocator, rows [][]string) (*gdrive.TableAppendResult, error)
+
+	// BatchUpdateDocumentFunc mocks the BatchUpdateDocument method.
+	BatchUpdateDocumentFunc func(ctx context.Context, documentID string, requests []json.RawMessage, requiredRevisionID string) (*docs.BatchUpdateDocumentResponse, error)
+
+	// DiffDocumentsFunc mocks the DiffDocuments method.
+	DiffDocumentsFunc func(ctx context.Context, base gdrive.DocumentVersion, other gdrive.DocumentVersion, contextLines int) (*gdrive.DocumentDiff, error)
+
+	// GetDocumentChunkFunc mocks the GetDocumentChunk method.
+	GetDocumentChunkFunc func(ctx context.Context, documentID string, startIndex int, maxChars int) (*gdrive.DocumentChunk, error)
+
+	// GetDocumentContentFunc mocks the GetDocumentContent method.
+	GetDocumentContentFunc func(ctx context.Context, documentID string) (string, error)
+
+	// GetDocumentImagesFunc mocks the GetDocumentImages method.
+	GetDocumentImagesFunc func(ctx context.Context, documentID string, includeData bool) ([]gdrive.DocumentImage, error)
+
+	// GetDocumentParagraphsFunc mocks the GetDocumentParagraphs method.
+	GetDocumentParagraphsFunc func(ctx context.Context, documentID string) ([]gdrive.DocumentParagraph, error)
+
+	// GetDocumentStyleFunc mocks the GetDocumentStyle method.
+	GetDocumentStyleFunc func(ctx context.Context, documentID string) (*gdrive.DocumentStyleSettings, error)
+
+	// ReplaceDocumentImageFunc mocks the ReplaceDocumentImage method.
+	ReplaceDocumentImageFunc func(ctx context.Context, documentID string, locator gdrive.ImageLocator, imageURL string) (string, error)
+
+	// UpdateDocumentContentFunc mocks the UpdateDocumentContent method.
+	UpdateDocumentContentFunc func(ctx context.Context, documentID string, content string) error
+
+	// UpdateDocumentStyleFunc mocks the UpdateDocumentStyle method.
+	UpdateDocumentStyleFunc func(ctx context.Context, documentID string, update gdrive.DocumentStyleUpdate) (*gdrive.DocumentStyleSettings, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AppendTableRows holds details about calls to the AppendTableRows method.
+		AppendTableRows []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DocumentID is the documentID argument value.
+			DocumentID string
+			// Locator is the locator argument value.
+			Locator gdrive.TableLocator
+			// Rows is the rows argument value.
+			Rows [][]string
+		}
+		// BatchUpdateDocument holds details about calls to the BatchUpdateDocument method.
+		BatchUpdateDocument []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DocumentID is the documentID argument value.
+			DocumentID string
+			// Requests is the requests argument value.
+			Requests []json.RawMessage
+			// RequiredRevisionID is the requiredRevisionID argument value.
+			RequiredRevisionID string
+		}
+		// DiffDocuments holds details about calls to the DiffDocuments method.
+		DiffDocuments []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Base is the base argument value.
+			Base gdrive.DocumentVersion
+			// Other is the other argument value.
+			Other gdrive.DocumentVersion
+			// ContextLines is the contextLines argument value.
+			ContextLines int
+		}
+		// GetDocumentChunk holds details about calls to the GetDocumentChunk method.
+		GetDocumentChunk []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DocumentID is the documentID argument value.
+			DocumentID string
+			// StartIndex is the startIndex argument value.
+			StartIndex int
+			// MaxChars is the maxChars argument value.
+			MaxChars int
+		}
+		// GetDocumentContent holds details about calls to the GetDocumentContent method.
+		GetDocumentContent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DocumentID is the documentID argument value.
+			DocumentID string
+		}
+		// GetDocumentImages holds details about calls to the GetDocumentImages method.
+		GetDocumentImages []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DocumentID is the documentID argument value.
+			DocumentID string
+			// IncludeData is the includeData argument value.
+			IncludeData bool
+		}
+		// GetDocumentParagraphs holds details about calls to the GetDocumentParagraphs method.
+		GetDocumentParagraphs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DocumentID is the documentID argument value.
+			DocumentID string
+		}
+		// GetDocumentStyle holds details about calls to the GetDocumentStyle method.
+		GetDocumentStyle []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DocumentID is the documentID argument value.
+			DocumentID string
+		}
+		// ReplaceDocumentImage holds details about calls to the ReplaceDocumentImage method.
+		ReplaceDocumentImage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DocumentID is the documentID argument value.
+			DocumentID string
+			// Locator is the locator argument value.
+			Locator gdrive.ImageLocator
+			// ImageURL is the imageURL argument value.
+			ImageURL string
+		}
+		// UpdateDocumentContent holds details about calls to the UpdateDocumentContent method.
+		UpdateDocumentContent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DocumentID is the documentID argument value.
+			DocumentID string
+			// Content is the content argument value.
+			Content string
+		}
+		// UpdateDocumentStyle holds details about calls to the UpdateDocumentStyle method.
+		UpdateDocumentStyle []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DocumentID is the documentID argument value.
+			DocumentID string
+			// Update is the update argument value.
+			Update gdrive.DocumentStyleUpdate
+		}
+	}
+	lockAppendTableRows       sync.RWMutex
+	lockBatchUpdateDocument   sync.RWMutex
+	lockDiffDocuments         sync.RWMutex
+	lockGetDocumentChunk      sync.RWMutex
+	lockGetDocumentContent    sync.RWMutex
+	lockGetDocumentImages     sync.RWMutex
+	lockGetDocumentParagraphs sync.RWMutex
+	lockGetDocumentStyle      sync.RWMutex
+	lockReplaceDocumentImage  sync.RWMutex
+	lockUpdateDocumentContent sync.RWMutex
+	lockUpdateDocumentStyle   sync.RWMutex
+}
+
+// AppendTableRows calls AppendTableRowsFunc.
+func (mock *DocEditorMock) AppendTableRows(ctx context.Context, documentID string, locator gdrive.TableLocator, rows [][]string) (*gdrive.TableAppendResult, error) {
+	if mock.AppendTableRowsFunc == nil {
+		panic("DocEditorMock.AppendTableRowsFunc: method is nil but DocEditor.AppendTableRows was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		DocumentID string
+		Locator    gdrive.TableLocator
+		Rows       [][]string
+	}{
+		Ctx:        ctx,
+		DocumentID: documentID,
+		Locator:    locator,
+		Rows:       rows,
+	}
+	mock.lockAppendTableRows.Lock()
+	mock.calls.AppendTableRows = append(mock.calls.AppendTableRows, callInfo)
+	mock.lockAppendTableRows.Unlock()
+	return mock.AppendTableRowsFunc(ctx, documentID, locator, rows)
+}
+
+// AppendTableRowsCalls gets all the calls that were made to AppendTableRows.
+// Check the length with:
+//
+//	len(mockedDocEditor.AppendTableRowsCalls())
+func (mock *DocEditorMock) AppendTableRowsCalls() []struct {
+	Ctx        context.Context
+	DocumentID string
+	Locator    gdrive.TableLocator
+	Rows       [][]string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		DocumentID string
+		Locator    gdrive.TableLocator
+		Rows       [][]string
+	}
+	mock.lockAppendTableRows.RLock()
+	calls = mock.calls.AppendTableRows
+	mock.lockAppendTableRows.RUnlock()
+	return calls
+}
+
+// BatchUpdateDocument calls BatchUpdateDocumentFunc.
+func (mock *DocEditorMock) BatchUpdateDocument(ctx context.Context, documentID string, requests []json.RawMessage, requiredRevisionID string) (*docs.BatchUpdateDocumentResponse, error) {
+	if mock.BatchUpdateDocumentFunc == nil {
+		panic("DocEditorMock.BatchUpdateDocumentFunc: method is nil but DocEditor.BatchUpdateDocument was just called")
+	}
+	callInfo := struct {
+		Ctx                context.Context
+		DocumentID         string
+		Requests           []json.RawMessage
+		RequiredRevisionID string
+	}{
+		Ctx:                ctx,
+		DocumentID:         documentID,
+		Requests:           requests,
+		RequiredRevisionID: requiredRevisionID,
+	}
+	mock.lockBatchUpdateDocument.Lock()
+	mock.calls.BatchUpdateDocument = append(mock.calls.BatchUpdateDocument, callInfo)
+	mock.lockBatchUpdateDocument.Unlock()
+	return mock.BatchUpdateDocumentFunc(ctx, documentID, requests, requiredRevisionID)
+}
+
+// BatchUpdateDocumentCalls gets all the calls that were made to BatchUpdateDocument.
+// Check the length with:
+//
+//	len(mockedDocEditor.BatchUpdateDocumentCalls())
+func (mock *DocEditorMock) BatchUpdateDocumentCalls() []struct {
+	Ctx                context.Context
+	DocumentID         string
+	Requests           []json.RawMessage
+	RequiredRevisionID string
+} {
+	var calls []struct {
+		Ctx                context.Context
+		DocumentID         string
+		Requests           []json.RawMessage
+		RequiredRevisionID string
+	}
+	mock.lockBatchUpdateDocument.RLock()
+	calls = mock.calls.BatchUpdateDocument
+	mock.lockBatchUpdateDocument.RUnlock()
+	return calls
+}
+
+// DiffDocuments calls DiffDocumentsFunc.
+func (mock *DocEditorMock) DiffDocuments(ctx context.Context, base gdrive.DocumentVersion, other gdrive.DocumentVersion, contextLines int) (*gdrive.DocumentDiff, error) {
+	if mock.DiffDocumentsFunc == nil {
+		panic("DocEditorMock.DiffDocumentsFunc: method is nil but DocEditor.DiffDocuments was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Base         gdrive.DocumentVersion
+		Other        gdrive.DocumentVersion
+		ContextLines int
+	}{
+		Ctx:          ctx,
+		Base:         base,
+		Other:        other,
+		ContextLines: contextLines,
+	}
+	mock.lockDiffDocuments.Lock()
+	mock.calls.DiffDocuments = append(mock.calls.DiffDocuments, callInfo)
+	mock.lockDiffDocuments.Unlock()
+	return mock.DiffDocumentsFunc(ctx, base, other, contextLines)
+}
+
+// DiffDocumentsCalls gets all the calls that were made to DiffDocuments.
+// Check the length with:
+//
+//	len(mockedDocEditor.DiffDocumentsCalls())
+func (mock *DocEditorMock) DiffDocumentsCalls() []struct {
+	Ctx          context.Context
+	Base         gdrive.DocumentVersion
+	Other        gdrive.DocumentVersion
+	ContextLines int
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Base         gdrive.DocumentVersion
+		Other        gdrive.DocumentVersion
+		ContextLines int
+	}
+	mock.lockDiffDocuments.RLock()
+	calls = mock.calls.DiffDocuments
+	mock.lockDiffDocuments.RUnlock()
+	return calls
+}
+
+// GetDocumentChunk calls GetDocumentChunkFunc.
+func (mock *DocEditorMock) GetDocumentChunk(ctx context.Context, documentID string, startIndex int, maxChars int) (*gdrive.DocumentChunk, error) {
+	if mock.GetDocumentChunkFunc == nil {
+		panic("DocEditorMock.GetDocumentChunkFunc: method is nil but DocEditor.GetDocumentChunk was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		DocumentID string
+		StartIndex int
+		MaxChars   int
+	}{
+		Ctx:        ctx,
+		DocumentID: documentID,
+		StartIndex: startIndex,
+		MaxChars:   maxChars,
+	}
+	mock.lockGetDocumentChunk.Lock()
+	mock.calls.GetDocumentChunk = append(mock.calls.GetDocumentChunk, callInfo)
+	mock.lockGetDocumentChunk.Unlock()
+	return mock.GetDocumentChunkFunc(ctx, documentID, startIndex, maxChars)
+}
+
+// GetDocumentChunkCalls gets all the calls that were made to GetDocumentChunk.
+// Check the length with:
+//
+//	len(mockedDocEditor.GetDocumentChunkCalls())
+func (mock *DocEditorMock) GetDocumentChunkCalls() []struct {
+	Ctx        context.Context
+	DocumentID string
+	StartIndex int
+	MaxChars   int
+} {
+	var calls []struct {
+		Ctx        context.Context
+		DocumentID string
+		StartIndex int
+		MaxChars   int
+	}
+	mock.lockGetDocumentChunk.RLock()
+	calls = mock.calls.GetDocumentChunk
+	mock.lockGetDocumentChunk.RUnlock()
+	return calls
+}
+
+// GetDocumentContent calls GetDocumentContentFunc.
+func (mock *DocEditorMock) GetDocumentContent(ctx context.Context, documentID string) (string, error) {
+	if mock.GetDocumentContentFunc == nil {
+		panic("DocEditorMock.GetDocumentContentFunc: method is nil but DocEditor.GetDocumentContent was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		DocumentID string
+	}{
+		Ctx:        ctx,
+		DocumentID: documentID,
+	}
+	mock.lockGetDocumentContent.Lock()
+	mock.calls.GetDocumentContent = append(mock.calls.GetDocumentContent, callInfo)
+	mock.lockGetDocumentContent.Unlock()
+	return mock.GetDocumentContentFunc(ctx, documentID)
+}
+
+// GetDocumentContentCalls gets all the calls that were made to GetDocumentContent.
+// Check the length with:
+//
+//	len(mockedDocEditor.GetDocumentContentCalls())
+func (mock *DocEditorMock) GetDocumentContentCalls() []struct {
+	Ctx        context.Context
+	DocumentID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		DocumentID string
+	}
+	mock.lockGetDocumentContent.RLock()
+	calls = mock.calls.GetDocumentContent
+	mock.lockGetDocumentContent.RUnlock()
+	return calls
+}
+
+// GetDocumentImages calls GetDocumentImagesFunc.
+func (mock *DocEditorMock) GetDocumentImages(ctx context.Context, documentID string, includeData bool) ([]gdrive.DocumentImage, error) {
+	if mock.GetDocumentImagesFunc == nil {
+		panic("DocEditorMock.GetDocumentImagesFunc: method is nil but DocEditor.GetDocumentImages was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		DocumentID  string
+		IncludeData bool
+	}{
+		Ctx:         ctx,
+		DocumentID:  documentID,
+		IncludeData: includeData,
+	}
+	mock.lockGetDocumentImages.Lock()
+	mock.calls.GetDocumentImages = append(mock.calls.GetDocumentImages, callInfo)
+	mock.lockGetDocumentImages.Unlock()
+	return mock.GetDocumentImagesFunc(ctx, documentID, includeData)
+}
+
+// GetDocumentImagesCalls gets all the calls that were made to GetDocumentImages.
+// Check the length with:
+//
+//	len(mockedDocEditor.GetDocumentImagesCalls())
+func (mock *DocEditorMock) GetDocumentImagesCalls() []struct {
+	Ctx         context.Context
+	DocumentID  string
+	IncludeData bool
+} {
+	var calls []struct {
+		Ctx         context.Context
+		DocumentID  string
+		IncludeData bool
+	}
+	mock.lockGetDocumentImages.RLock()
+	calls = mock.calls.GetDocumentImages
+	mock.lockGetDocumentImages.RUnlock()
+	return calls
+}
+
+// GetDocumentParagraphs calls GetDocumentParagraphsFunc.
+func (mock *DocEditorMock) GetDocumentParagraphs(ctx context.Context, documentID string) ([]gdrive.DocumentParagraph, error) {
+	if mock.GetDocumentParagraphsFunc == nil {
+		panic("DocEditorMock.GetDocumentParagraphsFunc: method is nil but DocEditor.GetDocumentParagraphs was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		DocumentID string
+	}{
+		Ctx:        ctx,
+		DocumentID: documentID,
+	}
+	mock.lockGetDocumentParagraphs.Lock()
+	mock.calls.GetDocumentParagraphs = append(mock.calls.GetDocumentParagraphs, callInfo)
+	mock.lockGetDocumentParagraphs.Unlock()
+	return mock.GetDocumentParagraphsFunc(ctx, documentID)
+}
+
+// GetDocumentParagraphsCalls gets all the calls that were made to GetDocumentParagraphs.
+// Check the length with:
+//
+//	len(mockedDocEditor.GetDocumentParagraphsCalls())
+func (mock *DocEditorMock) GetDocumentParagraphsCalls() []struct {
+	Ctx        context.Context
+	DocumentID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		DocumentID string
+	}
+	mock.lockGetDocumentParagraphs.RLock()
+	calls = mock.calls.GetDocumentParagraphs
+	mock.lockGetDocumentParagraphs.RUnlock()
+	return calls
+}
+
+// GetDocumentStyle calls GetDocumentStyleFunc.
+func (mock *DocEditorMock) GetDocumentStyle(ctx context.Context, documentID string) (*gdrive.DocumentStyleSettings, error) {
+	if mock.GetDocumentStyleFunc == nil {
+		panic("DocEditorMock.GetDocumentStyleFunc: method is nil but DocEditor.GetDocumentStyle was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		DocumentID string
+	}{
+		Ctx:        ctx,
+		DocumentID: documentID,
+	}
+	mock.lockGetDocumentStyle.Lock()
+	mock.calls.GetDocumentStyle = append(mock.calls.GetDocumentStyle, callInfo)
+	mock.lockGetDocumentStyle.Unlock()
+	return mock.GetDocumentStyleFunc(ctx, documentID)
+}
+
+// GetDocumentStyleCalls gets all the calls that were made to GetDocumentStyle.
+// Check the length with:
+//
+//	len(mockedDocEditor.GetDocumentStyleCalls())
+func (mock *DocEditorMock) GetDocumentStyleCalls() []struct {
+	Ctx        context.Context
+	DocumentID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		DocumentID string
+	}
+	mock.lockGetDocumentStyle.RLock()
+	calls = mock.calls.GetDocumentStyle
+	mock.lockGetDocumentStyle.RUnlock()
+	return calls
+}
+
+// ReplaceDocumentImage calls ReplaceDocumentImageFunc.
+func (mock *DocEditorMock) ReplaceDocumentImage(ctx context.Context, documentID string, locator gdrive.ImageLocator, imageURL string) (string, error) {
+	if mock.ReplaceDocumentImageFunc == nil {
+		panic("DocEditorMock.ReplaceDocumentImageFunc: method is nil but DocEditor.ReplaceDocumentImage was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		DocumentID string
+		Locator    gdrive.ImageLocator
+		ImageURL   string
+	}{
+		Ctx:        ctx,
+		DocumentID: documentID,
+		Locator:    locator,
+		ImageURL:   imageURL,
+	}
+	mock.lockReplaceDocumentImage.Lock()
+	mock.calls.ReplaceDocumentImage = append(mock.calls.ReplaceDocumentImage, callInfo)
+	mock.lockReplaceDocumentImage.Unlock()
+	return mock.ReplaceDocumentImageFunc(ctx, documentID, locator, imageURL)
+}
+
+// ReplaceDocumentImageCalls gets all the calls that were made to ReplaceDocumentImage.
+// Check the length with:
+//
+//	len(mockedDocEditor.ReplaceDocumentImageCalls())
+func (mock *DocEditorMock) ReplaceDocumentImageCalls() []struct {
+	Ctx        context.Context
+	DocumentID string
+	Locator    gdrive.ImageLocator
+	ImageURL   string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		DocumentID string
+		Locator    gdrive.ImageLocator
+		ImageURL   string
+	}
+	mock.lockReplaceDocumentImage.RLock()
+	calls = mock.calls.ReplaceDocumentImage
+	mock.lockReplaceDocumentImage.RUnlock()
+	return calls
+}
+
+// UpdateDocumentContent calls UpdateDocumentContentFunc.
+func (mock *DocEditorMock) UpdateDocumentContent(ctx context.Context, documentID string, content string) error {
+	if mock.UpdateDocumentContentFunc == nil {
+		panic("DocEditorMock.UpdateDocumentContentFunc: method is nil but DocEditor.UpdateDocumentContent was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		DocumentID string
+		Content    string
+	}{
+		Ctx:        ctx,
+		DocumentID: documentID,
+		Content:    content,
+	}
+	mock.lockUpdateDocumentContent.Lock()
+	mock.calls.UpdateDocumentContent = append(mock.calls.UpdateDocumentContent, callInfo)
+	mock.lockUpdateDocumentContent.Unlock()
+	return mock.UpdateDocumentContentFunc(ctx, documentID, content)
+}
+
+// UpdateDocumentContentCalls gets all the calls that were made to UpdateDocumentContent.
+// Check the length with:
+//
+//	len(mockedDocEditor.UpdateDocumentContentCalls())
+func (mock *DocEditorMock) UpdateDocumentContentCalls() []struct {
+	Ctx        context.Context
+	DocumentID string
+	Content    string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		DocumentID string
+		Content    string
+	}
+	mock.lockUpdateDocumentContent.RLock()
+	calls = mock.calls.UpdateDocumentContent
+	mock.lockUpdateDocumentContent.RUnlock()
+	return calls
+}
+
+// UpdateDocumentStyle calls UpdateDocumentStyleFunc.
+func (mock *DocEditorMock) UpdateDocumentStyle(ctx context.Context, documentID string, update gdrive.DocumentStyleUpdate) (*gdrive.DocumentStyleSettings, error) {
+	if mock.UpdateDocumentStyleFunc == nil {
+		panic("DocEditorMock.UpdateDocumentStyleFunc: method is nil but DocEditor.UpdateDocumentStyle was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		DocumentID string
+		Update     gdrive.DocumentStyleUpdate
+	}{
+		Ctx:        ctx,
+		DocumentID: documentID,
+		Update:     update,
+	}
+	mock.lockUpdateDocumentStyle.Lock()
+	mock.calls.UpdateDocumentStyle = append(mock.calls.UpdateDocumentStyle, callInfo)
+	mock.lockUpdateDocumentStyle.Unlock()
+	return mock.UpdateDocumentStyleFunc(ctx, documentID, update)
+}
+
+// UpdateDocumentStyleCalls gets all the calls that were made to UpdateDocumentStyle.
+// Check the length with:
+//
+//	len(mockedDocEditor.UpdateDocumentStyleCalls())
+func (mock *DocEditorMock) UpdateDocumentStyleCalls() []struct {
+	Ctx        context.Context
+	DocumentID string
+	Update     gdrive.DocumentStyleUpdate
+} {
+	var calls []struct {
+		Ctx        context.Context
+		DocumentID string
+		Update     gdrive.DocumentStyleUpdate
+	}
+	mock.lockUpdateDocumentStyle.RLock()
+	calls = mock.calls.UpdateDocumentStyle
+	mock.lockUpdateDocumentStyle.RUnlock()
+	return calls
+}
+
+// Ensure, that SlideEditorMock does implement gdrive.SlideEditor.
+// If this is not the case, regenerate this file with moq.
+var _ gdrive.SlideEditor = &SlideEditorMock{}
+
+// SlideEditorMock is a mock implementation of gdrive.SlideEditor.
+//
+//	func TestSomethingThatUsesSlideEditor(t *testing.T) {
+//
+//		// make and configure a mocked gdrive.SlideEditor
+//		mockedSlideEditor := &SlideEditorMock{
+//			ApplyPresentationTemplateFunc: func(ctx context.Context, presentationID string, templateID string, name string, folderID string) (*gdrive.ThemedPresentation, error) {
+//				panic("mock out the ApplyPresentationTemplate method")
+//			},
+//			BatchUpdatePresentationFunc: func(ctx context.Context, presentationID string, requests []json.RawMessage, requiredRevisionID string) (*slides.BatchUpdatePresentationResponse, error) {
+//				panic("mock out the BatchUpdatePresentation method")
+//			},
+//			DiffPresentationRevisionsFunc: func(ctx context.Context, presentationID string, baseRevisionID string, otherRevisionID string) (*gdrive.PresentationDiff, error) {
+//				panic("mock out the DiffPresentationRevisions method")
+//			},
+//			GetPresentationContentFunc: func(ctx context.Context, presentationID string) (string, error) {
+//				panic("mock out the GetPresentationContent method")
+//			},
+//			GetPresentationImagesFunc: func(ctx context.Context, presentationID string, includeData bool) ([]gdrive.SlideImage, error) {
+//				panic("mock out the GetPresentationImages method")
+//			},
+//			GetPresentationTextFunc: func(ctx context.Context, presentationID string) (*gdrive.PresentationText, error) {
+//				panic("mock out the GetPresentationText method")
+//			},
+//			GetSlideElementsFunc: func(ctx context.Context, presentationID string, slideIndex int) ([]gdrive.SlideElement, error) {
+//				panic("mock out the GetSlideElements method")
+//			},
+//			RefreshSheetsChartsFunc: func(ctx context.Context, presentationID string, objectIDs []string) ([]gdrive.LinkedChart, error) {
+//				panic("mock out the RefreshSheetsCharts method")
+//			},
+//			UpdatePresentationSlideFunc: func(ctx context.Context, presentationID string, slideIndex int, title string, content string) error {
+//				panic("mock out the UpdatePresentationSlide method")
+//			},
+//			UpdateSlideElementFunc: func(ctx context.Context, presentationID string, objectID string, update gdrive.SlideElementUpdate) (*gdrive.SlideElement, error) {
+//				panic("mock out the UpdateSlideElement method")
+//			},
+//		}
+//
+//		// use mockedSlideEditor in code that requires gdrive.SlideEditor
+//		// and then make assertions.
+//
+//	}
+type SlideEditorMock struct {
+	// ApplyPresentationTemplateFunc mocks the ApplyPresentationTemplate method.
+	ApplyPresentationTemplateFunc func(ctx context.Context, presentationID string, templateID string, name string, folderID string) (*gdrive.ThemedPresentation, error)
+
+	// BatchUpdatePresentationFunc mocks the BatchUpdatePresentation method.
+	BatchUpdatePresentationFunc func(ctx context.Context, presentationID string, requests []json.RawMessage, requiredRevisionID string) (*slides.BatchUpdatePresentationResponse, error)
+
+	// DiffPresentationRevisionsFunc mocks the DiffPresentationRevisions method.
+	DiffPresentationRevisionsFunc func(ctx context.Context, presentationID string, baseRevisionID string, otherRevisionID string) (*gdrive.PresentationDiff, error)
+
+	// GetPresentationContentFunc mocks the GetPresentationContent method.
+	GetPresentationContentFunc func(ctx context.Context, presentationID string) (string, error)
+
+	// GetPresentationImagesFunc mocks the GetPresentationImages method.
+	GetPresentationImagesFunc func(ctx context.Context, presentationID string, includeData bool) ([]gdrive.SlideImage, error)
+
+	// GetPresentationTextFunc mocks the GetPresentationText method.
+	GetPresentationTextFunc func(ctx context.Context, presentationID string) (*gdrive.PresentationText, error)
+
+	// GetSlideElementsFunc mocks the GetSlideElements method.
+	GetSlideElementsFunc func(ctx context.Context, presentationID string, slideIndex int) ([]gdrive.SlideElement, error)
+
+	// RefreshSheetsChartsFunc mocks the RefreshSheetsCharts method.
+	RefreshSheetsChartsFunc func(ctx context.Context, presentationID string, objectIDs []string) ([]gdrive.LinkedChart, error)
+
+	// UpdatePresentationSlideFunc mocks the UpdatePresentationSlide method.
+	UpdatePresentationSlideFunc func(ctx context.Context, presentationID string, slideIndex int, title string, content string) error
+
+	// UpdateSlideElementFunc mocks the UpdateSlideElement method.
+	UpdateSlideElementFunc func(ctx context.Context, presentationID string, objectID string, update gdrive.SlideElementUpdate) (*gdrive.SlideElement, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ApplyPresentationTemplate holds details about calls to the ApplyPresentationTemplate method.
+		ApplyPresentationTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PresentationID is the presentationID argument value.
+			PresentationID string
+			// TemplateID is the templateID argument value.
+			TemplateID string
+			// Name is the name argument value.
+			Name string
+			// FolderID is the folderID argument value.
+			FolderID string
+		}
+		// BatchUpdatePresentation holds details about calls to the BatchUpdatePresentation method.
+		BatchUpdatePresentation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PresentationID is the presentationID argument value.
+			PresentationID string
+			// Requests is the requests argument value.
+			Requests []json.RawMessage
+			// RequiredRevisionID is the requiredRevisionID argument value.
+			RequiredRevisionID string
+		}
+		// DiffPresentationRevisions holds details about calls to the DiffPresentationRevisions method.
+		DiffPresentationRevisions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PresentationID is the presentationID argument value.
+			PresentationID string
+			// BaseRevisionID is the baseRevisionID argument value.
+			BaseRevisionID string
+			// OtherRevisionID is the otherRevisionID argument value.
+			OtherRevisionID string
+		}
+		// GetPresentationContent holds details about calls to the GetPresentationContent method.
+		GetPresentationContent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PresentationID is the presentationID argument value.
+			PresentationID string
+		}
+		// GetPresentationImages holds details about calls to the GetPresentationImages method.
+		GetPresentationImages []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PresentationID is the presentationID argument value.
+			PresentationID string
+			// IncludeData is the includeData argument value.
+			IncludeData bool
+		}
+		// GetPresentationText holds details about calls to the GetPresentationText method.
+		GetPresentationText []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PresentationID is the presentationID argument value.
+			PresentationID string
+		}
+		// GetSlideElements holds details about calls to the GetSlideElements method.
+		GetSlideElements []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PresentationID is the presentationID argument value.
+			PresentationID string
+			// SlideIndex is the slideIndex argument value.
+			SlideIndex int
+		}
+		// RefreshSheetsCharts holds details about calls to the RefreshSheetsCharts method.
+		RefreshSheetsCharts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PresentationID is the presentationID argument value.
+			PresentationID string
+			// ObjectIDs is the objectIDs argument value.
+			ObjectIDs []string
+		}
+		// UpdatePresentationSlide holds details about calls to the UpdatePresentationSlide method.
+		UpdatePresentationSlide []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PresentationID is the presentationID argument value.
+			PresentationID string
+			// SlideIndex is the slideIndex argument value.
+			SlideIndex int
+			// Title is the title argument value.
+			Title string
+			// Content is the content argument value.
+			Content string
+		}
+		// UpdateSlideElement holds details about calls to the UpdateSlideElement method.
+		UpdateSlideElement []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PresentationID is the presentationID argument value.
+			PresentationID string
+			// ObjectID is the objectID argument value.
+			ObjectID string
+			// Update is the update argument value.
+			Update gdrive.SlideElementUpdate
+		}
+	}
+	lockApplyPresentationTemplate sync.RWMutex
+	lockBatchUpdatePresentation   sync.RWMutex
+	lockDiffPresentationRevisions sync.RWMutex
+	lockGetPresentationContent    sync.RWMutex
+	lockGetPresentationImages     sync.RWMutex
+	lockGetPresentationText       sync.RWMutex
+	lockGetSlideElements          sync.RWMutex
+	lockRefreshSheetsCharts       sync.RWMutex
+	lockUpdatePresentationSlide   sync.RWMutex
+	lockUpdateSlideElement        sync.RWMutex
+}
+
+// ApplyPresentationTemplate calls ApplyPresentationTemplateFunc.
+func (mock *SlideEditorMock) ApplyPresentationTemplate(ctx context.Context, presentationID string, templateID string, name string, folderID string) (*gdrive.ThemedPresentation, error) {
+	if mock.ApplyPresentationTemplateFunc == nil {
+		panic("SlideEditorMock.ApplyPresentationTemplateFunc: method is nil but SlideEditor.ApplyPresentationTemplate was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		PresentationID string
+		TemplateID     string
+		Name           string
+		FolderID       string
+	}{
+		Ctx:            ctx,
+		PresentationID: presentationID,
+		TemplateID:     templateID,
+		Name:           name,
+		FolderID:       folderID,
+	}
+	mock.lockApplyPresentationTemplate.Lock()
+	mock.calls.ApplyPresentationTemplate = append(mock.calls.ApplyPresentationTemplate, callInfo)
+	mock.lockApplyPresentationTemplate.Unlock()
+	return mock.ApplyPresentationTemplateFunc(ctx, presentationID, templateID, name, folderID)
+}
+
+// ApplyPresentationTemplateCalls gets all the calls that were made to ApplyPresentationTemplate.
+// Check the length with:
+//
+//	len(mockedSlideEditor.ApplyPresentationTemplateCalls())
+func (mock *SlideEditorMock) ApplyPresentationTemplateCalls() []struct {
+	Ctx            context.Context
+	PresentationID string
+	TemplateID     string
+	Name           string
+	FolderID       string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		PresentationID string
+		TemplateID     string
+		Name           string
+		FolderID       string
+	}
+	mock.lockApplyPresentationTemplate.RLock()
+	calls = mock.calls.ApplyPresentationTemplate
+	mock.lockApplyPresentationTemplate.RUnlock()
+	return calls
+}
+
+// BatchUpdatePresentation calls BatchUpdatePresentationFunc.
+func (mock *SlideEditorMock) BatchUpdatePresentation(ctx context.Context, presentationID string, requests []json.RawMessage, requiredRevisionID string) (*slides.BatchUpdatePresentationResponse, error) {
+	if mock.BatchUpdatePresentationFunc == nil {
+		panic("SlideEditorMock.BatchUpdatePresentationFunc: method is nil but SlideEditor.BatchUpdatePresentation was just called")
+	}
+	callInfo := struct {
+		Ctx                context.Context
+		PresentationID     string
+		Requests           []json.RawMessage
+		RequiredRevisionID string
+	}{
+		Ctx:                ctx,
+		PresentationID:     presentationID,
+		Requests:           requests,
+		RequiredRevisionID: requiredRevisionID,
+	}
+	mock.lockBatchUpdatePresentation.Lock()
+	mock.calls.BatchUpdatePresentation = append(mock.calls.BatchUpdatePresentation, callInfo)
+	mock.lockBatchUpdatePresentation.Unlock()
+	return mock.BatchUpdatePresentationFunc(ctx, presentationID, requests, requiredRevisionID)
+}
+
+// BatchUpdatePresentationCalls gets all the calls that were made to BatchUpdatePresentation.
+// Check the length with:
+//
+//	len(mockedSlideEditor.BatchUpdatePresentationCalls())
+func (mock *SlideEditorMock) BatchUpdatePresentationCalls() []struct {
+	Ctx                context.Context
+	PresentationID     string
+	Requests           []json.RawMessage
+	RequiredRevisionID string
+} {
+	var calls []struct {
+		Ctx                context.Context
+		PresentationID     string
+		Requests           []json.RawMessage
+		RequiredRevisionID string
+	}
+	mock.lockBatchUpdatePresentation.RLock()
+	calls = mock.calls.BatchUpdatePresentation
+	mock.lockBatchUpdatePresentation.RUnlock()
+	return calls
+}
+
+// DiffPresentationRevisions calls DiffPresentationRevisionsFunc.
+func (mock *SlideEditorMock) DiffPresentationRevisions(ctx context.Context, presentationID string, baseRevisionID string, otherRevisionID string) (*gdrive.PresentationDiff, error) {
+	if mock.DiffPresentationRevisionsFunc == nil {
+		panic("SlideEditorMock.DiffPresentationRevisionsFunc: method is nil but SlideEditor.DiffPresentationRevisions was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		PresentationID  string
+		BaseRevisionID  string
+		OtherRevisionID string
+	}{
+		Ctx:             ctx,
+		PresentationID:  presentationID,
+		BaseRevisionID:  baseRevisionID,
+		OtherRevisionID: otherRevisionID,
+	}
+	mock.lockDiffPresentationRevisions.Lock()
+	mock.calls.DiffPresentationRevisions = append(mock.calls.DiffPresentationRevisions, callInfo)
+	mock.lockDiffPresentationRevisions.Unlock()
+	return mock.DiffPresentationRevisionsFunc(ctx, presentationID, baseRevisionID, otherRevisionID)
+}
+
+// DiffPresentationRevisionsCalls gets all the calls that were made to DiffPresentationRevisions.
+// Check the length with:
+//
+//	len(mockedSlideEditor.DiffPresentationRevisionsCalls())
+func (mock *SlideEditorMock) DiffPresentationRevisionsCalls() []struct {
+	Ctx             context.Context
+	PresentationID  string
+	BaseRevisionID  string
+	OtherRevisionID string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		PresentationID  string
+		BaseRevisionID  string
+		OtherRevisionID string
+	}
+	mock.lockDiffPresentationRevisions.RLock()
+	calls = mock.calls.DiffPresentationRevisions
+	mock.lockDiffPresentationRevisions.RUnlock()
+	return calls
+}
+
+// GetPresentationContent calls GetPresentationContentFunc.
+func (mock *SlideEditorMock) GetPresentationContent(ctx context.Context, presentationID string) (string, error) {
+	if mock.GetPresentationContentFunc == nil {
+		panic("SlideEditorMock.GetPresentationContentFunc: method is nil but SlideEditor.GetPresentationContent was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		PresentationID string
+	}{
+		Ctx:            ctx,
+		PresentationID: presentationID,
+	}
+	mock.lockGetPresentationContent.Lock()
+	mock.calls.GetPresentationContent = append(mock.calls.GetPresentationContent, callInfo)
+	mock.lockGetPresentationContent.Unlock()
+	return mock.GetPresentationContentFunc(ctx, presentationID)
+}
+
+// GetPresentationContentCalls gets all the calls that were made to GetPresentationContent.
+// Check the length with:
+//
+//	len(mockedSlideEditor.GetPresentationContentCalls())
+func (mock *SlideEditorMock) GetPresentationContentCalls() []struct {
+	Ctx            context.Context
+	PresentationID string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		PresentationID string
+	}
+	mock.lockGetPresentationContent.RLock()
+	calls = mock.calls.GetPresentationContent
+	mock.lockGetPresentationContent.RUnlock()
+	return calls
+}
+
+// GetPresentationImages calls GetPresentationImagesFunc.
+func (mock *SlideEditorMock) GetPresentationImages(ctx context.Context, presentationID string, includeData bool) ([]gdrive.SlideImage, error) {
+	if mock.GetPresentationImagesFunc == nil {
+		panic("SlideEditorMock.GetPresentationImagesFunc: method is nil but SlideEditor.GetPresentationImages was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		PresentationID string
+		IncludeData    bool
+	}{
+		Ctx:            ctx,
+		PresentationID: presentationID,
+		IncludeData:    includeData,
+	}
+	mock.lockGetPresentationImages.Lock()
+	mock.calls.GetPresentationImages = append(mock.calls.GetPresentationImages, callInfo)
+	mock.lockGetPresentationImages.Unlock()
+	return mock.GetPresentationImagesFunc(ctx, presentationID, includeData)
+}
+
+// GetPresentationImagesCalls gets all the calls that were made to GetPresentationImages.
+// Check the length with:
+//
+//	len(mockedSlideEditor.GetPresentationImagesCalls())
+func (mock *SlideEditorMock) GetPresentationImagesCalls() []struct {
+	Ctx            context.Context
+	PresentationID string
+	IncludeData    bool
+} {
+	var calls []struct {
+		Ctx            context.Context
+		PresentationID string
+		IncludeData    bool
+	}
+	mock.lockGetPresentationImages.RLock()
+	calls = mock.calls.GetPresentationImages
+	mock.lockGetPresentationImages.RUnlock()
+	return calls
+}
+
+// GetPresentationText calls GetPresentationTextFunc.
+func (mock *SlideEditorMock) GetPresentationText(ctx context.Context, presentationID string) (*gdrive.PresentationText, error) {
+	if mock.GetPresentationTextFunc == nil {
+		panic("SlideEditorMock.GetPresentationTextFunc: method is nil but SlideEditor.GetPresentationText was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		PresentationID string
+	}{
+		Ctx:            ctx,
+		PresentationID: presentationID,
+	}
+	mock.lockGetPresentationText.Lock()
+	mock.calls.GetPresentationText = append(mock.calls.GetPresentationText, callInfo)
+	mock.lockGetPresentationText.Unlock()
+	return mock.GetPresentationTextFunc(ctx, presentationID)
+}
+
+// GetPresentationTextCalls gets all the calls that were made to GetPresentationText.
+// Check the length with:
+//
+//	len(mockedSlideEditor.GetPresentationTextCalls())
+func (mock *SlideEditorMock) GetPresentationTextCalls() []struct {
+	Ctx            context.Context
+	PresentationID string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		PresentationID string
+	}
+	mock.lockGetPresentationText.RLock()
+	calls = mock.calls.GetPresentationText
+	mock.lockGetPresentationText.RUnlock()
+	return calls
+}
+
+// GetSlideElements calls GetSlideElementsFunc.
+func (mock *SlideEditorMock) GetSlideElements(ctx context.Context, presentationID string, slideIndex int) ([]gdrive.SlideElement, error) {
+	if mock.GetSlideElementsFunc == nil {
+		panic("SlideEditorMock.GetSlideElementsFunc: method is nil but SlideEditor.GetSlideElements was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		PresentationID string
+		SlideIndex     int
+	}{
+		Ctx:            ctx,
+		PresentationID: presentationID,
+		SlideIndex:     slideIndex,
+	}
+	mock.lockGetSlideElements.Lock()
+	mock.calls.GetSlideElements = append(mock.calls.GetSlideElements, callInfo)
+	mock.lockGetSlideElements.Unlock()
+	return mock.GetSlideElementsFunc(ctx, presentationID, slideIndex)
+}
+
+// GetSlideElementsCalls gets all the calls that were made to GetSlideElements.
+// Check the length with:
+//
+//	len(mockedSlideEditor.GetSlideElementsCalls())
+func (mock *SlideEditorMock) GetSlideElementsCalls() []struct {
+	Ctx            context.Context
+	PresentationID string
+	SlideIndex     int
+} {
+	var calls []struct {
+		Ctx            context.Context
+		PresentationID string
+		SlideIndex     int
+	}
+	mock.lockGetSlideElements.RLock()
+	calls = mock.calls.GetSlideElements
+	mock.lockGetSlideElements.RUnlock()
+	return calls
+}
+
+// RefreshSheetsCharts calls RefreshSheetsChartsFunc.
+func (mock *SlideEditorMock) RefreshSheetsCharts(ctx context.Context, presentationID string, objectIDs []string) ([]gdrive.LinkedChart, error) {
+	if mock.RefreshSheetsChartsFunc == nil {
+		panic("SlideEditorMock.RefreshSheetsChartsFunc: method is nil but SlideEditor.RefreshSheetsCharts was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		PresentationID string
+		ObjectIDs      []string
+	}{
+		Ctx:            ctx,
+		PresentationID: presentationID,
+		ObjectIDs:      objectIDs,
+	}
+	mock.lockRefreshSheetsCharts.Lock()
+	mock.calls.RefreshSheetsCharts = append(mock.calls.RefreshSheetsCharts, callInfo)
+	mock.lockRefreshSheetsCharts.Unlock()
+	return mock.RefreshSheetsChartsFunc(ctx, presentationID, objectIDs)
+}
+
+// RefreshSheetsChartsCalls gets all the calls that were made to RefreshSheetsCharts.
+// Check the length with:
+//
+//	len(mockedSlideEditor.RefreshSheetsChartsCalls())
+func (mock *SlideEditorMock) RefreshSheetsChartsCalls() []struct {
+	Ctx            context.Context
+	PresentationID string
+	ObjectIDs      []string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		PresentationID string
+		ObjectIDs      []string
+	}
+	mock.lockRefreshSheetsCharts.RLock()
+	calls = mock.calls.RefreshSheetsCharts
+	mock.lockRefreshSheetsCharts.RUnlock()
+	return calls
+}
+
+// UpdatePresentationSlide calls UpdatePresentationSlideFunc.
+func (mock *SlideEditorMock) UpdatePresentationSlide(ctx context.Context, presentationID string, slideIndex int, title string, content string) error {
+	if mock.UpdatePresentationSlideFunc == nil {
+		panic("SlideEditorMock.UpdatePresentationSlideFunc: method is nil but SlideEditor.UpdatePresentationSlide was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		PresentationID string
+		SlideIndex     int
+		Title          string
+		Content        string
+	}{
+		Ctx:            ctx,
+		PresentationID: presentationID,
+		SlideIndex:     slideIndex,
+		Title:          title,
+		Content:        content,
+	}
+	mock.lockUpdatePresentationSlide.Lock()
+	mock.calls.UpdatePresentationSlide = append(mock.calls.UpdatePresentationSlide, callInfo)
+	mock.lockUpdatePresentationSlide.Unlock()
+	return mock.UpdatePresentationSlideFunc(ctx, presentationID, slideIndex, title, content)
+}
+
+// UpdatePresentationSlideCalls gets all the calls that were made to UpdatePresentationSlide.
+// Check the length with:
+//
+//	len(mockedSlideEditor.UpdatePresentationSlideCalls())
+func (mock *SlideEditorMock) UpdatePresentationSlideCalls() []struct {
+	Ctx            context.Context
+	PresentationID string
+	SlideIndex     int
+	Title          string
+	Content        string
+} {
+	var calls []struct {
+		Ctx            context.Context
+		PresentationID string
+		SlideIndex     int
+		Title          string
+		Content        string
+	}
+	mock.lockUpdatePresentationSlide.RLock()
+	calls = mock.calls.UpdatePresentationSlide
+	mock.lockUpdatePresentationSlide.RUnlock()
+	return calls
+}
+
+// UpdateSlideElement calls UpdateSlideElementFunc.
+func (mock *SlideEditorMock) UpdateSlideElement(ctx context.Context, presentationID string, objectID string, update gdrive.SlideElementUpdate) (*gdrive.SlideElement, error) {
+	if mock.UpdateSlideElementFunc == nil {
+		panic("SlideEditorMock.UpdateSlideElementFunc: method is nil but SlideEditor.UpdateSlideElement was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		PresentationID string
+		ObjectID       string
+		Update         gdrive.SlideElementUpdate
+	}{
+		Ctx:            ctx,
+		PresentationID: presentationID,
+		ObjectID:       objectID,
+		Update:         update,
+	}
+	mock.lockUpdateSlideElement.Lock()
+	mock.calls.UpdateSlideElement = append(mock.calls.UpdateSlideElement, callInfo)
+	mock.lockUpdateSlideElement.Unlock()
+	return mock.UpdateSlideElementFunc(ctx, presentationID, objectID, update)
+}
+
+// UpdateSlideElementCalls gets all the calls that were made to UpdateSlideElement.
+// Check the length with:
+//
+//	len(mockedSlideEditor.UpdateSlideElementCalls())
+func (mock *SlideEditorMock) UpdateSlideElementCalls() []struct {
+	Ctx            context.Context
+	PresentationID string
+	ObjectID       string
+	Update         gdrive.SlideElementUpdate
+} {
+	var calls []struct {
+		Ctx            context.Context
+		PresentationID string
+		ObjectID       string
+		Update         gdrive.SlideElementUpdate
+	}
+	mock.lockUpdateSlideElement.RLock()
+	calls = mock.calls.UpdateSlideElement
+	mock.lockUpdateSlideElement.RUnlock()
+	return calls
+}
+
+// Ensure, that SheetEditorMock does implement gdrive.SheetEditor.
+// If this is not the case, regenerate this file with moq.
+var _ gdrive.SheetEditor = &SheetEditorMock{}
+
+// SheetEditorMock is a mock implementation of gdrive.SheetEditor.
+//
+//	func TestSomethingThatUsesSheetEditor(t *testing.T) {
+//
+//		// make and configure a mocked gdrive.SheetEditor
+//		mockedSheetEditor := &SheetEditorMock{
+//			AppendSheetTableRowsFunc: func(ctx context.Context, spreadsheetID string, locator gdrive.SheetTableLocator, rows [][]interface{}) (*gdrive.SheetAppendResult, error) {
+//				panic("mock out the AppendSheetTableRows method")
+//			},
+//			AuditSpreadsheetProtectionFunc: func(ctx context.Context, spreadsheetID string) (*gdrive.SpreadsheetAudit, error) {
+//				panic("mock out the AuditSpreadsheetProtection method")
+//			},
+//			CopySpreadsheetRangeFunc: func(ctx context.Context, source gdrive.SpreadsheetRange, destination gdrive.SpreadsheetRange, mode string) (*gdrive.SpreadsheetRangeCopy, error) {
+//				panic("mock out the CopySpreadsheetRange method")
+//			},
+//			GetSpreadsheetValuesFunc: func(ctx context.Context, spreadsheetID string, rangeName string, options gdrive.SpreadsheetValuesOptions) ([][]interface{}, error) {
+//				panic("mock out the GetSpreadsheetValues method")
+//			},
+//			SnapshotSpreadsheetFunc: func(ctx context.Context, spreadsheetID string, folderID string, asXLSX bool) (*gdrive.SpreadsheetSnapshot, error) {
+//				panic("mock out the SnapshotSpreadsheet method")
+//			},
+//			UpdateSpreadsheetValuesFunc: func(ctx context.Context, spreadsheetID string, rangeName string, values [][]interface{}) error {
+//				panic("mock out the UpdateSpreadsheetValues method")
+//			},
+//		}
+//
+//		// use mockedSheetEditor in code that requires gdrive.SheetEditor
+//		// and then make assertions.
+//
+//	}
+type SheetEditorMock struct {
+	// AppendSheetTableRowsFunc mocks the AppendSheetTableRows method.
+	AppendSheetTableRowsFunc func(ctx context.Context, spreadsheetID string, locator gdrive.SheetTableLocator, rows [][]interface{}) (*gdrive.SheetAppendResult, error)
+
+	// AuditSpreadsheetProtectionFunc mocks the AuditSpreadsheetProtection method.
+	AuditSpreadsheetProtectionFunc func(ctx context.Context, spreadsheetID string) (*gdrive.SpreadsheetAudit, error)
+
+	// CopySpreadsheetRangeFunc mocks the CopySpreadsheetRange method.
+	CopySpreadsheetRangeFunc func(ctx context.Context, source gdrive.SpreadsheetRange, destination gdrive.SpreadsheetRange, mode string) (*gdrive.SpreadsheetRangeCopy, error)
+
+	// GetSpreadsheetValuesFunc mocks the GetSpreadsheetValues method.
+	GetSpreadsheetValuesFunc func(ctx context.Context, spreadsheetID string, rangeName string, options gdrive.SpreadsheetValuesOptions) ([][]interface{}, error)
+
+	// SnapshotSpreadsheetFunc mocks the SnapshotSpreadsheet method.
+	SnapshotSpreadsheetFunc func(ctx context.Context, spreadsheetID string, folderID string, asXLSX bool) (*gdrive.SpreadsheetSnapshot, error)
+
+	// UpdateSpreadsheetValuesFunc mocks the UpdateSpreadsheetValues method.
+	UpdateSpreadsheetValuesFunc func(ctx context.Context, spreadsheetID string, rangeName string, values [][]interface{}) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AppendSheetTableRows holds details about calls to the AppendSheetTableRows method.
+		AppendSheetTableRows []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpreadsheetID is the spreadsheetID argument value.
+			SpreadsheetID string
+			// Locator is the locator argument value.
+			Locator gdrive.SheetTableLocator
+			// Rows is the rows argument value.
+			Rows [][]interface{}
+		}
+		// AuditSpreadsheetProtection holds details about calls to the AuditSpreadsheetProtection method.
+		AuditSpreadsheetProtection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpreadsheetID is the spreadsheetID argument value.
+			SpreadsheetID string
+		}
+		// CopySpreadsheetRange holds details about calls to the CopySpreadsheetRange method.
+		CopySpreadsheetRange []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Source is the source argument value.
+			Source gdrive.SpreadsheetRange
+			// Destination is the destination argument value.
+			Destination gdrive.SpreadsheetRange
+			// Mode is the mode argument value.
+			Mode string
+		}
+		// GetSpreadsheetValues holds details about calls to the GetSpreadsheetValues method.
+		GetSpreadsheetValues []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpreadsheetID is the spreadsheetID argument value.
+			SpreadsheetID string
+			// RangeName is the rangeName argument value.
+			RangeName string
+			// Options is the options argument value.
+			Options gdrive.SpreadsheetValuesOptions
+		}
+		// SnapshotSpreadsheet holds details about calls to the SnapshotSpreadsheet method.
+		SnapshotSpreadsheet []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpreadsheetID is the spreadsheetID argument value.
+			SpreadsheetID string
+			// FolderID is the folderID argument value.
+			FolderID string
+			// AsXLSX is the asXLSX argument value.
+			AsXLSX bool
+		}
+		// UpdateSpreadsheetValues holds details about calls to the UpdateSpreadsheetValues method.
+		UpdateSpreadsheetValues []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpreadsheetID is the spreadsheetID argument value.
+			SpreadsheetID string
+			// RangeName is the rangeName argument value.
+			RangeName string
+			// Values is the values argument value.
+			Values [][]interface{}
+		}
+	}
+	lockAppendSheetTableRows       sync.RWMutex
+	lockAuditSpreadsheetProtection sync.RWMutex
+	lockCopySpreadsheetRange       sync.RWMutex
+	lockGetSpreadsheetValues       sync.RWMutex
+	lockSnapshotSpreadsheet        sync.RWMutex
+	lockUpdateSpreadsheetValues    sync.RWMutex
+}
+
+// AppendSheetTableRows calls AppendSheetTableRowsFunc.
+func (mock *SheetEditorMock) AppendSheetTableRows(ctx context.Context, spreadsheetID string, locator gdrive.SheetTableLocator, rows [][]interface{}) (*gdrive.SheetAppendResult, error) {
+	if mock.AppendSheetTableRowsFunc == nil {
+		panic("SheetEditorMock.AppendSheetTableRowsFunc: method is nil but SheetEditor.AppendSheetTableRows was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		SpreadsheetID string
+		Locator       gdrive.SheetTableLocator
+		Rows          [][]interface{}
+	}{
+		Ctx:           ctx,
+		SpreadsheetID: spreadsheetID,
+		Locator:       locator,
+		Rows:          rows,
+	}
+	mock.lockAppendSheetTableRows.Lock()
+	mock.calls.AppendSheetTableRows = append(mock.calls.AppendSheetTableRows, callInfo)
+	mock.lockAppendSheetTableRows.Unlock()
+	return mock.AppendSheetTableRowsFunc(ctx, spreadsheetID, locator, rows)
+}
+
+// AppendSheetTableRowsCalls gets all the calls that were made to AppendSheetTableRows.
+// Check the length with:
+//
+//	len(mockedSheetEditor.AppendSheetTableRowsCalls())
+func (mock *SheetEditorMock) AppendSheetTableRowsCalls() []struct {
+	Ctx           context.Context
+	SpreadsheetID string
+	Locator       gdrive.SheetTableLocator
+	Rows          [][]interface{}
+} {
+	var calls []struct {
+		Ctx           context.Context
+		SpreadsheetID string
+		Locator       gdrive.SheetTableLocator
+		Rows          [][]interface{}
+	}
+	mock.lockAppendSheetTableRows.RLock()
+	calls = mock.calls.AppendSheetTableRows
+	mock.lockAppendSheetTableRows.RUnlock()
+	return calls
+}
+
+// AuditSpreadsheetProtection calls AuditSpreadsheetProtectionFunc.
+func (mock *SheetEditorMock) AuditSpreadsheetProtection(ctx context.Context, spreadsheetID string) (*gdrive.SpreadsheetAudit, error) {
+	if mock.AuditSpreadsheetProtectionFunc == nil {
+		panic("SheetEditorMock.AuditSpreadsheetProtectionFunc: method is nil but SheetEditor.AuditSpreadsheetProtection was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		SpreadsheetID string
+	}{
+		Ctx:           ctx,
+		SpreadsheetID: spreadsheetID,
+	}
+	mock.lockAuditSpreadsheetProtection.Lock()
+	mock.calls.AuditSpreadsheetProtection = append(mock.calls.AuditSpreadsheetProtection, callInfo)
+	mock.lockAuditSpreadsheetProtection.Unlock()
+	return mock.AuditSpreadsheetProtectionFunc(ctx, spreadsheetID)
+}
+
+// AuditSpreadsheetProtectionCalls gets all the calls that were made to AuditSpreadsheetProtection.
+// Check the length with:
+//
+//	len(mockedSheetEditor.AuditSpreadsheetProtectionCalls())
+func (mock *SheetEditorMock) AuditSpreadsheetProtectionCalls() []struct {
+	Ctx           context.Context
+	SpreadsheetID string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		SpreadsheetID string
+	}
+	mock.lockAuditSpreadsheetProtection.RLock()
+	calls = mock.calls.AuditSpreadsheetProtection
+	mock.lockAuditSpreadsheetProtection.RUnlock()
+	return calls
+}
+
+// CopySpreadsheetRange calls CopySpreadsheetRangeFunc.
+func (mock *SheetEditorMock) CopySpreadsheetRange(ctx context.Context, source gdrive.SpreadsheetRange, destination gdrive.SpreadsheetRange, mode string) (*gdrive.SpreadsheetRangeCopy, error) {
+	if mock.CopySpreadsheetRangeFunc == nil {
+		panic("SheetEditorMock.CopySpreadsheetRangeFunc: method is nil but SheetEditor.CopySpreadsheetRange was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Source      gdrive.SpreadsheetRange
+		Destination gdrive.SpreadsheetRange
+		Mode        string
+	}{
+		Ctx:         ctx,
+		Source:      source,
+		Destination: destination,
+		Mode:        mode,
+	}
+	mock.lockCopySpreadsheetRange.Lock()
+	mock.calls.CopySpreadsheetRange = append(mock.calls.CopySpreadsheetRange, callInfo)
+	mock.lockCopySpreadsheetRange.Unlock()
+	return mock.CopySpreadsheetRangeFunc(ctx, source, destination, mode)
+}
+
+// CopySpreadsheetRangeCalls gets all the calls that were made to CopySpreadsheetRange.
+// Check the length with:
+//
+//	len(mockedSheetEditor.CopySpreadsheetRangeCalls())
+func (mock *SheetEditorMock) CopySpreadsheetRangeCalls() []struct {
+	Ctx         context.Context
+	Source      gdrive.SpreadsheetRange
+	Destination gdrive.SpreadsheetRange
+	Mode        string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Source      gdrive.SpreadsheetRange
+		Destination gdrive.SpreadsheetRange
+		Mode        string
+	}
+	mock.lockCopySpreadsheetRange.RLock()
+	calls = mock.calls.CopySpreadsheetRange
+	mock.lockCopySpreadsheetRange.RUnlock()
+	return calls
+}
+
+// GetSpreadsheetValues calls GetSpreadsheetValuesFunc.
+func (mock *SheetEditorMock) GetSpreadsheetValues(ctx context.Context, spreadsheetID string, rangeName string, options gdrive.SpreadsheetValuesOptions) ([][]interface{}, error) {
+	if mock.GetSpreadsheetValuesFunc == nil {
+		panic("SheetEditorMock.GetSpreadsheetValuesFunc: method is nil but SheetEditor.GetSpreadsheetValues was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		SpreadsheetID string
+		RangeName     string
+		Options       gdrive.SpreadsheetValuesOptions
+	}{
+		Ctx:           ctx,
+		SpreadsheetID: spreadsheetID,
+		RangeName:     rangeName,
+		Options:       options,
+	}
+	mock.lockGetSpreadsheetValues.Lock()
+	mock.calls.GetSpreadsheetValues = append(mock.calls.GetSpreadsheetValues, callInfo)
+	mock.lockGetSpreadsheetValues.Unlock()
+	return mock.GetSpreadsheetValuesFunc(ctx, spreadsheetID, rangeName, options)
+}
+
+// GetSpreadsheetValuesCalls gets all the calls that were made to GetSpreadsheetValues.
+// Check the length with:
+//
+//	len(mockedSheetEditor.GetSpreadsheetValuesCalls())
+func (mock *SheetEditorMock) GetSpreadsheetValuesCalls() []struct {
+	Ctx           context.Context
+	SpreadsheetID string
+	RangeName     string
+	Options       gdrive.SpreadsheetValuesOptions
+} {
+	var calls []struct {
+		Ctx           context.Context
+		SpreadsheetID string
+		RangeName     string
+		Options       gdrive.SpreadsheetValuesOptions
+	}
+	mock.lockGetSpreadsheetValues.RLock()
+	calls = mock.calls.GetSpreadsheetValues
+	mock.lockGetSpreadsheetValues.RUnlock()
+	return calls
+}
+
+// SnapshotSpreadsheet calls SnapshotSpreadsheetFunc.
+func (mock *SheetEditorMock) SnapshotSpreadsheet(ctx context.Context, spreadsheetID string, folderID string, asXLSX bool) (*gdrive.SpreadsheetSnapshot, error) {
+	if mock.SnapshotSpreadsheetFunc == nil {
+		panic("SheetEditorMock.SnapshotSpreadsheetFunc: method is nil but SheetEditor.SnapshotSpreadsheet was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		SpreadsheetID string
+		FolderID      string
+		AsXLSX        bool
+	}{
+		Ctx:           ctx,
+		SpreadsheetID: spreadsheetID,
+		FolderID:      folderID,
+		AsXLSX:        asXLSX,
+	}
+	mock.lockSnapshotSpreadsheet.Lock()
+	mock.calls.SnapshotSpreadsheet = append(mock.calls.SnapshotSpreadsheet, callInfo)
+	mock.lockSnapshotSpreadsheet.Unlock()
+	return mock.SnapshotSpreadsheetFunc(ctx, spreadsheetID, folderID, asXLSX)
+}
+
+// SnapshotSpreadsheetCalls gets all the calls that were made to SnapshotSpreadsheet.
+// Check the length with:
+//
+//	len(mockedSheetEditor.SnapshotSpreadsheetCalls())
+func (mock *SheetEditorMock) SnapshotSpreadsheetCalls() []struct {
+	Ctx           context.Context
+	SpreadsheetID string
+	FolderID      string
+	AsXLSX        bool
+} {
+	var calls []struct {
+		Ctx           context.Context
+		SpreadsheetID string
+		FolderID      string
+		AsXLSX        bool
+	}
+	mock.lockSnapshotSpreadsheet.RLock()
+	calls = mock.calls.SnapshotSpreadsheet
+	mock.lockSnapshotSpreadsheet.RUnlock()
+	return calls
+}
+
+// UpdateSpreadsheetValues calls UpdateSpreadsheetValuesFunc.
+func (mock *SheetEditorMock) UpdateSpreadsheetValues(ctx context.Context, spreadsheetID string, rangeName string, values [][]interface{}) error {
+	if mock.UpdateSpreadsheetValuesFunc == nil {
+		panic("SheetEditorMock.UpdateSpreadsheetValuesFunc: method is nil but SheetEditor.UpdateSpreadsheetValues was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		SpreadsheetID string
+		RangeName     string
+		Values        [][]interface{}
+	}{
+		Ctx:           ctx,
+		SpreadsheetID: spreadsheetID,
+		RangeName:     rangeName,
+		Values:        values,
+	}
+	mock.lockUpdateSpreadsheetValues.Lock()
+	mock.calls.UpdateSpreadsheetValues = append(mock.calls.UpdateSpreadsheetValues, callInfo)
+	mock.lockUpdateSpreadsheetValues.Unlock()
+	return mock.UpdateSpreadsheetValuesFunc(ctx, spreadsheetID, rangeName, values)
+}
+
+// UpdateSpreadsheetValuesCalls gets all the calls that were made to UpdateSpreadsheetValues.
+// Check the length with:
+//
+//	len(mockedSheetEditor.UpdateSpreadsheetValuesCalls())
+func (mock *SheetEditorMock) UpdateSpreadsheetValuesCalls() []struct {
+	Ctx           context.Context
+	SpreadsheetID string
+	RangeName     string
+	Values        [][]interface{}
+} {
+	var calls []struct {
+		Ctx           context.Context
+		SpreadsheetID string
+		RangeName     string
+		Values        [][]interface{}
+	}
+	mock.lockUpdateSpreadsheetValues.RLock()
+	calls = mock.calls.UpdateSpreadsheetValues
+	mock.lockUpdateSpreadsheetValues.RUnlock()
+	return calls
+}
+
+// Ensure, that FileOrganizerMock does implement gdrive.FileOrganizer.
+// If this is not the case, regenerate this file with moq.
+var _ gdrive.FileOrganizer = &FileOrganizerMock{}
+
+// FileOrganizerMock is a mock implementation of gdrive.FileOrganizer.
+//
+//	func TestSomethingThatUsesFileOrganizer(t *testing.T) {
+//
+//		// make and configure a mocked gdrive.FileOrganizer
+//		mockedFileOrganizer := &FileOrganizerMock{
+//			AddCommentFunc: func(ctx context.Context, fileID string, content string) (*gdrive.Comment, error) {
+//				panic("mock out the AddComment method")
+//			},
+//			BatchFileOperationFunc: func(ctx context.Context, fileIDs []string, op gdrive.BatchOperation) ([]gdrive.FileResult, error) {
+//				panic("mock out the BatchFileOperation method")
+//			},
+//			CopyFileFunc: func(ctx context.Context, fileID string, name string, folderID string, convert bool) (*gdrive.DriveFile, error) {
+//				panic("mock out the CopyFile method")
+//			},
+//			CopyFolderFunc: func(ctx context.Context, folderID string, name string, destinationFolderID string, replacements map[string]string) (*gdrive.FolderCopy, error) {
+//				panic("mock out the CopyFolder method")
+//			},
+//			CreateFolderFunc: func(ctx context.Context, name string, parentID string) (*gdrive.DriveFile, error) {
+//				panic("mock out the CreateFolder method")
+//			},
+//			CreateShortcutFunc: func(ctx context.Context, targetID string, name string, folderID string) (*gdrive.DriveFile, error) {
+//				panic("mock out the CreateShortcut method")
+//			},
+//			DeleteFileFunc: func(ctx context.Context, fileID string) error {
+//				panic("mock out the DeleteFile method")
+//			},
+//			EmptyTrashFunc: func(ctx context.Context) error {
+//				panic("mock out the EmptyTrash method")
+//			},
+//			ExportFolderZipFunc: func(ctx context.Context, folderID string, opts gdrive.FolderArchiveOptions) (*gdrive.FolderArchive, error) {
+//				panic("mock out the ExportFolderZip method")
+//			},
+//			FindEmptyFoldersFunc: func(ctx context.Context, folderID string, dryRun bool) (*gdrive.EmptyFoldersReport, error) {
+//				panic("mock out the FindEmptyFolders method")
+//			},
+//			GetSharingLinkFunc: func(ctx context.Context, fileID string, anyoneRole string) (*gdrive.SharingLink, error) {
+//				panic("mock out the GetSharingLink method")
+//			},
+//			MoveFileFunc: func(ctx context.Context, fileID string, folderID string, fromFolderID string) (*gdrive.DriveFile, error) {
+//				panic("mock out the MoveFile method")
+//			},
+//			ReplyToCommentFunc: func(ctx context.Context, fileID string, commentID string, content string) (*gdrive.CommentReply, error) {
+//				panic("mock out the ReplyToComment method")
+//			},
+//			ResolveCommentFunc: func(ctx context.Context, fileID string, commentID string, content string) (*gdrive.CommentReply, error) {
+//				panic("mock out the ResolveComment method")
+//			},
+//			RestoreRevisionFunc: func(ctx context.Context, fileID string, revisionID string) (*gdrive.DriveFile, error) {
+//				panic("mock out the RestoreRevision method")
+//			},
+//			SetFileLabelFunc: func(ctx context.Context, fileID string, labelID string, fields map[string][]string, remove bool) (*gdrive.FileLabels, error) {
+//				panic("mock out the SetFileLabel method")
+//			},
+//			SetFilePropertiesFunc: func(ctx context.Context, fileID string, properties map[string]*string, appProperties bool) (*gdrive.FileProperties, error) {
+//				panic("mock out the SetFileProperties method")
+//			},
+//			ShareFileFunc: func(ctx context.Context, fileID string, share gdrive.ShareRequest) (*gdrive.Permission, error) {
+//				panic("mock out the ShareFile method")
+//			},
+//			TrashFileFunc: func(ctx context.Context, fileID string) (*gdrive.DriveFile, error) {
+//				panic("mock out the TrashFile method")
+//			},
+//			UpdateFileMetadataFunc: func(ctx context.Context, fileID string, update gdrive.FileMetadataUpdate) (*gdrive.DriveFile, error) {
+//				panic("mock out the UpdateFileMetadata method")
+//			},
+//			UploadDirectoryFunc: func(ctx context.Context, localPath string, folderID string, progress func(gdrive.UploadProgress)) (*gdrive.DirectoryUpload, error) {
+//				panic("mock out the UploadDirectory method")
+//			},
+//			UploadFileFunc: func(ctx context.Context, name string, mimeType string, folderID string, content []byte) (*gdrive.DriveFile, error) {
+//				panic("mock out the UploadFile method")
+//			},
+//			UploadFromURLFunc: func(ctx context.Context, rawURL string, name string, folderID string, convert bool) (*gdrive.DriveFile, error) {
+//				panic("mock out the UploadFromURL method")
+//			},
+//		}
+//
+//		// use mockedFileOrganizer in code that requires gdrive.FileOrganizer
+//		// and then make assertions.
+//
+//	}
+type FileOrganizerMock struct {
+	// AddCommentFunc mocks the AddComment method.
+	AddCommentFunc func(ctx context.Context, fileID string, content string) (*gdrive.Comment, error)
+
+	// BatchFileOperationFunc mocks the BatchFileOperation method.
+	BatchFileOperationFunc func(ctx context.Context, fileIDs []string, op gdrive.BatchOperation) ([]gdrive.FileResult, error)
+
+	// CopyFileFunc mocks the CopyFile method.
+	CopyFileFunc func(ctx context.Context, fileID string, name string, folderID string, convert bool) (*gdrive.DriveFile, error)
+
+	// CopyFolderFunc mocks the CopyFolder method.
+	CopyFolderFunc func(ctx context.Context, folderID string, name string, destinationFolderID string, replacements map[string]string) (*gdrive.FolderCopy, error)
+
+	// CreateFolderFunc mocks the CreateFolder method.
+	CreateFolderFunc func(ctx context.Context, name string, parentID string) (*gdrive.DriveFile, error)
+
+	// CreateShortcutFunc mocks the CreateShortcut method.
+	CreateShortcutFunc func(ctx context.Context, targetID string, name string, folderID string) (*gdrive.DriveFile, error)
+
+	// DeleteFileFunc mocks the DeleteFile method.
+	DeleteFileFunc func(ctx context.Context, fileID string) error
+
+	// EmptyTrashFunc mocks the EmptyTrash method.
+	EmptyTrashFunc func(ctx context.Context) error
+
+	// ExportFolderZipFunc mocks the ExportFolderZip method.
+	ExportFolderZipFunc func(ctx context.Context, folderID string, opts gdrive.FolderArchiveOptions) (*gdrive.FolderArchive, error)
+
+	// FindEmptyFoldersFunc mocks the FindEmptyFolders method.
+	FindEmptyFoldersFunc func(ctx context.Context, folderID string, dryRun bool) (*gdrive.EmptyFoldersReport, error)
+
+	// GetSharingLinkFunc mocks the GetSharingLink method.
+	GetSharingLinkFunc func(ctx context.Context, fileID string, anyoneRole string) (*gdrive.SharingLink, error)
+
+	// MoveFileFunc mocks the MoveFile method.
+	MoveFileFunc func(ctx context.Context, fileID string, folderID string, fromFolderID string) (*gdrive.DriveFile, error)
+
+	// ReplyToCommentFunc mocks the ReplyToComment method.
+	ReplyToCommentFunc func(ctx context.Context, fileID string, commentID string, content string) (*gdrive.CommentReply, error)
+
+	// ResolveCommentFunc mocks the ResolveComment method.
+	ResolveCommentFunc func(ctx context.Context, fileID string, commentID string, content string) (*gdrive.CommentReply, error)
+
+	// RestoreRevisionFunc mocks the RestoreRevision method.
+	RestoreRevisionFunc func(ctx context.Context, fileID string, revisionID string) (*gdrive.DriveFile, error)
+
+	// SetFileLabelFunc mocks the SetFileLabel method.
+	SetFileLabelFunc func(ctx context.Context, fileID string, labelID string, fields map[string][]string, remove bool) (*gdrive.FileLabels, error)
+
+	// SetFilePropertiesFunc mocks the SetFileProperties method.
+	SetFilePropertiesFunc func(ctx context.Context, fileID string, properties map[string]*string, appProperties bool) (*gdrive.FileProperties, error)
+
+	// ShareFileFunc mocks the ShareFile method.
+	ShareFileFunc func(ctx context.Context, fileID string, share gdrive.ShareRequest) (*gdrive.Permission, error)
+
+	// TrashFileFunc mocks the TrashFile method.
+	TrashFileFunc func(ctx context.Context, fileID string) (*gdrive.DriveFile, error)
+
+	// UpdateFileMetadataFunc mocks the UpdateFileMetadata method.
+	UpdateFileMetadataFunc func(ctx context.Context, fileID string, update gdrive.FileMetadataUpdate) (*gdrive.DriveFile, error)
+
+	// UploadDirectoryFunc mocks the UploadDirectory method.
+	UploadDirectoryFunc func(ctx context.Context, localPath string, folderID string, progress func(gdrive.UploadProgress)) (*gdrive.DirectoryUpload, error)
+
+	// UploadFileFunc mocks the UploadFile method.
+	UploadFileFunc func(ctx context.Context, name string, mimeType string, folderID string, content []byte) (*gdrive.DriveFile, error)
+
+	// UploadFromURLFunc mocks the UploadFromURL method.
+	UploadFromURLFunc func(ctx context.Context, rawURL string, name string, folderID string, convert bool) (*gdrive.DriveFile, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddComment holds details about calls to the AddComment method.
+		AddComment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// Content is the content argument value.
+			Content string
+		}
+		// BatchFileOperation holds details about calls to the BatchFileOperation method.
+		BatchFileOperation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileIDs is the fileIDs argument value.
+			FileIDs []string
+			// Op is the op argument value.
+			Op gdrive.BatchOperation
+		}
+		// CopyFile holds details about calls to the CopyFile method.
+		CopyFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// Name is the name argument value.
+			Name string
+			// FolderID is the folderID argument value.
+			FolderID string
+			// Convert is the convert argument value.
+			Convert bool
+		}
+		// CopyFolder holds details about calls to the CopyFolder method.
+		CopyFolder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FolderID is the folderID argument value.
+			FolderID string
+			// Name is the name argument value.
+			Name string
+			// DestinationFolderID is the destinationFolderID argument value.
+			DestinationFolderID string
+			// Replacements is the replacements argument value.
+			Replacements map[string]string
+		}
+		// CreateFolder holds details about calls to the CreateFolder method.
+		CreateFolder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// ParentID is the parentID argument value.
+			ParentID string
+		}
+		// CreateShortcut holds details about calls to the CreateShortcut method.
+		CreateShortcut []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TargetID is the targetID argument value.
+			TargetID string
+			// Name is the name argument value.
+			Name string
+			// FolderID is the folderID argument value.
+			FolderID string
+		}
+		// DeleteFile holds details about calls to the DeleteFile method.
+		DeleteFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+		}
+		// EmptyTrash holds details about calls to the EmptyTrash method.
+		EmptyTrash []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ExportFolderZip holds details about calls to the ExportFolderZip method.
+		ExportFolderZip []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FolderID is the folderID argument value.
+			FolderID string
+			// Opts is the opts argument value.
+			Opts gdrive.FolderArchiveOptions
+		}
+		// FindEmptyFolders holds details about calls to the FindEmptyFolders method.
+		FindEmptyFolders []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FolderID is the folderID argument value.
+			FolderID string
+			// DryRun is the dryRun argument value.
+			DryRun bool
+		}
+		// GetSharingLink holds details about calls to the GetSharingLink method.
+		GetSharingLink []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// AnyoneRole is the anyoneRole argument value.
+			AnyoneRole string
+		}
+		// MoveFile holds details about calls to the MoveFile method.
+		MoveFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// FolderID is the folderID argument value.
+			FolderID string
+			// FromFolderID is the fromFolderID argument value.
+			FromFolderID string
+		}
+		// ReplyToComment holds details about calls to the ReplyToComment method.
+		ReplyToComment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// CommentID is the commentID argument value.
+			CommentID string
+			// Content is the content argument value.
+			Content string
+		}
+		// ResolveComment holds details about calls to the ResolveComment method.
+		ResolveComment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// CommentID is the commentID argument value.
+			CommentID string
+			// Content is the content argument value.
+			Content string
+		}
+		// RestoreRevision holds details about calls to the RestoreRevision method.
+		RestoreRevision []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// RevisionID is the revisionID argument value.
+			RevisionID string
+		}
+		// SetFileLabel holds details about calls to the SetFileLabel method.
+		SetFileLabel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// LabelID is the labelID argument value.
+			LabelID string
+			// Fields is the fields argument value.
+			Fields map[string][]string
+			// Remove is the remove argument value.
+			Remove bool
+		}
+		// SetFileProperties holds details about calls to the SetFileProperties method.
+		SetFileProperties []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// Properties is the properties argument value.
+			Properties map[string]*string
+			// AppProperties is the appProperties argument value.
+			AppProperties bool
+		}
+		// ShareFile holds details about calls to the ShareFile method.
+		ShareFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// Share is the share argument value.
+			Share gdrive.ShareRequest
+		}
+		// TrashFile holds details about calls to the TrashFile method.
+		TrashFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+		}
+		// UpdateFileMetadata holds details about calls to the UpdateFileMetadata method.
+		UpdateFileMetadata []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// Update is the update argument value.
+			Update gdrive.FileMetadataUpdate
+		}
+		// UploadDirectory holds details about calls to the UploadDirectory method.
+		UploadDirectory []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LocalPath is the localPath argument value.
+			LocalPath string
+			// FolderID is the folderID argument value.
+			FolderID string
+			// Progress is the progress argument value.
+			Progress func(gdrive.UploadProgress)
+		}
+		// UploadFile holds details about calls to the UploadFile method.
+		UploadFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// MimeType is the mimeType argument value.
+			MimeType string
+			// FolderID is the folderID argument value.
+			FolderID string
+			// Content is the content argument value.
+			Content []byte
+		}
+		// UploadFromURL holds details about calls to the UploadFromURL method.
+		UploadFromURL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RawURL is the rawURL argument value.
+			RawURL string
+			// Name is the name argument value.
+			Name string
+			// FolderID is the folderID argument value.
+			FolderID string
+			// Convert is the convert argument value.
+			Convert bool
+		}
+	}
+	lockAddComment         sync.RWMutex
+	lockBatchFileOperation sync.RWMutex
+	lockCopyFile           sync.RWMutex
+	lockCopyFolder         sync.RWMutex
+	lockCreateFolder       sync.RWMutex
+	lockCreateShortcut     sync.RWMutex
+	lockDeleteFile         sync.RWMutex
+	lockEmptyTrash         sync.RWMutex
+	lockExportFolderZip    sync.RWMutex
+	lockFindEmptyFolders   sync.RWMutex
+	lockGetSharingLink     sync.RWMutex
+	lockMoveFile           sync.RWMutex
+	lockReplyToComment     sync.RWMutex
+	lockResolveComment     sync.RWMutex
+	lockRestoreRevision    sync.RWMutex
+	lockSetFileLabel       sync.RWMutex
+	lockSetFileProperties  sync.RWMutex
+	lockShareFile          sync.RWMutex
+	lockTrashFile          sync.RWMutex
+	lockUpdateFileMetadata sync.RWMutex
+	lockUploadDirectory    sync.RWMutex
+	lockUploadFile         sync.RWMutex
+	lockUploadFromURL      sync.RWMutex
+}
+
+// AddComment calls AddCommentFunc.
+func (mock *FileOrganizerMock) AddComment(ctx context.Context, fileID string, content string) (*gdrive.Comment, error) {
+	if mock.AddCommentFunc == nil {
+		panic("FileOrganizerMock.AddCommentFunc: method is nil but FileOrganizer.AddComment was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		FileID  string
+		Content string
+	}{
+		Ctx:     ctx,
+		FileID:  fileID,
+		Content: content,
+	}
+	mock.lockAddComment.Lock()
+	mock.calls.AddComment = append(mock.calls.AddComment, callInfo)
+	mock.lockAddComment.Unlock()
+	return mock.AddCommentFunc(ctx, fileID, content)
+}
+
+// AddCommentCalls gets all the calls that were made to AddComment.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.AddCommentCalls())
+func (mock *FileOrganizerMock) AddCommentCalls() []struct {
+	Ctx     context.Context
+	FileID  string
+	Content string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		FileID  string
+		Content string
+	}
+	mock.lockAddComment.RLock()
+	calls = mock.calls.AddComment
+	mock.lockAddComment.RUnlock()
+	return calls
+}
+
+// BatchFileOperation calls BatchFileOperationFunc.
+func (mock *FileOrganizerMock) BatchFileOperation(ctx context.Context, fileIDs []string, op gdrive.BatchOperation) ([]gdrive.FileResult, error) {
+	if mock.BatchFileOperationFunc == nil {
+		panic("FileOrganizerMock.BatchFileOperationFunc: method is nil but FileOrganizer.BatchFileOperation was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		FileIDs []string
+		Op      gdrive.BatchOperation
+	}{
+		Ctx:     ctx,
+		FileIDs: fileIDs,
+		Op:      op,
+	}
+	mock.lockBatchFileOperation.Lock()
+	mock.calls.BatchFileOperation = append(mock.calls.BatchFileOperation, callInfo)
+	mock.lockBatchFileOperation.Unlock()
+	return mock.BatchFileOperationFunc(ctx, fileIDs, op)
+}
+
+// BatchFileOperationCalls gets all the calls that were made to BatchFileOperation.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.BatchFileOperationCalls())
+func (mock *FileOrganizerMock) BatchFileOperationCalls() []struct {
+	Ctx     context.Context
+	FileIDs []string
+	Op      gdrive.BatchOperation
+} {
+	var calls []struct {
+		Ctx     context.Context
+		FileIDs []string
+		Op      gdrive.BatchOperation
+	}
+	mock.lockBatchFileOperation.RLock()
+	calls = mock.calls.BatchFileOperation
+	mock.lockBatchFileOperation.RUnlock()
+	return calls
+}
+
+// CopyFile calls CopyFileFunc.
+func (mock *FileOrganizerMock) CopyFile(ctx context.Context, fileID string, name string, folderID string, convert bool) (*gdrive.DriveFile, error) {
+	if mock.CopyFileFunc == nil {
+		panic("FileOrganizerMock.CopyFileFunc: method is nil but FileOrganizer.CopyFile was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		FileID   string
+		Name     string
+		FolderID string
+		Convert  bool
+	}{
+		Ctx:      ctx,
+		FileID:   fileID,
+		Name:     name,
+		FolderID: folderID,
+		Convert:  convert,
+	}
+	mock.lockCopyFile.Lock()
+	mock.calls.CopyFile = append(mock.calls.CopyFile, callInfo)
+	mock.lockCopyFile.Unlock()
+	return mock.CopyFileFunc(ctx, fileID, name, folderID, convert)
+}
+
+// CopyFileCalls gets all the calls that were made to CopyFile.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.CopyFileCalls())
+func (mock *FileOrganizerMock) CopyFileCalls() []struct {
+	Ctx      context.Context
+	FileID   string
+	Name     string
+	FolderID string
+	Convert  bool
+} {
+	var calls []struct {
+		Ctx      context.Context
+		FileID   string
+		Name     string
+		FolderID string
+		Convert  bool
+	}
+	mock.lockCopyFile.RLock()
+	calls = mock.calls.CopyFile
+	mock.lockCopyFile.RUnlock()
+	return calls
+}
+
+// CopyFolder calls CopyFolderFunc.
+func (mock *FileOrganizerMock) CopyFolder(ctx context.Context, folderID string, name string, destinationFolderID string, replacements map[string]string) (*gdrive.FolderCopy, error) {
+	if mock.CopyFolderFunc == nil {
+		panic("FileOrganizerMock.CopyFolderFunc: method is nil but FileOrganizer.CopyFolder was just called")
+	}
+	callInfo := struct {
+		Ctx                 context.Context
+		FolderID            string
+		Name                string
+		DestinationFolderID string
+		Replacements        map[string]string
+	}{
+		Ctx:                 ctx,
+		FolderID:            folderID,
+		Name:                name,
+		DestinationFolderID: destinationFolderID,
+		Replacements:        replacements,
+	}
+	mock.lockCopyFolder.Lock()
+	mock.calls.CopyFolder = append(mock.calls.CopyFolder, callInfo)
+	mock.lockCopyFolder.Unlock()
+	return mock.CopyFolderFunc(ctx, folderID, name, destinationFolderID, replacements)
+}
+
+// CopyFolderCalls gets all the calls that were made to CopyFolder.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.CopyFolderCalls())
+func (mock *FileOrganizerMock) CopyFolderCalls() []struct {
+	Ctx                 context.Context
+	FolderID            string
+	Name                string
+	DestinationFolderID string
+	Replacements        map[string]string
+} {
+	var calls []struct {
+		Ctx                 context.Context
+		FolderID            string
+		Name                string
+		DestinationFolderID string
+		Replacements        map[string]string
+	}
+	mock.lockCopyFolder.RLock()
+	calls = mock.calls.CopyFolder
+	mock.lockCopyFolder.RUnlock()
+	return calls
+}
+
+// CreateFolder calls CreateFolderFunc.
+func (mock *FileOrganizerMock) CreateFolder(ctx context.Context, name string, parentID string) (*gdrive.DriveFile, error) {
+	if mock.CreateFolderFunc == nil {
+		panic("FileOrganizerMock.CreateFolderFunc: method is nil but FileOrganizer.CreateFolder was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Name     string
+		ParentID string
+	}{
+		Ctx:      ctx,
+		Name:     name,
+		ParentID: parentID,
+	}
+	mock.lockCreateFolder.Lock()
+	mock.calls.CreateFolder = append(mock.calls.CreateFolder, callInfo)
+	mock.lockCreateFolder.Unlock()
+	return mock.CreateFolderFunc(ctx, name, parentID)
+}
+
+// CreateFolderCalls gets all the calls that were made to CreateFolder.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.CreateFolderCalls())
+func (mock *FileOrganizerMock) CreateFolderCalls() []struct {
+	Ctx      context.Context
+	Name     string
+	ParentID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Name     string
+		ParentID string
+	}
+	mock.lockCreateFolder.RLock()
+	calls = mock.calls.CreateFolder
+	mock.lockCreateFolder.RUnlock()
+	return calls
+}
+
+// CreateShortcut calls CreateShortcutFunc.
+func (mock *FileOrganizerMock) CreateShortcut(ctx context.Context, targetID string, name string, folderID string) (*gdrive.DriveFile, error) {
+	if mock.CreateShortcutFunc == nil {
+		panic("FileOrganizerMock.CreateShortcutFunc: method is nil but FileOrganizer.CreateShortcut was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		TargetID string
+		Name     string
+		FolderID string
+	}{
+		Ctx:      ctx,
+		TargetID: targetID,
+		Name:     name,
+		FolderID: folderID,
+	}
+	mock.lockCreateShortcut.Lock()
+	mock.calls.CreateShortcut = append(mock.calls.CreateShortcut, callInfo)
+	mock.lockCreateShortcut.Unlock()
+	return mock.CreateShortcutFunc(ctx, targetID, name, folderID)
+}
+
+// CreateShortcutCalls gets all the calls that were made to CreateShortcut.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.CreateShortcutCalls())
+func (mock *FileOrganizerMock) CreateShortcutCalls() []struct {
+	Ctx      context.Context
+	TargetID string
+	Name     string
+	FolderID string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		TargetID string
+		Name     string
+		FolderID string
+	}
+	mock.lockCreateShortcut.RLock()
+	calls = mock.calls.CreateShortcut
+	mock.lockCreateShortcut.RUnlock()
+	return calls
+}
+
+// DeleteFile calls DeleteFileFunc.
+func (mock *FileOrganizerMock) DeleteFile(ctx context.Context, fileID string) error {
+	if mock.DeleteFileFunc == nil {
+		panic("FileOrganizerMock.DeleteFileFunc: method is nil but FileOrganizer.DeleteFile was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID string
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+	}
+	mock.lockDeleteFile.Lock()
+	mock.calls.DeleteFile = append(mock.calls.DeleteFile, callInfo)
+	mock.lockDeleteFile.Unlock()
+	return mock.DeleteFileFunc(ctx, fileID)
+}
+
+// DeleteFileCalls gets all the calls that were made to DeleteFile.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.DeleteFileCalls())
+func (mock *FileOrganizerMock) DeleteFileCalls() []struct {
+	Ctx    context.Context
+	FileID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID string
+	}
+	mock.lockDeleteFile.RLock()
+	calls = mock.calls.DeleteFile
+	mock.lockDeleteFile.RUnlock()
+	return calls
+}
+
+// EmptyTrash calls EmptyTrashFunc.
+func (mock *FileOrganizerMock) EmptyTrash(ctx context.Context) error {
+	if mock.EmptyTrashFunc == nil {
+		panic("FileOrganizerMock.EmptyTrashFunc: method is nil but FileOrganizer.EmptyTrash was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockEmptyTrash.Lock()
+	mock.calls.EmptyTrash = append(mock.calls.EmptyTrash, callInfo)
+	mock.lockEmptyTrash.Unlock()
+	return mock.EmptyTrashFunc(ctx)
+}
+
+// EmptyTrashCalls gets all the calls that were made to EmptyTrash.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.EmptyTrashCalls())
+func (mock *FileOrganizerMock) EmptyTrashCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockEmptyTrash.RLock()
+	calls = mock.calls.EmptyTrash
+	mock.lockEmptyTrash.RUnlock()
+	return calls
+}
+
+// ExportFolderZip calls ExportFolderZipFunc.
+func (mock *FileOrganizerMock) ExportFolderZip(ctx context.Context, folderID string, opts gdrive.FolderArchiveOptions) (*gdrive.FolderArchive, error) {
+	if mock.ExportFolderZipFunc == nil {
+		panic("FileOrganizerMock.ExportFolderZipFunc: method is nil but FileOrganizer.ExportFolderZip was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		FolderID string
+		Opts     gdrive.FolderArchiveOptions
+	}{
+		Ctx:      ctx,
+		FolderID: folderID,
+		Opts:     opts,
+	}
+	mock.lockExportFolderZip.Lock()
+	mock.calls.ExportFolderZip = append(mock.calls.ExportFolderZip, callInfo)
+	mock.lockExportFolderZip.Unlock()
+	return mock.ExportFolderZipFunc(ctx, folderID, opts)
+}
+
+// ExportFolderZipCalls gets all the calls that were made to ExportFolderZip.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.ExportFolderZipCalls())
+func (mock *FileOrganizerMock) ExportFolderZipCalls() []struct {
+	Ctx      context.Context
+	FolderID string
+	Opts     gdrive.FolderArchiveOptions
+} {
+	var calls []struct {
+		Ctx      context.Context
+		FolderID string
+		Opts     gdrive.FolderArchiveOptions
+	}
+	mock.lockExportFolderZip.RLock()
+	calls = mock.calls.ExportFolderZip
+	mock.lockExportFolderZip.RUnlock()
+	return calls
+}
+
+// FindEmptyFolders calls FindEmptyFoldersFunc.
+func (mock *FileOrganizerMock) FindEmptyFolders(ctx context.Context, folderID string, dryRun bool) (*gdrive.EmptyFoldersReport, error) {
+	if mock.FindEmptyFoldersFunc == nil {
+		panic("FileOrganizerMock.FindEmptyFoldersFunc: method is nil but FileOrganizer.FindEmptyFolders was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		FolderID string
+		DryRun   bool
+	}{
+		Ctx:      ctx,
+		FolderID: folderID,
+		DryRun:   dryRun,
+	}
+	mock.lockFindEmptyFolders.Lock()
+	mock.calls.FindEmptyFolders = append(mock.calls.FindEmptyFolders, callInfo)
+	mock.lockFindEmptyFolders.Unlock()
+	return mock.FindEmptyFoldersFunc(ctx, folderID, dryRun)
+}
+
+// FindEmptyFoldersCalls gets all the calls that were made to FindEmptyFolders.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.FindEmptyFoldersCalls())
+func (mock *FileOrganizerMock) FindEmptyFoldersCalls() []struct {
+	Ctx      context.Context
+	FolderID string
+	DryRun   bool
+} {
+	var calls []struct {
+		Ctx      context.Context
+		FolderID string
+		DryRun   bool
+	}
+	mock.lockFindEmptyFolders.RLock()
+	calls = mock.calls.FindEmptyFolders
+	mock.lockFindEmptyFolders.RUnlock()
+	return calls
+}
+
+// GetSharingLink calls GetSharingLinkFunc.
+func (mock *FileOrganizerMock) GetSharingLink(ctx context.Context, fileID string, anyoneRole string) (*gdrive.SharingLink, error) {
+	if mock.GetSharingLinkFunc == nil {
+		panic("FileOrganizerMock.GetSharingLinkFunc: method is nil but FileOrganizer.GetSharingLink was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		FileID     string
+		AnyoneRole string
+	}{
+		Ctx:        ctx,
+		FileID:     fileID,
+		AnyoneRole: anyoneRole,
+	}
+	mock.lockGetSharingLink.Lock()
+	mock.calls.GetSharingLink = append(mock.calls.GetSharingLink, callInfo)
+	mock.lockGetSharingLink.Unlock()
+	return mock.GetSharingLinkFunc(ctx, fileID, anyoneRole)
+}
+
+// GetSharingLinkCalls gets all the calls that were made to GetSharingLink.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.GetSharingLinkCalls())
+func (mock *FileOrganizerMock) GetSharingLinkCalls() []struct {
+	Ctx        context.Context
+	FileID     string
+	AnyoneRole string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		FileID     string
+		AnyoneRole string
+	}
+	mock.lockGetSharingLink.RLock()
+	calls = mock.calls.GetSharingLink
+	mock.lockGetSharingLink.RUnlock()
+	return calls
+}
+
+// MoveFile calls MoveFileFunc.
+func (mock *FileOrganizerMock) MoveFile(ctx context.Context, fileID string, folderID string, fromFolderID string) (*gdrive.DriveFile, error) {
+	if mock.MoveFileFunc == nil {
+		panic("FileOrganizerMock.MoveFileFunc: method is nil but FileOrganizer.MoveFile was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		FileID       string
+		FolderID     string
+		FromFolderID string
+	}{
+		Ctx:          ctx,
+		FileID:       fileID,
+		FolderID:     folderID,
+		FromFolderID: fromFolderID,
+	}
+	mock.lockMoveFile.Lock()
+	mock.calls.MoveFile = append(mock.calls.MoveFile, callInfo)
+	mock.lockMoveFile.Unlock()
+	return mock.MoveFileFunc(ctx, fileID, folderID, fromFolderID)
+}
+
+// MoveFileCalls gets all the calls that were made to MoveFile.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.MoveFileCalls())
+func (mock *FileOrganizerMock) MoveFileCalls() []struct {
+	Ctx          context.Context
+	FileID       string
+	FolderID     string
+	FromFolderID string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		FileID       string
+		FolderID     string
+		FromFolderID string
+	}
+	mock.lockMoveFile.RLock()
+	calls = mock.calls.MoveFile
+	mock.lockMoveFile.RUnlock()
+	return calls
+}
+
+// ReplyToComment calls ReplyToCommentFunc.
+func (mock *FileOrganizerMock) ReplyToComment(ctx context.Context, fileID string, commentID string, content string) (*gdrive.CommentReply, error) {
+	if mock.ReplyToCommentFunc == nil {
+		panic("FileOrganizerMock.ReplyToCommentFunc: method is nil but FileOrganizer.ReplyToComment was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		FileID    string
+		CommentID string
+		Content   string
+	}{
+		Ctx:       ctx,
+		FileID:    fileID,
+		CommentID: commentID,
+		Content:   content,
+	}
+	mock.lockReplyToComment.Lock()
+	mock.calls.ReplyToComment = append(mock.calls.ReplyToComment, callInfo)
+	mock.lockReplyToComment.Unlock()
+	return mock.ReplyToCommentFunc(ctx, fileID, commentID, content)
+}
+
+// ReplyToCommentCalls gets all the calls that were made to ReplyToComment.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.ReplyToCommentCalls())
+func (mock *FileOrganizerMock) ReplyToCommentCalls() []struct {
+	Ctx       context.Context
+	FileID    string
+	CommentID string
+	Content   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		FileID    string
+		CommentID string
+		Content   string
+	}
+	mock.lockReplyToComment.RLock()
+	calls = mock.calls.ReplyToComment
+	mock.lockReplyToComment.RUnlock()
+	return calls
+}
+
+// ResolveComment calls ResolveCommentFunc.
+func (mock *FileOrganizerMock) ResolveComment(ctx context.Context, fileID string, commentID string, content string) (*gdrive.CommentReply, error) {
+	if mock.ResolveCommentFunc == nil {
+		panic("FileOrganizerMock.ResolveCommentFunc: method is nil but FileOrganizer.ResolveComment was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		FileID    string
+		CommentID string
+		Content   string
+	}{
+		Ctx:       ctx,
+		FileID:    fileID,
+		CommentID: commentID,
+		Content:   content,
+	}
+	mock.lockResolveComment.Lock()
+	mock.calls.ResolveComment = append(mock.calls.ResolveComment, callInfo)
+	mock.lockResolveComment.Unlock()
+	return mock.ResolveCommentFunc(ctx, fileID, commentID, content)
+}
+
+// ResolveCommentCalls gets all the calls that were made to ResolveComment.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.ResolveCommentCalls())
+func (mock *FileOrganizerMock) ResolveCommentCalls() []struct {
+	Ctx       context.Context
+	FileID    string
+	CommentID string
+	Content   string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		FileID    string
+		CommentID string
+		Content   string
+	}
+	mock.lockResolveComment.RLock()
+	calls = mock.calls.ResolveComment
+	mock.lockResolveComment.RUnlock()
+	return calls
+}
+
+// RestoreRevision calls RestoreRevisionFunc.
+func (mock *FileOrganizerMock) RestoreRevision(ctx context.Context, fileID string, revisionID string) (*gdrive.DriveFile, error) {
+	if mock.RestoreRevisionFunc == nil {
+		panic("FileOrganizerMock.RestoreRevisionFunc: method is nil but FileOrganizer.RestoreRevision was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		FileID     string
+		RevisionID string
+	}{
+		Ctx:        ctx,
+		FileID:     fileID,
+		RevisionID: revisionID,
+	}
+	mock.lockRestoreRevision.Lock()
+	mock.calls.RestoreRevision = append(mock.calls.RestoreRevision, callInfo)
+	mock.lockRestoreRevision.Unlock()
+	return mock.RestoreRevisionFunc(ctx, fileID, revisionID)
+}
+
+// RestoreRevisionCalls gets all the calls that were made to RestoreRevision.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.RestoreRevisionCalls())
+func (mock *FileOrganizerMock) RestoreRevisionCalls() []struct {
+	Ctx        context.Context
+	FileID     string
+	RevisionID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		FileID     string
+		RevisionID string
+	}
+	mock.lockRestoreRevision.RLock()
+	calls = mock.calls.RestoreRevision
+	mock.lockRestoreRevision.RUnlock()
+	return calls
+}
+
+// SetFileLabel calls SetFileLabelFunc.
+func (mock *FileOrganizerMock) SetFileLabel(ctx context.Context, fileID string, labelID string, fields map[string][]string, remove bool) (*gdrive.FileLabels, error) {
+	if mock.SetFileLabelFunc == nil {
+		panic("FileOrganizerMock.SetFileLabelFunc: method is nil but FileOrganizer.SetFileLabel was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		FileID  string
+		LabelID string
+		Fields  map[string][]string
+		Remove  bool
+	}{
+		Ctx:     ctx,
+		FileID:  fileID,
+		LabelID: labelID,
+		Fields:  fields,
+		Remove:  remove,
+	}
+	mock.lockSetFileLabel.Lock()
+	mock.calls.SetFileLabel = append(mock.calls.SetFileLabel, callInfo)
+	mock.lockSetFileLabel.Unlock()
+	return mock.SetFileLabelFunc(ctx, fileID, labelID, fields, remove)
+}
+
+// SetFileLabelCalls gets all the calls that were made to SetFileLabel.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.SetFileLabelCalls())
+func (mock *FileOrganizerMock) SetFileLabelCalls() []struct {
+	Ctx     context.Context
+	FileID  string
+	LabelID string
+	Fields  map[string][]string
+	Remove  bool
+} {
+	var calls []struct {
+		Ctx     context.Context
+		FileID  string
+		LabelID string
+		Fields  map[string][]string
+		Remove  bool
+	}
+	mock.lockSetFileLabel.RLock()
+	calls = mock.calls.SetFileLabel
+	mock.lockSetFileLabel.RUnlock()
+	return calls
+}
+
+// SetFileProperties calls SetFilePropertiesFunc.
+func (mock *FileOrganizerMock) SetFileProperties(ctx context.Context, fileID string, properties map[string]*string, appProperties bool) (*gdrive.FileProperties, error) {
+	if mock.SetFilePropertiesFunc == nil {
+		panic("FileOrganizerMock.SetFilePropertiesFunc: method is nil but FileOrganizer.SetFileProperties was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		FileID        string
+		Properties    map[string]*string
+		AppProperties bool
+	}{
+		Ctx:           ctx,
+		FileID:        fileID,
+		Properties:    properties,
+		AppProperties: appProperties,
+	}
+	mock.lockSetFileProperties.Lock()
+	mock.calls.SetFileProperties = append(mock.calls.SetFileProperties, callInfo)
+	mock.lockSetFileProperties.Unlock()
+	return mock.SetFilePropertiesFunc(ctx, fileID, properties, appProperties)
+}
+
+// SetFilePropertiesCalls gets all the calls that were made to SetFileProperties.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.SetFilePropertiesCalls())
+func (mock *FileOrganizerMock) SetFilePropertiesCalls() []struct {
+	Ctx           context.Context
+	FileID        string
+	Properties    map[string]*string
+	AppProperties bool
+} {
+	var calls []struct {
+		Ctx           context.Context
+		FileID        string
+		Properties    map[string]*string
+		AppProperties bool
+	}
+	mock.lockSetFileProperties.RLock()
+	calls = mock.calls.SetFileProperties
+	mock.lockSetFileProperties.RUnlock()
+	return calls
+}
+
+// ShareFile calls ShareFileFunc.
+func (mock *FileOrganizerMock) ShareFile(ctx context.Context, fileID string, share gdrive.ShareRequest) (*gdrive.Permission, error) {
+	if mock.ShareFileFunc == nil {
+		panic("FileOrganizerMock.ShareFileFunc: method is nil but FileOrganizer.ShareFile was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID string
+		Share  gdrive.ShareRequest
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+		Share:  share,
+	}
+	mock.lockShareFile.Lock()
+	mock.calls.ShareFile = append(mock.calls.ShareFile, callInfo)
+	mock.lockShareFile.Unlock()
+	return mock.ShareFileFunc(ctx, fileID, share)
+}
+
+// ShareFileCalls gets all the calls that were made to ShareFile.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.ShareFileCalls())
+func (mock *FileOrganizerMock) ShareFileCalls() []struct {
+	Ctx    context.Context
+	FileID string
+	Share  gdrive.ShareRequest
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID string
+		Share  gdrive.ShareRequest
+	}
+	mock.lockShareFile.RLock()
+	calls = mock.calls.ShareFile
+	mock.lockShareFile.RUnlock()
+	return calls
+}
+
+// TrashFile calls TrashFileFunc.
+func (mock *FileOrganizerMock) TrashFile(ctx context.Context, fileID string) (*gdrive.DriveFile, error) {
+	if mock.TrashFileFunc == nil {
+		panic("FileOrganizerMock.TrashFileFunc: method is nil but FileOrganizer.TrashFile was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID string
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+	}
+	mock.lockTrashFile.Lock()
+	mock.calls.TrashFile = append(mock.calls.TrashFile, callInfo)
+	mock.lockTrashFile.Unlock()
+	return mock.TrashFileFunc(ctx, fileID)
+}
+
+// TrashFileCalls gets all the calls that were made to TrashFile.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.TrashFileCalls())
+func (mock *FileOrganizerMock) TrashFileCalls() []struct {
+	Ctx    context.Context
+	FileID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID string
+	}
+	mock.lockTrashFile.RLock()
+	calls = mock.calls.TrashFile
+	mock.lockTrashFile.RUnlock()
+	return calls
+}
+
+// UpdateFileMetadata calls UpdateFileMetadataFunc.
+func (mock *FileOrganizerMock) UpdateFileMetadata(ctx context.Context, fileID string, update gdrive.FileMetadataUpdate) (*gdrive.DriveFile, error) {
+	if mock.UpdateFileMetadataFunc == nil {
+		panic("FileOrganizerMock.UpdateFileMetadataFunc: method is nil but FileOrganizer.UpdateFileMetadata was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		FileID string
+		Update gdrive.FileMetadataUpdate
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+		Update: update,
+	}
+	mock.lockUpdateFileMetadata.Lock()
+	mock.calls.UpdateFileMetadata = append(mock.calls.UpdateFileMetadata, callInfo)
+	mock.lockUpdateFileMetadata.Unlock()
+	return mock.UpdateFileMetadataFunc(ctx, fileID, update)
+}
+
+// UpdateFileMetadataCalls gets all the calls that were made to UpdateFileMetadata.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.UpdateFileMetadataCalls())
+func (mock *FileOrganizerMock) UpdateFileMetadataCalls() []struct {
+	Ctx    context.Context
+	FileID string
+	Update gdrive.FileMetadataUpdate
+} {
+	var calls []struct {
+		Ctx    context.Context
+		FileID string
+		Update gdrive.FileMetadataUpdate
+	}
+	mock.lockUpdateFileMetadata.RLock()
+	calls = mock.calls.UpdateFileMetadata
+	mock.lockUpdateFileMetadata.RUnlock()
+	return calls
+}
+
+// UploadDirectory calls UploadDirectoryFunc.
+func (mock *FileOrganizerMock) UploadDirectory(ctx context.Context, localPath string, folderID string, progress func(gdrive.UploadProgress)) (*gdrive.DirectoryUpload, error) {
+	if mock.UploadDirectoryFunc == nil {
+		panic("FileOrganizerMock.UploadDirectoryFunc: method is nil but FileOrganizer.UploadDirectory was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		LocalPath string
+		FolderID  string
+		Progress  func(gdrive.UploadProgress)
+	}{
+		Ctx:       ctx,
+		LocalPath: localPath,
+		FolderID:  folderID,
+		Progress:  progress,
+	}
+	mock.lockUploadDirectory.Lock()
+	mock.calls.UploadDirectory = append(mock.calls.UploadDirectory, callInfo)
+	mock.lockUploadDirectory.Unlock()
+	return mock.UploadDirectoryFunc(ctx, localPath, folderID, progress)
+}
+
+// UploadDirectoryCalls gets all the calls that were made to UploadDirectory.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.UploadDirectoryCalls())
+func (mock *FileOrganizerMock) UploadDirectoryCalls() []struct {
+	Ctx       context.Context
+	LocalPath string
+	FolderID  string
+	Progress  func(gdrive.UploadProgress)
+} {
+	var calls []struct {
+		Ctx       context.Context
+		LocalPath string
+		FolderID  string
+		Progress  func(gdrive.UploadProgress)
+	}
+	mock.lockUploadDirectory.RLock()
+	calls = mock.calls.UploadDirectory
+	mock.lockUploadDirectory.RUnlock()
+	return calls
+}
+
+// UploadFile calls UploadFileFunc.
+func (mock *FileOrganizerMock) UploadFile(ctx context.Context, name string, mimeType string, folderID string, content []byte) (*gdrive.DriveFile, error) {
+	if mock.UploadFileFunc == nil {
+		panic("FileOrganizerMock.UploadFileFunc: method is nil but FileOrganizer.UploadFile was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Name     string
+		MimeType string
+		FolderID string
+		Content  []byte
+	}{
+		Ctx:      ctx,
+		Name:     name,
+		MimeType: mimeType,
+		FolderID: folderID,
+		Content:  content,
+	}
+	mock.lockUploadFile.Lock()
+	mock.calls.UploadFile = append(mock.calls.UploadFile, callInfo)
+	mock.lockUploadFile.Unlock()
+	return mock.UploadFileFunc(ctx, name, mimeType, folderID, content)
+}
+
+// UploadFileCalls gets all the calls that were made to UploadFile.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.UploadFileCalls())
+func (mock *FileOrganizerMock) UploadFileCalls() []struct {
+	Ctx      context.Context
+	Name     string
+	MimeType string
+	FolderID string
+	Content  []byte
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Name     string
+		MimeType string
+		FolderID string
+		Content  []byte
+	}
+	mock.lockUploadFile.RLock()
+	calls = mock.calls.UploadFile
+	mock.lockUploadFile.RUnlock()
+	return calls
+}
+
+// UploadFromURL calls UploadFromURLFunc.
+func (mock *FileOrganizerMock) UploadFromURL(ctx context.Context, rawURL string, name string, folderID string, convert bool) (*gdrive.DriveFile, error) {
+	if mock.UploadFromURLFunc == nil {
+		panic("FileOrganizerMock.UploadFromURLFunc: method is nil but FileOrganizer.UploadFromURL was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		RawURL   string
+		Name     string
+		FolderID string
+		Convert  bool
+	}{
+		Ctx:      ctx,
+		RawURL:   rawURL,
+		Name:     name,
+		FolderID: folderID,
+		Convert:  convert,
+	}
+	mock.lockUploadFromURL.Lock()
+	mock.calls.UploadFromURL = append(mock.calls.UploadFromURL, callInfo)
+	mock.lockUploadFromURL.Unlock()
+	return mock.UploadFromURLFunc(ctx, rawURL, name, folderID, convert)
+}
+
+// UploadFromURLCalls gets all the calls that were made to UploadFromURL.
+// Check the length with:
+//
+//	len(mockedFileOrganizer.UploadFromURLCalls())
+func (mock *FileOrganizerMock) UploadFromURLCalls() []struct {
+	Ctx      context.Context
+	RawURL   string
+	Name     string
+	FolderID string
+	Convert  bool
+} {
+	var calls []struct {
+		Ctx      context.Context
+		RawURL   string
+		Name     string
+		FolderID string
+		Convert  bool
+	}
+	mock.lockUploadFromURL.RLock()
+	calls = mock.calls.UploadFromURL
+	mock.lockUploadFromURL.RUnlock()
+	return calls
+}
+
+// Ensure, that AccountInspectorMock does implement gdrive.AccountInspector.
+// If this is not the case, regenerate this file with moq.
+var _ gdrive.AccountInspector = &AccountInspectorMock{}
+
+// AccountInspectorMock is a mock implementation of gdrive.AccountInspector.
+//
+//	func TestSomethingThatUsesAccountInspector(t *testing.T) {
+//
+//		// make and configure a mocked gdrive.AccountInspector
+//		mockedAccountInspector := &AccountInspectorMock{
+//			DiagnoseAuthFunc: func(ctx context.Context) (*gdrive.AuthDiagnosis, error) {
+//				panic("mock out the DiagnoseAuth method")
+//			},
+//			GetAccountInfoFunc: func(ctx context.Context) (*gdrive.AccountInfo, error) {
+//				panic("mock out the GetAccountInfo method")
+//			},
+//			GetStorageQuotaFunc: func(ctx context.Context) (*gdrive.StorageQuota, error) {
+//				panic("mock out the GetStorageQuota method")
+//			},
+//			ReloadCredentialsFunc: func(ctx context.Context) (*gdrive.AccountInfo, error) {
+//				panic("mock out the ReloadCredentials method")
+//			},
+//		}
+//
+//		// use mockedAccountInspector in code that requires gdrive.AccountInspector
+//		// and then make assertions.
+//
+//	}
+type AccountInspectorMock struct {
+	// DiagnoseAuthFunc mocks the DiagnoseAuth method.
+	DiagnoseAuthFunc func(ctx context.Context) (*gdrive.AuthDiagnosis, error)
+
+	// GetAccountInfoFunc mocks the GetAccountInfo method.
+	GetAccountInfoFunc func(ctx context.Context) (*gdrive.AccountInfo, error)
+
+	// GetStorageQuotaFunc mocks the GetStorageQuota method.
+	GetStorageQuotaFunc func(ctx context.Context) (*gdrive.StorageQuota, error)
+
+	// ReloadCredentialsFunc mocks the ReloadCredentials method.
+	ReloadCredentialsFunc func(ctx context.Context) (*gdrive.AccountInfo, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DiagnoseAuth holds details about calls to the DiagnoseAuth method.
+		DiagnoseAuth []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetAccountInfo holds details about calls to the GetAccountInfo method.
+		GetAccountInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetStorageQuota holds details about calls to the GetStorageQuota method.
+		GetStorageQuota []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ReloadCredentials holds details about calls to the ReloadCredentials method.
+		ReloadCredentials []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockDiagnoseAuth      sync.RWMutex
+	lockGetAccountInfo    sync.RWMutex
+	lockGetStorageQuota   sync.RWMutex
+	lockReloadCredentials sync.RWMutex
+}
+
+// DiagnoseAuth calls DiagnoseAuthFunc.
+func (mock *AccountInspectorMock) DiagnoseAuth(ctx context.Context) (*gdrive.AuthDiagnosis, error) {
+	if mock.DiagnoseAuthFunc == nil {
+		panic("AccountInspectorMock.DiagnoseAuthFunc: method is nil but AccountInspector.DiagnoseAuth was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDiagnoseAuth.Lock()
+	mock.calls.DiagnoseAuth = append(mock.calls.DiagnoseAuth, callInfo)
+	mock.lockDiagnoseAuth.Unlock()
+	return mock.DiagnoseAuthFunc(ctx)
+}
+
+// DiagnoseAuthCalls gets all the calls that were made to DiagnoseAuth.
+// Check the length with:
+//
+//	len(mockedAccountInspector.DiagnoseAuthCalls())
+func (mock *AccountInspectorMock) DiagnoseAuthCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDiagnoseAuth.RLock()
+	calls = mock.calls.DiagnoseAuth
+	mock.lockDiagnoseAuth.RUnlock()
+	return calls
+}
+
+// GetAccountInfo calls GetAccountInfoFunc.
+func (mock *AccountInspectorMock) GetAccountInfo(ctx context.Context) (*gdrive.AccountInfo, error) {
+	if mock.GetAccountInfoFunc == nil {
+		panic("AccountInspectorMock.GetAccountInfoFunc: method is nil but AccountInspector.GetAccountInfo was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetAccountInfo.Lock()
+	mock.calls.GetAccountInfo = append(mock.calls.GetAccountInfo, callInfo)
+	mock.lockGetAccountInfo.Unlock()
+	return mock.GetAccountInfoFunc(ctx)
+}
+
+// GetAccountInfoCalls gets all the calls that were made to GetAccountInfo.
+// Check the length with:
+//
+//	len(mockedAccountInspector.GetAccountInfoCalls())
+func (mock *AccountInspectorMock) GetAccountInfoCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetAccountInfo.RLock()
+	calls = mock.calls.GetAccountInfo
+	mock.lockGetAccountInfo.RUnlock()
+	return calls
+}
+
+// GetStorageQuota calls GetStorageQuotaFunc.
+func (mock *AccountInspectorMock) GetStorageQuota(ctx context.Context) (*gdrive.StorageQuota, error) {
+	if mock.GetStorageQuotaFunc == nil {
+		panic("AccountInspectorMock.GetStorageQuotaFunc: method is nil but AccountInspector.GetStorageQuota was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetStorageQuota.Lock()
+	mock.calls.GetStorageQuota = append(mock.calls.GetStorageQuota, callInfo)
+	mock.lockGetStorageQuota.Unlock()
+	return mock.GetStorageQuotaFunc(ctx)
+}
+
+// GetStorageQuotaCalls gets all the calls that were made to GetStorageQuota.
+// Check the length with:
+//
+//	len(mockedAccountInspector.GetStorageQuotaCalls())
+func (mock *AccountInspectorMock) GetStorageQuotaCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetStorageQuota.RLock()
+	calls = mock.calls.GetStorageQuota
+	mock.lockGetStorageQuota.RUnlock()
+	return calls
+}
+
+// ReloadCredentials calls ReloadCredentialsFunc.
+func (mock *AccountInspectorMock) ReloadCredentials(ctx context.Context) (*gdrive.AccountInfo, error) {
+	if mock.ReloadCredentialsFunc == nil {
+		panic("AccountInspectorMock.ReloadCredentialsFunc: method is nil but AccountInspector.ReloadCredentials was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockReloadCredentials.Lock()
+	mock.calls.ReloadCredentials = append(mock.calls.ReloadCredentials, callInfo)
+	mock.lockReloadCredentials.Unlock()
+	return mock.ReloadCredentialsFunc(ctx)
+}
+
+// ReloadCredentialsCalls gets all the calls that were made to ReloadCredentials.
+// Check the length with:
+//
+//	len(mockedAccountInspector.ReloadCredentialsCalls())
+func (mock *AccountInspectorMock) ReloadCredentialsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockReloadCredentials.RLock()
+	calls = mock.calls.ReloadCredentials
+	mock.lockReloadCredentials.RUnlock()
+	return calls
+}
@@ -0,0 +1,80 @@
+package gdrivemock_test
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestMocksUpToDate runs the go:generate directive of pkg/gdrive into a temporary file and fails if the result differs
+// from mocks.go, so the mocks cannot fall out of sync with the interfaces. Generating needs network access to
+// fetch moq and the module's Go toolchain, so it only runs when DRIVE_MCP_CHECK_MOCKS is set.
+func TestMocksUpToDate(t *testing.T) {
+	if os.Getenv("DRIVE_MCP_CHECK_MOCKS") == "" {
+		t.Skip("set DRIVE_MCP_CHECK_MOCKS=1 to check the mocks are up to date")
+	}
+
+	source, err := os.ReadFile("../interfaces.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var args []string
+	for _, line := range strings.Split(string(source), "\n") {
+		if directive, ok := strings.CutPrefix(line, "//go:generate "); ok {
+			args = strings.Fields(directive)
+			break
+		}
+	}
+	if len(args) == 0 {
+		t.Fatal("no go:generate directive in interfaces.go")
+	}
+
+	// Write to a temporary file instead of mocks.go
+	out := filepath.Join(t.TempDir(), "mocks.go")
+	for i := range args[:len(args)-1] {
+		if args[i] == "-out" {
+			args[i+1] = out
+		}
+	}
+
+	// moq can only load packages with the Go version the module is built with
+	goVersion, err := moduleGoVersion("../../../go.mod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Dir = ".."
+	cmd.Env = append(os.Environ(), "GOTOOLCHAIN=go"+goVersion)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go generate failed: %v\n%s", err, output)
+	}
+
+	generated, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := os.ReadFile("mocks.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(generated, current) {
+		t.Error("mocks.go is out of date; run go generate ./pkg/gdrive/")
+	}
+}
+
+// moduleGoVersion returns the Go version declared in the go.mod file at path
+func moduleGoVersion(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if version, ok := strings.CutPrefix(strings.TrimSpace(line), "go "); ok {
+			return strings.TrimSpace(version), nil
+		}
+	}
+	return "", os.ErrNotExist
+}
@@ -0,0 +1,78 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// FileIntegrity holds the checksums Drive computed for a file's content
+type FileIntegrity struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Size           int64  `json:"size"`
+	MD5Checksum    string `json:"md5Checksum"`
+	SHA1Checksum   string `json:"sha1Checksum,omitempty"`
+	SHA256Checksum string `json:"sha256Checksum,omitempty"`
+	// Algorithm and Match report the comparison against an expected hash, if one was given
+	Algorithm string `json:"algorithm,omitempty"`
+	Match     *bool  `json:"match,omitempty"`
+}
+
+// VerifyFile returns the size and checksums of a file's content. If expectedHash is set, it is compared
+// against the checksum of the same algorithm, which is inferred from its length (MD5, SHA-1, or SHA-256).
+func (ds *DriveService) VerifyFile(ctx context.Context, fileID, expectedHash string) (*FileIntegrity, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Get(fileID).
+		Fields("id, name, mimeType, size, md5Checksum, sha1Checksum, sha256Checksum").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	if file.Md5Checksum == "" {
+		return nil, fmt.Errorf("file type %s has no binary content to checksum", file.MimeType)
+	}
+
+	integrity := &FileIntegrity{
+		ID:             file.Id,
+		Name:           file.Name,
+		Size:           file.Size,
+		MD5Checksum:    file.Md5Checksum,
+		SHA1Checksum:   file.Sha1Checksum,
+		SHA256Checksum: file.Sha256Checksum,
+	}
+	if expectedHash == "" {
+		return integrity, nil
+	}
+
+	// Hex digests identify their algorithm by length
+	var actual string
+	switch expectedHash = strings.ToLower(strings.TrimSpace(expectedHash)); len(expectedHash) {
+	case 32:
+		integrity.Algorithm, actual = "md5", file.Md5Checksum
+	case 40:
+		integrity.Algorithm, actual = "sha1", file.Sha1Checksum
+	case 64:
+		integrity.Algorithm, actual = "sha256", file.Sha256Checksum
+	default:
+		return nil, fmt.Errorf("expected hash must be a hex MD5, SHA-1, or SHA-256 digest, got %d characters", len(expectedHash))
+	}
+	if actual == "" {
+		return nil, fmt.Errorf("drive has no %s checksum for this file", integrity.Algorithm)
+	}
+
+	match := strings.EqualFold(actual, expectedHash)
+	integrity.Match = &match
+	return integrity, nil
+}
@@ -0,0 +1,132 @@
+package gdrive
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/slides/v1"
+)
+
+//go:generate go run github.com/matryer/moq@v0.5.3 -pkg gdrivemock -out gdrivemock/mocks.go . FileStore DocEditor SlideEditor SheetEditor FileOrganizer AccountInspector
+
+// FileStore searches, lists, inspects, and downloads files in Google Drive
+type FileStore interface {
+	SearchFiles(ctx context.Context, query string, filter SearchFilter, opts ListOptions) (*FileList, error)
+	SearchFilesWithSnippets(ctx context.Context, query string, filter SearchFilter, opts ListOptions) (*SearchResultList, error)
+	SearchFilesByProperties(ctx context.Context, properties map[string]string, appProperties bool, opts ListOptions) (*FileList, error)
+	ListFiles(ctx context.Context, folderID string, opts ListOptions) (*FileList, error)
+	ListModifiedFiles(ctx context.Context, query ModifiedFilesQuery, opts ListOptions) (*FileList, error)
+	ListLargestFiles(ctx context.Context, query LargestFilesQuery, opts ListOptions) (*FileList, error)
+	FindDuplicates(ctx context.Context, query DuplicatesQuery) (*DuplicatesReport, error)
+	ListRecentFiles(ctx context.Context, viewed bool, opts ListOptions) (*FileList, error)
+	ListTrashedFiles(ctx context.Context, opts ListOptions) (*FileList, error)
+	ListStarredFiles(ctx context.Context, opts ListOptions) (*FileList, error)
+	ListSharedDrives(ctx context.Context, opts ListOptions) (*SharedDriveList, error)
+	GetFileMetadata(ctx context.Context, fileID string) (*FileMetadata, error)
+	ListPermissions(ctx context.Context, fileID string, opts ListOptions) (*PermissionList, error)
+	ListRevisions(ctx context.Context, fileID string, opts ListOptions) (*RevisionList, error)
+	GetRevisionContent(ctx context.Context, fileID, revisionID, format string) (*RevisionContent, error)
+	ListComments(ctx context.Context, fileID string, includeResolved bool, opts ListOptions) (*CommentList, error)
+	GetFileActivity(ctx context.Context, fileID string, query ActivityQuery, opts ListOptions) (*ActivityList, error)
+	GetThumbnail(ctx context.Context, fileID string, slideIndex *int, size string) (*Thumbnail, error)
+	GetFileProperties(ctx context.Context, fileID string) (*FileProperties, error)
+	ListLabels(ctx context.Context, opts ListOptions) (*LabelList, error)
+	GetFileLabels(ctx context.Context, fileID string) (*FileLabels, error)
+	GetFilesMetadata(ctx context.Context, fileIDs []string, extraFields []string) ([]FileResult, error)
+	DownloadFileChunk(ctx context.Context, fileID, continuationToken string, chunkSize int64) (*FileChunk, error)
+	SaveFile(ctx context.Context, fileID, localPath, format string, overwrite bool) (*SavedFile, error)
+	ExportFile(ctx context.Context, fileID, format string) (*ExportedFile, error)
+	VerifyFile(ctx context.Context, fileID, expectedHash string) (*FileIntegrity, error)
+	GetFileParents(ctx context.Context, fileID string) (*FileParents, error)
+	GetFileCapabilities(ctx context.Context, fileID string) (*FileCapabilities, error)
+	ResolveShortcut(ctx context.Context, fileID string) (*ShortcutInfo, error)
+	ResolvePath(ctx context.Context, path, driveID string) (*ResolvedPath, error)
+	ExtractPDFText(ctx context.Context, fileID, ocrLanguage string, perPage bool) (*PDFText, error)
+	GetStartPageToken(ctx context.Context) (string, error)
+	ListChanges(ctx context.Context, opts ListOptions) (*ChangeList, error)
+}
+
+// DocEditor reads and updates Google Documents
+type DocEditor interface {
+	GetDocumentContent(ctx context.Context, documentID string) (string, error)
+	GetDocumentChunk(ctx context.Context, documentID string, startIndex, maxChars int) (*DocumentChunk, error)
+	GetDocumentParagraphs(ctx context.Context, documentID string) ([]DocumentParagraph, error)
+	UpdateDocumentContent(ctx context.Context, documentID, content string) error
+	AppendTableRows(ctx context.Context, documentID string, locator TableLocator, rows [][]string) (*TableAppendResult, error)
+	ReplaceDocumentImage(ctx context.Context, documentID string, locator ImageLocator, imageURL string) (string, error)
+	GetDocumentImages(ctx context.Context, documentID string, includeData bool) ([]DocumentImage, error)
+	GetDocumentStyle(ctx context.Context, documentID string) (*DocumentStyleSettings, error)
+	UpdateDocumentStyle(ctx context.Context, documentID string, update DocumentStyleUpdate) (*DocumentStyleSettings, error)
+	BatchUpdateDocument(ctx context.Context, documentID string, requests []json.RawMessage, requiredRevisionID string) (*docs.BatchUpdateDocumentResponse, error)
+	DiffDocuments(ctx context.Context, base, other DocumentVersion, contextLines int) (*DocumentDiff, error)
+}
+
+// SlideEditor reads and updates Google Slides presentations
+type SlideEditor interface {
+	GetPresentationContent(ctx context.Context, presentationID string) (string, error)
+	GetPresentationText(ctx context.Context, presentationID string) (*PresentationText, error)
+	UpdatePresentationSlide(ctx context.Context, presentationID string, slideIndex int, title, content string) error
+	GetSlideElements(ctx context.Context, presentationID string, slideIndex int) ([]SlideElement, error)
+	UpdateSlideElement(ctx context.Context, presentationID, objectID string, update SlideElementUpdate) (*SlideElement, error)
+	DiffPresentationRevisions(ctx context.Context, presentationID, baseRevisionID, otherRevisionID string) (*PresentationDiff, error)
+	ApplyPresentationTemplate(ctx context.Context, presentationID, templateID, name, folderID string) (*ThemedPresentation, error)
+	RefreshSheetsCharts(ctx context.Context, presentationID string, objectIDs []string) ([]LinkedChart, error)
+	GetPresentationImages(ctx context.Context, presentationID string, includeData bool) ([]SlideImage, error)
+	BatchUpdatePresentation(ctx context.Context, presentationID string, requests []json.RawMessage, requiredRevisionID string) (*slides.BatchUpdatePresentationResponse, error)
+}
+
+// SheetEditor reads and updates Google Spreadsheets
+type SheetEditor interface {
+	GetSpreadsheetValues(ctx context.Context, spreadsheetID, rangeName string, options SpreadsheetValuesOptions) ([][]interface{}, error)
+	UpdateSpreadsheetValues(ctx context.Context, spreadsheetID, rangeName string, values [][]interface{}) error
+	AppendSheetTableRows(ctx context.Context, spreadsheetID string, locator SheetTableLocator, rows [][]interface{}) (*SheetAppendResult, error)
+	CopySpreadsheetRange(ctx context.Context, source, destination SpreadsheetRange, mode string) (*SpreadsheetRangeCopy, error)
+	AuditSpreadsheetProtection(ctx context.Context, spreadsheetID string) (*SpreadsheetAudit, error)
+	SnapshotSpreadsheet(ctx context.Context, spreadsheetID, folderID string, asXLSX bool) (*SpreadsheetSnapshot, error)
+}
+
+// FileOrganizer creates, copies, reorganizes, and cleans up files in Google Drive
+type FileOrganizer interface {
+	CopyFile(ctx context.Context, fileID, name, folderID string, convert bool) (*DriveFile, error)
+	CreateFolder(ctx context.Context, name, parentID string) (*DriveFile, error)
+	CreateShortcut(ctx context.Context, targetID, name, folderID string) (*DriveFile, error)
+	CopyFolder(ctx context.Context, folderID, name, destinationFolderID string, replacements map[string]string) (*FolderCopy, error)
+	UploadFromURL(ctx context.Context, rawURL, name, folderID string, convert bool) (*DriveFile, error)
+	UploadFile(ctx context.Context, name, mimeType, folderID string, content []byte) (*DriveFile, error)
+	MoveFile(ctx context.Context, fileID, folderID, fromFolderID string) (*DriveFile, error)
+	UpdateFileMetadata(ctx context.Context, fileID string, update FileMetadataUpdate) (*DriveFile, error)
+	SetFileProperties(ctx context.Context, fileID string, properties map[string]*string, appProperties bool) (*FileProperties, error)
+	SetFileLabel(ctx context.Context, fileID, labelID string, fields map[string][]string, remove bool) (*FileLabels, error)
+	ShareFile(ctx context.Context, fileID string, share ShareRequest) (*Permission, error)
+	GetSharingLink(ctx context.Context, fileID, anyoneRole string) (*SharingLink, error)
+	RestoreRevision(ctx context.Context, fileID, revisionID string) (*DriveFile, error)
+	AddComment(ctx context.Context, fileID, content string) (*Comment, error)
+	ReplyToComment(ctx context.Context, fileID, commentID, content string) (*CommentReply, error)
+	ResolveComment(ctx context.Context, fileID, commentID, content string) (*CommentReply, error)
+	TrashFile(ctx context.Context, fileID string) (*DriveFile, error)
+	DeleteFile(ctx context.Context, fileID string) error
+	BatchFileOperation(ctx context.Context, fileIDs []string, op BatchOperation) ([]FileResult, error)
+	EmptyTrash(ctx context.Context) error
+	FindEmptyFolders(ctx context.Context, folderID string, dryRun bool) (*EmptyFoldersReport, error)
+	ExportFolderZip(ctx context.Context, folderID string, opts FolderArchiveOptions) (*FolderArchive, error)
+	UploadDirectory(ctx context.Context, localPath, folderID string, progress func(UploadProgress)) (*DirectoryUpload, error)
+}
+
+// AccountInspector reports which Google account the server is acting as, its storage quota, and whether it can reach
+// the APIs
+type AccountInspector interface {
+	GetAccountInfo(ctx context.Context) (*AccountInfo, error)
+	GetStorageQuota(ctx context.Context) (*StorageQuota, error)
+	DiagnoseAuth(ctx context.Context) (*AuthDiagnosis, error)
+	ReloadCredentials(ctx context.Context) (*AccountInfo, error)
+}
+
+var (
+	_ FileStore        = (*DriveService)(nil)
+	_ DocEditor        = (*DriveService)(nil)
+	_ SlideEditor      = (*DriveService)(nil)
+	_ SheetEditor      = (*DriveService)(nil)
+	_ FileOrganizer    = (*DriveService)(nil)
+	_ AccountInspector = (*DriveService)(nil)
+)
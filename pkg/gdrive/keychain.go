@@ -0,0 +1,59 @@
+package gdrive
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// KeychainService is the service name credentials are stored under in the OS credential store
+const KeychainService = "drive-mcp"
+
+// keychainAccount is the account name credentials are stored under in the OS credential store
+const keychainAccount = "credentials"
+
+// ErrKeychainNotFound is returned when no credentials have been saved to the OS credential store
+var ErrKeychainNotFound = errors.New("no credentials in the OS credential store")
+
+// ErrKeychainUnsupported is returned on platforms without a supported OS credential store
+var ErrKeychainUnsupported = errors.New("the OS credential store is not supported on this platform")
+
+// SaveKeychainCredentials stores credentials JSON, such as the application_default_credentials.json written by
+// gcloud, in the OS credential store: the macOS Keychain, the Windows Credential Manager, or libsecret on Linux.
+// Existing credentials are replaced.
+func SaveKeychainCredentials(data []byte) error {
+	var credentials struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &credentials); err != nil {
+		return fmt.Errorf("invalid credentials JSON: %w", err)
+	}
+	if credentials.Type == "" {
+		return errors.New("invalid credentials JSON: missing type")
+	}
+
+	if err := keychainWrite(KeychainService, keychainAccount, data); err != nil {
+		return fmt.Errorf("failed to save credentials: %w", err)
+	}
+	return nil
+}
+
+// LoadKeychainCredentials returns the credentials JSON saved with SaveKeychainCredentials
+func LoadKeychainCredentials() ([]byte, error) {
+	data, err := keychainRead(KeychainService, keychainAccount)
+	if err != nil {
+		if errors.Is(err, ErrKeychainNotFound) || errors.Is(err, ErrKeychainUnsupported) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to load credentials: %w", err)
+	}
+	return data, nil
+}
+
+// WithCredentialsJSON authenticates with credentials JSON, e.g. from LoadKeychainCredentials,
+// instead of gcloud application-default credentials
+func WithCredentialsJSON(data []byte) Option {
+	return func(ds *DriveService) {
+		ds.credentialsJSON = data
+	}
+}
@@ -0,0 +1,46 @@
+package gdrive
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// errSecItemNotFound is the exit status of the security command when no matching item exists
+const errSecItemNotFound = 44
+
+// keychainRead reads a generic password from the macOS Keychain. security prints a password holding newlines or
+// other unprintable characters, such as pretty-printed credentials JSON, hex-encoded, so output that is not JSON
+// but is valid hex is decoded.
+func keychainRead(service, account string) ([]byte, error) {
+	out, err := exec.Command("security", "find-generic-password", "-s", service, "-a", account, "-w").Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == errSecItemNotFound {
+			return nil, ErrKeychainNotFound
+		}
+		return nil, fmt.Errorf("security: %w", err)
+	}
+	out = bytes.TrimSpace(out)
+	if !json.Valid(out) {
+		if decoded, err := hex.DecodeString(string(out)); err == nil {
+			return decoded, nil
+		}
+	}
+	return out, nil
+}
+
+// keychainWrite stores a generic password in the macOS Keychain. The command is passed on standard input
+// rather than as arguments, so the secret is not visible in the process list.
+func keychainWrite(service, account string, data []byte) error {
+	cmd := exec.Command("security", "-i")
+	cmd.Stdin = strings.NewReader(fmt.Sprintf("add-generic-password -U -s %s -a %s -X %s\n", service, account, hex.EncodeToString(data)))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("security: %w: %s", err, bytes.TrimSpace(out))
+	}
+	return nil
+}
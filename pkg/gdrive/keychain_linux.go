@@ -0,0 +1,41 @@
+package gdrive
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+)
+
+// keychainRead looks up a secret with libsecret's secret-tool
+func keychainRead(service, account string) ([]byte, error) {
+	out, err := exec.Command("secret-tool", "lookup", "service", service, "account", account).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(bytes.TrimSpace(exitErr.Stderr)) == 0 {
+			// secret-tool exits with an error and no message when nothing matches
+			return nil, ErrKeychainNotFound
+		}
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, fmt.Errorf("%w: secret-tool is not installed", ErrKeychainUnsupported)
+		}
+		return nil, fmt.Errorf("secret-tool: %w", err)
+	}
+	if len(out) == 0 {
+		return nil, ErrKeychainNotFound
+	}
+	return bytes.TrimSpace(out), nil
+}
+
+// keychainWrite stores a secret with libsecret's secret-tool, which reads it from standard input
+func keychainWrite(service, account string, data []byte) error {
+	cmd := exec.Command("secret-tool", "store", "--label", service+" "+account, "service", service, "account", account)
+	cmd.Stdin = bytes.NewReader(data)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return fmt.Errorf("%w: secret-tool is not installed", ErrKeychainUnsupported)
+		}
+		return fmt.Errorf("secret-tool: %w: %s", err, bytes.TrimSpace(out))
+	}
+	return nil
+}
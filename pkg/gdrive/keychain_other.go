@@ -0,0 +1,11 @@
+//go:build !darwin && !linux && !windows
+
+package gdrive
+
+func keychainRead(service, account string) ([]byte, error) {
+	return nil, ErrKeychainUnsupported
+}
+
+func keychainWrite(service, account string, data []byte) error {
+	return ErrKeychainUnsupported
+}
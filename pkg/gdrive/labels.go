@@ -0,0 +1,402 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/drivelabels/v2"
+	"google.golang.org/api/googleapi"
+)
+
+// DefaultLabelPageSize is the number of labels ListLabels returns per page when ListOptions sets no page size
+const DefaultLabelPageSize = 50
+
+// labelFields are the label fields read for Label
+const labelFields = "id, labelType, properties(title, description), appliedCapabilities(canApply), " +
+	"fields(id, properties(displayName, required), textOptions, integerOptions, dateOptions, " +
+	"selectionOptions(listOptions, choices(id, properties(displayName))), userOptions(listOptions))"
+
+// Label field types, as reported in LabelField.Type
+const (
+	labelFieldText      = "text"
+	labelFieldInteger   = "integer"
+	labelFieldDate      = "date"
+	labelFieldSelection = "selection"
+	labelFieldUser      = "user"
+)
+
+// Label is a Drive label files can be classified with
+type Label struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	// LabelType is "SHARED" for labels users apply, or "ADMIN" for labels only administrators apply
+	LabelType string `json:"labelType"`
+	// CanApply reports whether the user may apply the label to files they can edit
+	CanApply bool         `json:"canApply"`
+	Fields   []LabelField `json:"fields,omitempty"`
+}
+
+// LabelField is a field of a label holding a value on each file the label is applied to
+type LabelField struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Type is "text", "integer", "date", "selection", or "user"
+	Type     string `json:"type"`
+	Required bool   `json:"required,omitempty"`
+	// MultiValued reports whether the field holds several selections or users
+	MultiValued bool `json:"multiValued,omitempty"`
+	// Choices are the values a selection field accepts
+	Choices []LabelChoice `json:"choices,omitempty"`
+}
+
+// LabelChoice is a value a selection field accepts
+type LabelChoice struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// LabelList is a page of labels
+type LabelList struct {
+	Labels []Label `json:"labels"`
+	// NextPageToken fetches the next page; empty on the last page
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// AppliedLabel is a label applied to a file, with the values of its fields on that file
+type AppliedLabel struct {
+	ID     string              `json:"id"`
+	Title  string              `json:"title"`
+	Fields []AppliedLabelField `json:"fields,omitempty"`
+}
+
+// AppliedLabelField is the value of a label field on a file
+type AppliedLabelField struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// Values are the field's values: text, integers, YYYY-MM-DD dates, choice names, or user email addresses
+	Values []string `json:"values"`
+}
+
+// FileLabels are the labels applied to a file
+type FileLabels struct {
+	FileID string         `json:"fileId"`
+	Labels []AppliedLabel `json:"labels"`
+}
+
+// ListLabels lists the published labels the user can read, with their fields and the choices of selection fields.
+// opts.OrderBy and opts.Fields are not supported.
+func (ds *DriveService) ListLabels(ctx context.Context, opts ListOptions) (*LabelList, error) {
+	if opts.OrderBy != "" || len(opts.Fields) > 0 {
+		return nil, errors.New("orderBy and fields are not supported for labels")
+	}
+
+	r, err := ds.labelsService.Labels.List().
+		PublishedOnly(true).
+		View("LABEL_VIEW_FULL").
+		PageSize(int64(opts.pageSize(DefaultLabelPageSize))).
+		PageToken(opts.PageToken).
+		Fields(googleapi.Field("nextPageToken, labels(" + labelFields + ")")).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list labels: %w", err)
+	}
+
+	list := &LabelList{Labels: make([]Label, 0, len(r.Labels)), NextPageToken: r.NextPageToken}
+	for _, label := range r.Labels {
+		list.Labels = append(list.Labels, newLabel(label))
+	}
+	return list, nil
+}
+
+// GetFileLabels lists the labels applied to a file, with the values of their fields. Selection values are
+// reported by choice name and user values by email address. For a shortcut, the labels of its target are listed.
+func (ds *DriveService) GetFileLabels(ctx context.Context, fileID string) (*FileLabels, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ds.fileLabels(ctx, fileID)
+}
+
+// SetFileLabel applies a label to a file, or changes the values of its fields if it is applied already. fields
+// maps field IDs or names to their new values; an empty list clears a field. Values are given as text, integers,
+// YYYY-MM-DD dates, choice IDs or names, or user email addresses, depending on the field type. When remove is set,
+// the label is removed from the file instead and fields must be empty. The labels applied to the file afterwards
+// are returned. For a shortcut, the label is applied to its target.
+func (ds *DriveService) SetFileLabel(ctx context.Context, fileID, labelID string, fields map[string][]string, remove bool) (*FileLabels, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if labelID == "" {
+		return nil, errors.New("label ID is empty")
+	}
+	if remove && len(fields) > 0 {
+		return nil, errors.New("fields cannot be set while removing a label")
+	}
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, fileID); err != nil {
+		return nil, err
+	}
+
+	modification := &drive.LabelModification{LabelId: labelID, RemoveLabel: remove}
+	if len(fields) > 0 {
+		definition, err := ds.labelDefinition(ctx, labelID)
+		if err != nil {
+			return nil, err
+		}
+		if modification.FieldModifications, err = labelFieldModifications(definition, fields); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = ds.driveService.Files.ModifyLabels(fileID, &drive.ModifyLabelsRequest{
+		LabelModifications: []*drive.LabelModification{modification},
+	}).
+		Fields("modifiedLabels(id)").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to modify labels: %w", err)
+	}
+
+	return ds.fileLabels(ctx, fileID)
+}
+
+// fileLabels lists the labels applied to a file, naming them and their values after the label definitions
+func (ds *DriveService) fileLabels(ctx context.Context, fileID string) (*FileLabels, error) {
+	var applied []*drive.Label
+	err := ds.driveService.Files.ListLabels(fileID).
+		Fields("nextPageToken, labels(id, fields)").
+		Pages(ctx, func(r *drive.LabelList) error {
+			applied = append(applied, r.Labels...)
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list file labels: %w", err)
+	}
+
+	// The label definitions give the names of the labels, their fields, and their choices
+	var mu sync.Mutex
+	definitions := make(map[string]Label, len(applied))
+	err = forEachConcurrent(ctx, ds.parallelism, len(applied), func(ctx context.Context, i int) error {
+		definition, err := ds.labelDefinition(ctx, applied[i].Id)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		definitions[applied[i].Id] = *definition
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FileLabels{FileID: fileID, Labels: make([]AppliedLabel, 0, len(applied))}
+	for _, label := range applied {
+		result.Labels = append(result.Labels, newAppliedLabel(label, definitions[label.Id]))
+	}
+	return result, nil
+}
+
+// labelDefinition reads the published revision of a label
+func (ds *DriveService) labelDefinition(ctx context.Context, labelID string) (*Label, error) {
+	r, err := ds.labelsService.Labels.Get("labels/" + labelID + "@published").
+		View("LABEL_VIEW_FULL").
+		Fields(googleapi.Field(labelFields)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get label %s: %w", labelID, err)
+	}
+
+	label := newLabel(r)
+	return &label, nil
+}
+
+// labelFieldModifications converts new field values, keyed by field ID or name, to modifications of the fields
+// of definition, in the order of the label's fields
+func labelFieldModifications(definition *Label, values map[string][]string) ([]*drive.LabelFieldModification, error) {
+	matched := make(map[string]bool, len(values))
+	var modifications []*drive.LabelFieldModification
+	for _, field := range definition.Fields {
+		key := field.ID
+		fieldValues, ok := values[key]
+		if !ok {
+			key = field.Name
+			if fieldValues, ok = values[key]; !ok {
+				continue
+			}
+		}
+		matched[key] = true
+
+		modification := &drive.LabelFieldModification{FieldId: field.ID}
+		if len(fieldValues) == 0 {
+			modification.UnsetValues = true
+			modifications = append(modifications, modification)
+			continue
+		}
+		if len(fieldValues) > 1 && !field.MultiValued {
+			return nil, fmt.Errorf("field %q takes a single value", field.Name)
+		}
+
+		switch field.Type {
+		case labelFieldText:
+			modification.SetTextValues = fieldValues
+		case labelFieldInteger:
+			for _, value := range fieldValues {
+				n, err := strconv.ParseInt(value, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("field %q takes integers: %q is not an integer", field.Name, value)
+				}
+				modification.SetIntegerValues = append(modification.SetIntegerValues, n)
+			}
+		case labelFieldDate:
+			for _, value := range fieldValues {
+				if _, err := time.Parse(time.DateOnly, value); err != nil {
+					return nil, fmt.Errorf("field %q takes YYYY-MM-DD dates: %q is not a date", field.Name, value)
+				}
+			}
+			modification.SetDateValues = fieldValues
+		case labelFieldSelection:
+			for _, value := range fieldValues {
+				choiceID, err := labelChoiceID(field, value)
+				if err != nil {
+					return nil, err
+				}
+				modification.SetSelectionValues = append(modification.SetSelectionValues, choiceID)
+			}
+		case labelFieldUser:
+			modification.SetUserValues = fieldValues
+		default:
+			return nil, fmt.Errorf("field %q cannot be set", field.Name)
+		}
+		modifications = append(modifications, modification)
+	}
+
+	var unknown []string
+	for key := range values {
+		if !matched[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("label %q has no fields named %s", definition.Title, strings.Join(unknown, ", "))
+	}
+	return modifications, nil
+}
+
+// labelChoiceID returns the ID of the choice of a selection field with the given ID or name
+func labelChoiceID(field LabelField, value string) (string, error) {
+	for _, choice := range field.Choices {
+		if choice.ID == value || choice.Name == value {
+			return choice.ID, nil
+		}
+	}
+	names := make([]string, 0, len(field.Choices))
+	for _, choice := range field.Choices {
+		names = append(names, choice.Name)
+	}
+	return "", fmt.Errorf("field %q has no choice %q; choices: %s", field.Name, value, strings.Join(names, ", "))
+}
+
+// newLabel converts a Drive Labels API label
+func newLabel(l *drivelabels.GoogleAppsDriveLabelsV2Label) Label {
+	label := Label{ID: l.Id, LabelType: l.LabelType}
+	if l.Properties != nil {
+		label.Title = l.Properties.Title
+		label.Description = l.Properties.Description
+	}
+	if l.AppliedCapabilities != nil {
+		label.CanApply = l.AppliedCapabilities.CanApply
+	}
+	for _, f := range l.Fields {
+		field := LabelField{ID: f.Id}
+		if f.Properties != nil {
+			field.Name = f.Properties.DisplayName
+			field.Required = f.Properties.Required
+		}
+		switch {
+		case f.TextOptions != nil:
+			field.Type = labelFieldText
+		case f.IntegerOptions != nil:
+			field.Type = labelFieldInteger
+		case f.DateOptions != nil:
+			field.Type = labelFieldDate
+		case f.SelectionOptions != nil:
+			field.Type = labelFieldSelection
+			field.MultiValued = f.SelectionOptions.ListOptions != nil
+			for _, c := range f.SelectionOptions.Choices {
+				choice := LabelChoice{ID: c.Id}
+				if c.Properties != nil {
+					choice.Name = c.Properties.DisplayName
+				}
+				field.Choices = append(field.Choices, choice)
+			}
+		case f.UserOptions != nil:
+			field.Type = labelFieldUser
+			field.MultiValued = f.UserOptions.ListOptions != nil
+		}
+		label.Fields = append(label.Fields, field)
+	}
+	return label
+}
+
+// newAppliedLabel converts a label applied to a file, naming its fields and choices after definition
+func newAppliedLabel(l *drive.Label, definition Label) AppliedLabel {
+	label := AppliedLabel{ID: l.Id, Title: definition.Title}
+
+	// Fields are listed in the label's order; fields without a value are not applied
+	for _, field := range definition.Fields {
+		value, ok := l.Fields[field.ID]
+		if !ok {
+			continue
+		}
+		applied := AppliedLabelField{ID: field.ID, Name: field.Name, Type: field.Type, Values: []string{}}
+		switch field.Type {
+		case labelFieldText:
+			applied.Values = append(applied.Values, value.Text...)
+		case labelFieldInteger:
+			for _, n := range value.Integer {
+				applied.Values = append(applied.Values, strconv.FormatInt(n, 10))
+			}
+		case labelFieldDate:
+			applied.Values = append(applied.Values, value.DateString...)
+		case labelFieldSelection:
+			for _, choiceID := range value.Selection {
+				name := choiceID
+				for _, choice := range field.Choices {
+					if choice.ID == choiceID {
+						name = choice.Name
+						break
+					}
+				}
+				applied.Values = append(applied.Values, name)
+			}
+		case labelFieldUser:
+			for _, user := range value.User {
+				applied.Values = append(applied.Values, user.EmailAddress)
+			}
+		}
+		label.Fields = append(label.Fields, applied)
+	}
+	return label
+}
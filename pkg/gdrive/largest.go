@@ -0,0 +1,151 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// LargestFilesQuery selects the files ListLargestFiles ranks by size
+type LargestFilesQuery struct {
+	// FolderID limits the results to files anywhere below this folder; empty searches all of Drive
+	FolderID string
+	// Owner limits the results to files owned by this email address, or by the signed-in user with "me"
+	Owner string
+}
+
+// DefaultLargestPageSize is the number of files ListLargestFiles returns per page when ListOptions sets no page size
+const DefaultLargestPageSize = 25
+
+// maxLargestRank bounds how far down the ranking a folder subtree can be paged, since each folder is asked
+// for every file up to the end of the page
+const maxLargestRank = 1000
+
+// largestFileFields are the fields always returned for the largest files
+var largestFileFields = []string{"size", "quotaBytesUsed", "owners(displayName, emailAddress)", "modifiedTime"}
+
+// largestPageToken continues ListLargestFiles below a folder after the files already returned
+type largestPageToken struct {
+	Offset int `json:"o"`
+}
+
+// ListLargestFiles lists non-folder files from largest to smallest by the storage they use, with their size,
+// owners, and last modification time alongside opts.Fields. Google Workspace files use little or no storage,
+// so they rank last. The order is fixed, so opts.OrderBy must be empty.
+func (ds *DriveService) ListLargestFiles(ctx context.Context, query LargestFilesQuery, opts ListOptions) (*FileList, error) {
+	if opts.OrderBy != "" {
+		return nil, errors.New("largest files are always listed largest first; orderBy is not supported")
+	}
+	pageSize := opts.pageSize(DefaultLargestPageSize)
+
+	extraFields := append(largestFileFields[:len(largestFileFields):len(largestFileFields)], opts.Fields...)
+	fields, err := fileFieldsMask(extraFields)
+	if err != nil {
+		return nil, err
+	}
+
+	clauses := []string{
+		fmt.Sprintf("mimeType != '%s'", folderMimeType),
+		"trashed = false",
+	}
+	if query.Owner != "" {
+		clauses = append(clauses, fmt.Sprintf("'%s' in owners", query.Owner))
+	}
+	q := strings.Join(clauses, " and ")
+
+	folderID := ds.folderOrRoot(query.FolderID)
+	if folderID == "" {
+		// Drive ranks all files itself and pages through them
+		r, err := ds.driveService.Files.List().
+			Q(q).
+			OrderBy("quotaBytesUsed desc").
+			PageSize(int64(pageSize)).
+			PageToken(opts.PageToken).
+			Fields(googleapi.Field("nextPageToken, " + fields)).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list files: %w", err)
+		}
+		return newFileList(r.Files, extraFields, r.NextPageToken)
+	}
+
+	// Drive can only match direct parents, so the largest files of each folder in the subtree are merged
+	var token largestPageToken
+	if opts.PageToken != "" {
+		if err := decodePageToken(opts.PageToken, &token); err != nil {
+			return nil, err
+		}
+	}
+	rank := token.Offset + pageSize
+	if rank > maxLargestRank {
+		return nil, fmt.Errorf("only the %d largest files below a folder can be listed", maxLargestRank)
+	}
+	if err := ds.checkScope(ctx, folderID); err != nil {
+		return nil, err
+	}
+	parents, err := ds.subtreeFolderIDs(ctx, folderID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu    sync.Mutex
+		files []*drive.File
+		// full is set when a folder filled its request, so it may hold more files
+		full bool
+	)
+	err = forEachConcurrent(ctx, ds.parallelism, len(parents), func(ctx context.Context, i int) error {
+		r, err := ds.driveService.Files.List().
+			Q(fmt.Sprintf("'%s' in parents and %s", parents[i], q)).
+			OrderBy("quotaBytesUsed desc").
+			PageSize(int64(rank)).
+			Fields(googleapi.Field(fields)).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return fmt.Errorf("failed to list files: %w", err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		files = append(files, r.Files...)
+		if len(r.Files) == rank {
+			full = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].QuotaBytesUsed > files[j].QuotaBytesUsed
+	})
+	var nextPageToken string
+	if len(files) > rank || (full && len(files) == rank) {
+		nextPageToken = encodePageToken(largestPageToken{Offset: rank})
+	}
+	files = files[min(token.Offset, len(files)):min(rank, len(files))]
+
+	return newFileList(files, extraFields, nextPageToken)
+}
+
+// newFileList converts Drive API files to a FileList, copying the requested extra fields
+func newFileList(files []*drive.File, extraFields []string, nextPageToken string) (*FileList, error) {
+	list := &FileList{Files: make([]DriveFile, 0, len(files)), NextPageToken: nextPageToken}
+	for _, file := range files {
+		driveFile, err := newDriveFile(file, extraFields)
+		if err != nil {
+			return nil, err
+		}
+		list.Files = append(list.Files, driveFile)
+	}
+	return list, nil
+}
@@ -0,0 +1,88 @@
+package gdrive
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ListOptions selects a page of a listing. Every listing method takes them, so all listings page the same way.
+type ListOptions struct {
+	// PageSize is the maximum number of items to return; 0 uses the listing's default
+	PageSize int
+	// PageToken continues a listing from the NextPageToken of its previous page; empty starts from the beginning
+	PageToken string
+	// OrderBy sorts the items, e.g. "modifiedTime desc"; the accepted keys depend on the listing
+	OrderBy string
+	// Fields are additional fields to return for each item besides the listing's defaults
+	Fields []string
+	// DriveID limits a file listing to one shared drive; empty lists My Drive and every shared drive the user can see
+	DriveID string
+}
+
+// pageSize returns the page size, or defaultSize if none is set
+func (o ListOptions) pageSize(defaultSize int) int {
+	if o.PageSize > 0 {
+		return o.PageSize
+	}
+	return defaultSize
+}
+
+// fileOrderKeys are the keys Drive sorts file listings by
+var fileOrderKeys = []string{
+	"createdTime", "folder", "modifiedByMeTime", "modifiedTime", "name", "name_natural",
+	"quotaBytesUsed", "recency", "sharedWithMeTime", "starred", "viewedByMeTime",
+}
+
+// checkFileOrderBy reports an orderBy Drive would reject for a file listing, naming the offending key, since
+// Drive itself only answers "Invalid Value"
+func checkFileOrderBy(orderBy string) error {
+	for _, term := range strings.Split(orderBy, ",") {
+		key, direction, _ := strings.Cut(strings.TrimSpace(term), " ")
+		if !slices.Contains(fileOrderKeys, key) {
+			return fmt.Errorf("invalid orderBy key %q: expected one of %s", key, strings.Join(fileOrderKeys, ", "))
+		}
+		if direction = strings.TrimSpace(direction); direction != "" && direction != "asc" && direction != "desc" {
+			return fmt.Errorf("invalid orderBy direction %q for %s: expected asc or desc", direction, key)
+		}
+	}
+	return nil
+}
+
+// DefaultPageSize is the number of items listed per page when ListOptions sets no page size
+const DefaultPageSize = 10
+
+// FileList is a page of files
+type FileList struct {
+	Files []DriveFile `json:"files"`
+	// NextPageToken fetches the next page; empty on the last page
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// SearchResultList is a page of content search results
+type SearchResultList struct {
+	Results []SearchResult `json:"results"`
+	// NextPageToken fetches the next page; empty on the last page
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// encodePageToken encodes a page token for listings that page on their own rather than with Drive's tokens
+func encodePageToken(token any) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken decodes a page token made by encodePageToken into token
+func decodePageToken(s string, token any) error {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return errors.New("invalid page token")
+	}
+	if err := json.Unmarshal(data, token); err != nil {
+		return errors.New("invalid page token")
+	}
+	return nil
+}
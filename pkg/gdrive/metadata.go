@@ -0,0 +1,81 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// FileMetadataUpdate holds the annotations to change on a file; nil fields are left unchanged
+type FileMetadataUpdate struct {
+	Description *string
+	Starred     *bool
+	// FolderColorRGB is a hex color such as "#4986e7"; Drive picks the closest palette color. Folders only.
+	FolderColorRGB *string
+	// IndexableText is extra text used for full-text search of the file, not shown to users
+	IndexableText *string
+}
+
+// folderColorPattern matches the hex colors accepted for FolderColorRGB
+var folderColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// metadataFields are the annotation fields returned by UpdateFileMetadata
+var metadataFields = []string{"description", "starred", "folderColorRgb"}
+
+// UpdateFileMetadata changes a file's description, starred state, folder color, or indexable text
+func (ds *DriveService) UpdateFileMetadata(ctx context.Context, fileID string, update FileMetadataUpdate) (*DriveFile, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if update == (FileMetadataUpdate{}) {
+		return nil, errors.New("no metadata to update")
+	}
+	if err := ds.checkScope(ctx, fileID); err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, fileID); err != nil {
+		return nil, err
+	}
+
+	file := &drive.File{}
+	if update.Description != nil {
+		file.Description = *update.Description
+		file.ForceSendFields = append(file.ForceSendFields, "Description")
+	}
+	if update.Starred != nil {
+		file.Starred = *update.Starred
+		file.ForceSendFields = append(file.ForceSendFields, "Starred")
+	}
+	if update.FolderColorRGB != nil {
+		if !folderColorPattern.MatchString(*update.FolderColorRGB) {
+			return nil, fmt.Errorf("invalid folder color %q: expected a hex color such as #4986e7", *update.FolderColorRGB)
+		}
+		file.FolderColorRgb = *update.FolderColorRGB
+	}
+	if update.IndexableText != nil {
+		file.ContentHints = &drive.FileContentHints{IndexableText: *update.IndexableText}
+	}
+	fields, err := fileFields(metadataFields)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := ds.driveService.Files.Update(fileID, file).
+		Fields(googleapi.Field(fields)).
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to update file metadata: %w", err)
+	}
+
+	driveFile, err := newDriveFile(updated, metadataFields)
+	if err != nil {
+		return nil, err
+	}
+	return &driveFile, nil
+}
@@ -0,0 +1,19 @@
+package gdrive
+
+// Google Workspace MIME types
+const (
+	folderMimeType       = "application/vnd.google-apps.folder"
+	documentMimeType     = "application/vnd.google-apps.document"
+	spreadsheetMimeType  = "application/vnd.google-apps.spreadsheet"
+	presentationMimeType = "application/vnd.google-apps.presentation"
+	formMimeType         = "application/vnd.google-apps.form"
+	drawingMimeType      = "application/vnd.google-apps.drawing"
+	shortcutMimeType     = "application/vnd.google-apps.shortcut"
+)
+
+// Office MIME types
+const (
+	docxMimeType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	xlsxMimeType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	pptxMimeType = "application/vnd.openxmlformats-officedocument.presentationml.presentation"
+)
@@ -0,0 +1,181 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+// ModifiedFilesQuery selects files changed within a time range
+type ModifiedFilesQuery struct {
+	// Since is the inclusive start of the range
+	Since time.Time
+	// Until is the exclusive end of the range; zero means no end
+	Until time.Time
+	// Created matches files by creation time instead of last modification time
+	Created bool
+	// FolderID limits the results to files anywhere below this folder; empty searches all of Drive
+	FolderID string
+	// Owner limits the results to files owned by this email address
+	Owner string
+}
+
+// DefaultModifiedPageSize is the number of files ListModifiedFiles returns per page when ListOptions sets no page size
+const DefaultModifiedPageSize = 50
+
+// modifiedPageToken continues ListModifiedFiles after the last file of a page. Files are listed newest first,
+// so the next page holds files at or before Before, except those already returned.
+type modifiedPageToken struct {
+	Before string   `json:"b"`
+	Skip   []string `json:"s,omitempty"`
+}
+
+// timeField returns the Drive file field the query matches on
+func (q ModifiedFilesQuery) timeField() string {
+	if q.Created {
+		return "createdTime"
+	}
+	return "modifiedTime"
+}
+
+// ListModifiedFiles lists non-folder files modified (or created) within a time range, most recent first.
+// The matched timestamp is always included in the result alongside opts.Fields. The order is fixed, so
+// opts.OrderBy must be empty.
+func (ds *DriveService) ListModifiedFiles(ctx context.Context, query ModifiedFilesQuery, opts ListOptions) (*FileList, error) {
+	if query.Since.IsZero() {
+		return nil, errors.New("start of time range is empty")
+	}
+	if !query.Until.IsZero() && !query.Until.After(query.Since) {
+		return nil, errors.New("end of time range must be after its start")
+	}
+	if opts.OrderBy != "" {
+		return nil, errors.New("modified files are always listed most recent first; orderBy is not supported")
+	}
+
+	var token modifiedPageToken
+	if opts.PageToken != "" {
+		if err := decodePageToken(opts.PageToken, &token); err != nil {
+			return nil, err
+		}
+	}
+	pageSize := opts.pageSize(DefaultModifiedPageSize)
+
+	timeField := query.timeField()
+	extraFields := opts.Fields
+	if !slices.Contains(extraFields, timeField) {
+		extraFields = append(extraFields[:len(extraFields):len(extraFields)], timeField)
+	}
+	fields, err := fileFieldsMask(extraFields)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the query shared by every folder
+	clauses := []string{
+		fmt.Sprintf("%s >= '%s'", timeField, query.Since.UTC().Format(time.RFC3339)),
+		fmt.Sprintf("mimeType != '%s'", folderMimeType),
+		"trashed = false",
+	}
+	if !query.Until.IsZero() {
+		clauses = append(clauses, fmt.Sprintf("%s < '%s'", timeField, query.Until.UTC().Format(time.RFC3339)))
+	}
+	if query.Owner != "" {
+		clauses = append(clauses, fmt.Sprintf("'%s' in owners", query.Owner))
+	}
+	if token.Before != "" {
+		clauses = append(clauses, fmt.Sprintf("%s <= '%s'", timeField, token.Before))
+	}
+
+	// Drive can only match direct parents, so a folder scope is expanded to its whole subtree
+	parents := []string{""}
+	if folderID := ds.folderOrRoot(query.FolderID); folderID != "" {
+		if err := ds.checkScope(ctx, folderID); err != nil {
+			return nil, err
+		}
+		parents, err = ds.subtreeFolderIDs(ctx, folderID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		mu    sync.Mutex
+		files []DriveFile
+		// full is set when a folder filled its page, so it may hold more files
+		full bool
+	)
+	err = forEachConcurrent(ctx, ds.parallelism, len(parents), func(ctx context.Context, i int) error {
+		q := strings.Join(clauses, " and ")
+		if parents[i] != "" {
+			q = fmt.Sprintf("'%s' in parents and %s", parents[i], q)
+		}
+
+		r, err := ds.driveService.Files.List().
+			Q(q).
+			OrderBy(timeField + " desc").
+			// Files already returned at the page boundary are skipped, so ask for that many more
+			PageSize(int64(pageSize + len(token.Skip))).
+			Fields(googleapi.Field(fields)).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return fmt.Errorf("failed to list modified files: %w", err)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if len(r.Files) == pageSize+len(token.Skip) {
+			full = true
+		}
+		for _, file := range r.Files {
+			if slices.Contains(token.Skip, file.Id) {
+				continue
+			}
+			driveFile, err := newDriveFile(file, extraFields)
+			if err != nil {
+				return err
+			}
+			files = append(files, driveFile)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Merge the per-folder results; Drive timestamps share one format, so they sort as strings
+	sort.SliceStable(files, func(i, j int) bool {
+		return fileTime(files[i], timeField) > fileTime(files[j], timeField)
+	})
+	list := &FileList{Files: files}
+	if len(files) > pageSize || (full && len(files) > 0) {
+		list.Files = files[:min(len(files), pageSize)]
+
+		// Continue from the last file's timestamp, skipping the files at that timestamp already returned
+		next := modifiedPageToken{Before: fileTime(list.Files[len(list.Files)-1], timeField)}
+		if next.Before == token.Before {
+			next.Skip = token.Skip
+		}
+		for _, file := range list.Files {
+			if fileTime(file, timeField) == next.Before {
+				next.Skip = append(next.Skip, file.ID)
+			}
+		}
+		list.NextPageToken = encodePageToken(next)
+	}
+
+	return list, nil
+}
+
+// fileTime returns the timestamp of a file in the given field
+func fileTime(file DriveFile, timeField string) string {
+	t, _ := file.Fields[timeField].(string)
+	return t
+}
@@ -0,0 +1,81 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// movedFileFields are the extra fields returned for a moved file, so callers can see where it ended up
+var movedFileFields = []string{"parents", "driveId"}
+
+// MoveFile moves a file or folder into folderID by adding it as a parent and removing the file's current parents.
+// Files created before Drive's single-parent model may still have several parents; for those, fromFolderID removes
+// only that parent and leaves the others in place. Items in a shared drive always have exactly one parent, so the
+// parent is always replaced there, and moving them out of their shared drive needs the right to do so in both drives.
+func (ds *DriveService) MoveFile(ctx context.Context, fileID, folderID, fromFolderID string) (*DriveFile, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if folderID == "" {
+		return nil, errors.New("folder ID is empty")
+	}
+	if err := ds.checkScope(ctx, fileID, folderID, fromFolderID); err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, fileID, folderID); err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Get(fileID).
+		Fields("id, name, mimeType, parents, driveId").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	if file.Id == folderID {
+		return nil, errors.New("a folder cannot be moved into itself")
+	}
+
+	// A shared drive item's single parent is always replaced
+	remove := file.Parents
+	if fromFolderID != "" && file.DriveId == "" {
+		if !slices.Contains(file.Parents, fromFolderID) {
+			return nil, fmt.Errorf("%s is not in folder %s", fileID, fromFolderID)
+		}
+		remove = []string{fromFolderID}
+	}
+	remove = slices.DeleteFunc(slices.Clone(remove), func(parent string) bool { return parent == folderID })
+
+	call := ds.driveService.Files.Update(file.Id, &drive.File{}).
+		SupportsAllDrives(true).
+		Context(ctx)
+	if !slices.Contains(file.Parents, folderID) {
+		call.AddParents(folderID)
+	}
+	if len(remove) > 0 {
+		call.RemoveParents(strings.Join(remove, ","))
+	}
+
+	fields, err := fileFields(movedFileFields)
+	if err != nil {
+		return nil, err
+	}
+	moved, err := call.Fields(googleapi.Field(fields)).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to move file: %w", err)
+	}
+
+	driveFile, err := newDriveFile(moved, movedFileFields)
+	if err != nil {
+		return nil, err
+	}
+	return &driveFile, nil
+}
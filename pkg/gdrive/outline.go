@@ -0,0 +1,111 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DocumentParagraph is a paragraph of a Google Document with the structure needed to render it, e.g. as Markdown
+type DocumentParagraph struct {
+	// Style is the paragraph's named style: NORMAL_TEXT, TITLE, SUBTITLE, or HEADING_1 to HEADING_6
+	Style string `json:"style"`
+	// Bullet is set for list items, and NestingLevel is their depth starting at 0
+	Bullet       bool   `json:"bullet,omitempty"`
+	NestingLevel int64  `json:"nestingLevel,omitempty"`
+	Text         string `json:"text"`
+}
+
+// PresentationText is the text of a Google Slides presentation, slide by slide
+type PresentationText struct {
+	Title  string      `json:"title"`
+	Slides []SlideText `json:"slides"`
+}
+
+// SlideText is the text of the shapes on a slide, one entry per shape with text
+type SlideText struct {
+	Texts []string `json:"texts"`
+}
+
+// GetDocumentParagraphs retrieves the paragraphs of a Google Document's body with their styles.
+// Paragraph text excludes the trailing newline.
+func (ds *DriveService) GetDocumentParagraphs(ctx context.Context, documentID string) ([]DocumentParagraph, error) {
+	if documentID == "" {
+		return nil, errors.New("document ID is empty")
+	}
+
+	documentID, err := ds.resolveFileID(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cachedRead(ctx, ds, documentID, "paragraphs:"+documentID, func() ([]DocumentParagraph, error) {
+		doc, err := ds.docsService.Documents.Get(documentID).
+			Fields("body(content(paragraph(paragraphStyle(namedStyleType),bullet(nestingLevel),elements(" + paragraphElementFields + "))))").
+			Context(ctx).
+			Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get document: %w", err)
+		}
+
+		var paragraphs []DocumentParagraph
+		for _, element := range doc.Body.Content {
+			if element.Paragraph == nil {
+				continue
+			}
+			var text strings.Builder
+			for _, elem := range element.Paragraph.Elements {
+				text.WriteString(elementText(elem))
+			}
+			paragraph := DocumentParagraph{Text: strings.TrimSuffix(text.String(), "\n")}
+			if element.Paragraph.ParagraphStyle != nil {
+				paragraph.Style = element.Paragraph.ParagraphStyle.NamedStyleType
+			}
+			if element.Paragraph.Bullet != nil {
+				paragraph.Bullet = true
+				paragraph.NestingLevel = element.Paragraph.Bullet.NestingLevel
+			}
+			paragraphs = append(paragraphs, paragraph)
+		}
+
+		return paragraphs, nil
+	})
+}
+
+// GetPresentationText retrieves the title of a Google Slides presentation and the text of each slide's shapes
+func (ds *DriveService) GetPresentationText(ctx context.Context, presentationID string) (*PresentationText, error) {
+	if presentationID == "" {
+		return nil, errors.New("presentation ID is empty")
+	}
+
+	presentationID, err := ds.resolveFileID(ctx, presentationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cachedRead(ctx, ds, presentationID, "presentation-text:"+presentationID, func() (*PresentationText, error) {
+		presentation, err := ds.slidesService.Presentations.Get(presentationID).
+			Fields("title,slides(pageElements(shape(text(textElements(textRun(content))))))").
+			Context(ctx).
+			Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get presentation: %w", err)
+		}
+
+		result := &PresentationText{Title: presentation.Title, Slides: make([]SlideText, len(presentation.Slides))}
+		for i, slide := range presentation.Slides {
+			result.Slides[i].Texts = []string{}
+			for _, element := range slide.PageElements {
+				if element.Shape == nil {
+					continue
+				}
+				if text := strings.TrimSuffix(shapeText(element.Shape), "\n"); text != "" {
+					result.Slides[i].Texts = append(result.Slides[i].Texts, text)
+				}
+			}
+		}
+
+		return result, nil
+	})
+}
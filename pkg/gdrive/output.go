@@ -0,0 +1,23 @@
+package gdrive
+
+import "context"
+
+// WithOutputFolder places every file the server creates, such as uploads, copies, and re-themed presentations,
+// in folderID regardless of the folder requested, so generated content is easy to review and clean up.
+// folderID may also be a folder URL. Empty creates files where requested.
+func WithOutputFolder(folderID string) Option {
+	return func(ds *DriveService) {
+		ds.outputFolder = ResolveFileID(folderID)
+	}
+}
+
+// outputParents returns the folders a new file is created in: the output folder if one is set, otherwise parents
+func (ds *DriveService) outputParents(ctx context.Context, parents []string) ([]string, error) {
+	if ds.outputFolder == "" {
+		return parents, nil
+	}
+	if err := ds.checkScope(ctx, ds.outputFolder); err != nil {
+		return nil, err
+	}
+	return []string{ds.outputFolder}, nil
+}
@@ -0,0 +1,122 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// maxFolderDepth bounds ancestor walks so that a malformed hierarchy cannot loop forever
+const maxFolderDepth = 100
+
+// ParentFolder is a folder directly containing a file
+type ParentFolder struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Path is the folder's full path, starting at My Drive or the shared drive name
+	Path string `json:"path"`
+	// DriveID is the shared drive holding the folder, empty for My Drive
+	DriveID string `json:"driveId,omitempty"`
+}
+
+// FileParents lists every folder a file can be reached from
+type FileParents struct {
+	ID       string         `json:"id"`
+	Name     string         `json:"name"`
+	MimeType string         `json:"mimeType"`
+	Parents  []ParentFolder `json:"parents"`
+	// Target holds the parents of the shortcut's target when the file is a shortcut
+	Target *FileParents `json:"target,omitempty"`
+}
+
+// GetFileParents returns the folders directly containing a file, each with its full path.
+// A file may have several parents, and a shortcut is reported together with the parents of its target.
+func (ds *DriveService) GetFileParents(ctx context.Context, fileID string) (*FileParents, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if err := ds.checkScope(ctx, fileID); err != nil {
+		return nil, err
+	}
+
+	return ds.fileParents(ctx, fileID, make(map[string]*drive.File))
+}
+
+// fileParents resolves the parents of one file, following a shortcut to its target once.
+// folders caches folder metadata across the ancestor walks.
+func (ds *DriveService) fileParents(ctx context.Context, fileID string, folders map[string]*drive.File) (*FileParents, error) {
+	file, err := ds.driveService.Files.Get(fileID).
+		Fields("id, name, mimeType, parents, shortcutDetails(targetId)").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	result := &FileParents{ID: file.Id, Name: file.Name, MimeType: file.MimeType, Parents: []ParentFolder{}}
+	for _, parentID := range file.Parents {
+		parent, err := ds.folderPath(ctx, parentID, folders)
+		if err != nil {
+			return nil, err
+		}
+		result.Parents = append(result.Parents, *parent)
+	}
+
+	if file.MimeType == shortcutMimeType && file.ShortcutDetails != nil && fileID != file.ShortcutDetails.TargetId {
+		result.Target, err = ds.fileParents(ctx, file.ShortcutDetails.TargetId, folders)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve shortcut target: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// folderPath walks up from folderID to the root of its drive and returns the folder with its full path
+func (ds *DriveService) folderPath(ctx context.Context, folderID string, folders map[string]*drive.File) (*ParentFolder, error) {
+	var names []string
+	var first *drive.File
+
+	for id, depth := folderID, 0; id != "" && depth < maxFolderDepth; depth++ {
+		folder, ok := folders[id]
+		if !ok {
+			var err error
+			folder, err = ds.driveService.Files.Get(id).
+				Fields("id, name, parents, driveId").
+				SupportsAllDrives(true).
+				Context(ctx).
+				Do()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get folder %s: %w", id, err)
+			}
+			folders[id] = folder
+		}
+		if first == nil {
+			first = folder
+		}
+
+		names = append(names, folder.Name)
+
+		// Ancestors of a folder with several parents are followed through its first parent
+		id = ""
+		if len(folder.Parents) > 0 {
+			id = folder.Parents[0]
+		}
+	}
+
+	// names runs from the folder up to the root
+	for i, j := 0, len(names)-1; i < j; i, j = i+1, j-1 {
+		names[i], names[j] = names[j], names[i]
+	}
+
+	return &ParentFolder{
+		ID:      first.Id,
+		Name:    first.Name,
+		Path:    strings.Join(names, "/"),
+		DriveID: first.DriveId,
+	}, nil
+}
@@ -0,0 +1,133 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// myDriveName is the name Drive shows for the root of My Drive, accepted as the first segment of a path
+const myDriveName = "My Drive"
+
+// maxPathMatches bounds the files a path may match, since duplicate folder names make every level branch
+const maxPathMatches = 100
+
+// ResolvedPath is the file or files found at a path
+type ResolvedPath struct {
+	Path string `json:"path"`
+	// Files holds every file at the path; Drive allows several files with the same name in a folder
+	Files []DriveFile `json:"files"`
+}
+
+// ResolvePath finds the file or folder at a slash-separated path such as "/Projects/2025/Q3 Report" by walking
+// down from the top of My Drive, or of the shared drive driveID. A leading "My Drive" segment is optional, and a
+// slash inside a name is written as "\/". Shortcuts to folders are followed along the way. With a root folder set,
+// paths start at the root folder instead of My Drive.
+func (ds *DriveService) ResolvePath(ctx context.Context, path, driveID string) (*ResolvedPath, error) {
+	segments := splitPath(path)
+	if len(segments) == 0 {
+		return nil, errors.New("path is empty")
+	}
+
+	start := ds.folderOrRoot(driveID)
+	if start == "" {
+		start = "root"
+		if segments[0] == myDriveName && len(segments) > 1 {
+			segments = segments[1:]
+		}
+	}
+	if err := ds.checkScope(ctx, start); err != nil {
+		return nil, err
+	}
+
+	resolved := &ResolvedPath{Path: "/" + strings.Join(segments, "/")}
+	parents := []string{start}
+	for i, segment := range segments {
+		last := i == len(segments)-1
+
+		var (
+			mu      sync.Mutex
+			matches []*drive.File
+		)
+		err := forEachConcurrent(ctx, ds.parallelism, len(parents), func(ctx context.Context, j int) error {
+			return ds.driveService.Files.List().
+				Q(fmt.Sprintf("name = %s and '%s' in parents and trashed = false", quoteQuery(segment), parents[j])).
+				PageSize(maxPathMatches).
+				Fields("nextPageToken, files(id, name, mimeType, shortcutDetails(targetId, targetMimeType))").
+				SupportsAllDrives(true).
+				IncludeItemsFromAllDrives(true).
+				Pages(ctx, func(r *drive.FileList) error {
+					mu.Lock()
+					defer mu.Unlock()
+					matches = append(matches, r.Files...)
+					return nil
+				})
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list files: %w", err)
+		}
+
+		if last {
+			for _, file := range matches {
+				resolved.Files = append(resolved.Files, DriveFile{ID: file.Id, Name: file.Name, Type: file.MimeType})
+			}
+			break
+		}
+
+		// Only folders, or shortcuts to them, lead further down
+		parents = parents[:0]
+		seen := make(map[string]bool)
+		for _, file := range matches {
+			folderID := file.Id
+			if file.MimeType == shortcutMimeType && file.ShortcutDetails != nil && file.ShortcutDetails.TargetMimeType == folderMimeType {
+				folderID = file.ShortcutDetails.TargetId
+			} else if file.MimeType != folderMimeType {
+				continue
+			}
+			if !seen[folderID] {
+				seen[folderID] = true
+				parents = append(parents, folderID)
+			}
+		}
+		if len(parents) == 0 {
+			return nil, fmt.Errorf("no folder at %q", "/"+strings.Join(segments[:i+1], "/"))
+		}
+		if len(parents) > maxPathMatches {
+			return nil, fmt.Errorf("more than %d folders match %q", maxPathMatches, "/"+strings.Join(segments[:i+1], "/"))
+		}
+	}
+
+	if len(resolved.Files) == 0 {
+		return nil, fmt.Errorf("no file or folder at %q", resolved.Path)
+	}
+	return resolved, nil
+}
+
+// splitPath splits a slash-separated path into its names, dropping empty ones. "\/" stands for a slash within a name.
+func splitPath(path string) []string {
+	var segments []string
+	var current strings.Builder
+	flush := func() {
+		if name := strings.TrimSpace(current.String()); name != "" {
+			segments = append(segments, name)
+		}
+		current.Reset()
+	}
+	for i := 0; i < len(path); i++ {
+		switch {
+		case path[i] == '\\' && i+1 < len(path) && path[i+1] == '/':
+			current.WriteByte('/')
+			i++
+		case path[i] == '/':
+			flush()
+		default:
+			current.WriteByte(path[i])
+		}
+	}
+	flush()
+	return segments
+}
@@ -0,0 +1,144 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/drive/v3"
+)
+
+const pdfMimeType = "application/pdf"
+
+// pdfPageFields is the field mask of the converted document's body read to split its text into pages
+const pdfPageFields = "body(content(paragraph(elements(textRun(content),pageBreak)),sectionBreak(sectionStyle(sectionType)),table(tableRows(tableCells(content(paragraph(elements(textRun(content)))))))))"
+
+// PDFText is the text extracted from a PDF
+type PDFText struct {
+	FileID string `json:"fileId"`
+	Name   string `json:"name"`
+	Text   string `json:"text,omitempty"`
+	// Pages holds the text of each page, when requested. Page boundaries are the page breaks Drive keeps
+	// when converting the PDF; if it keeps none, all text is in one page.
+	Pages []string `json:"pages,omitempty"`
+}
+
+// ExtractPDFText extracts the text of a PDF stored in Drive. The PDF is converted to a temporary Google Doc in
+// My Drive, or the output folder, with Drive's import, which runs OCR on scanned pages, and the document is
+// deleted afterwards.
+// ocrLanguage is an optional ISO 639-1 code hinting the language of scanned text. When perPage is set, the
+// text is returned page by page instead of as a whole.
+func (ds *DriveService) ExtractPDFText(ctx context.Context, fileID, ocrLanguage string, perPage bool) (*PDFText, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Get(fileID).
+		Fields("id, name, mimeType").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	if file.MimeType != pdfMimeType {
+		return nil, fmt.Errorf("file type %s is not a PDF", file.MimeType)
+	}
+
+	// The temporary document is created like any other file, so the output folder and access policy apply
+	parents, err := ds.outputParents(ctx, []string{"root"})
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, parents...); err != nil {
+		return nil, err
+	}
+
+	// Copying with a Google Docs MIME type runs Drive's import and OCR
+	call := ds.driveService.Files.Copy(file.Id, &drive.File{
+		Name:     file.Name + " (text extraction)",
+		MimeType: documentMimeType,
+		Parents:  parents,
+	})
+	if ocrLanguage != "" {
+		call = call.OcrLanguage(ocrLanguage)
+	}
+	converted, err := call.Fields("id").SupportsAllDrives(true).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert PDF: %w", err)
+	}
+	defer func() {
+		// Remove the temporary document even if the request was canceled
+		_ = ds.driveService.Files.Delete(converted.Id).Context(context.WithoutCancel(ctx)).Do()
+	}()
+
+	result := &PDFText{FileID: file.Id, Name: file.Name}
+	if !perPage {
+		result.Text, err = ds.exportText(ctx, converted.Id)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	doc, err := ds.docsService.Documents.Get(converted.Id).
+		Fields(pdfPageFields).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get converted document: %w", err)
+	}
+	result.Pages = splitPages(doc.Body.Content)
+
+	return result, nil
+}
+
+// splitPages returns the text of structural elements, split at page breaks and next-page section breaks
+func splitPages(content []*docs.StructuralElement) []string {
+	pages := []string{""}
+	var page strings.Builder
+	flush := func() {
+		pages[len(pages)-1] = page.String()
+		page.Reset()
+	}
+
+	for _, element := range content {
+		switch {
+		case element.SectionBreak != nil:
+			// The document starts with a section break; later ones may start a new page
+			if element.SectionBreak.SectionStyle != nil && element.SectionBreak.SectionStyle.SectionType == "NEXT_PAGE" && page.Len() > 0 {
+				flush()
+				pages = append(pages, "")
+			}
+		case element.Paragraph != nil:
+			for _, elem := range element.Paragraph.Elements {
+				if elem.PageBreak != nil {
+					flush()
+					pages = append(pages, "")
+					continue
+				}
+				if elem.TextRun != nil {
+					page.WriteString(elem.TextRun.Content)
+				}
+			}
+		case element.Table != nil:
+			for _, row := range element.Table.TableRows {
+				var cells []string
+				for _, cell := range row.TableCells {
+					cells = append(cells, strings.TrimSuffix(strings.Join(splitPages(cell.Content), ""), "\n"))
+				}
+				page.WriteString(strings.Join(cells, "\t") + "\n")
+			}
+		}
+	}
+	flush()
+
+	return pages
+}
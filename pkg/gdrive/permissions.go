@@ -0,0 +1,117 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// DefaultPermissionPageSize is the number of permissions ListPermissions returns per page when ListOptions sets no
+// page size; it is also the most Drive returns per page
+const DefaultPermissionPageSize = 100
+
+// permissionFields are the permission fields read for Permission
+const permissionFields = "id,type,role,emailAddress,domain,displayName,allowFileDiscovery,expirationTime,deleted,permissionDetails(inherited,inheritedFrom)"
+
+// Permission is a grant of access to a file
+type Permission struct {
+	ID string `json:"id"`
+	// Type is user, group, domain, or anyone
+	Type string `json:"type"`
+	// Role is owner, organizer, fileOrganizer, writer, commenter, or reader
+	Role         string `json:"role"`
+	EmailAddress string `json:"emailAddress,omitempty"`
+	Domain       string `json:"domain,omitempty"`
+	DisplayName  string `json:"displayName,omitempty"`
+	// AllowFileDiscovery reports whether a domain or anyone permission lets the file be found through search
+	AllowFileDiscovery bool   `json:"allowFileDiscovery,omitempty"`
+	ExpirationTime     string `json:"expirationTime,omitempty"`
+	// Deleted is set when the permission's account has been deleted
+	Deleted bool `json:"deleted,omitempty"`
+	// Inherited is set for shared drive items whose access comes from a parent folder or the drive itself
+	Inherited     bool   `json:"inherited,omitempty"`
+	InheritedFrom string `json:"inheritedFrom,omitempty"`
+}
+
+// PermissionList is a page of permissions
+type PermissionList struct {
+	Permissions []Permission `json:"permissions"`
+	// NextPageToken fetches the next page; empty on the last page
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// ListPermissions lists who can access a file: each user, group, domain, or anyone-with-the-link grant with its role.
+// opts.OrderBy and opts.Fields are not supported. For a shortcut, the permissions of its target are listed.
+func (ds *DriveService) ListPermissions(ctx context.Context, fileID string, opts ListOptions) (*PermissionList, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if opts.OrderBy != "" || len(opts.Fields) > 0 {
+		return nil, errors.New("orderBy and fields are not supported for permissions")
+	}
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := ds.driveService.Permissions.List(fileID).
+		SupportsAllDrives(true).
+		PageSize(int64(opts.pageSize(DefaultPermissionPageSize))).
+		PageToken(opts.PageToken).
+		Fields("nextPageToken,permissions(" + permissionFields + ")").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list permissions: %w", err)
+	}
+
+	list := &PermissionList{Permissions: make([]Permission, 0, len(r.Permissions)), NextPageToken: r.NextPageToken}
+	for _, p := range r.Permissions {
+		list.Permissions = append(list.Permissions, newPermission(p))
+	}
+	return list, nil
+}
+
+// listPermissions returns all permissions of a file
+func (ds *DriveService) listPermissions(ctx context.Context, fileID string) ([]Permission, error) {
+	var permissions []Permission
+	err := ds.driveService.Permissions.List(fileID).
+		SupportsAllDrives(true).
+		PageSize(DefaultPermissionPageSize).
+		Fields("nextPageToken,permissions("+permissionFields+")").
+		Pages(ctx, func(r *drive.PermissionList) error {
+			for _, p := range r.Permissions {
+				permissions = append(permissions, newPermission(p))
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list permissions: %w", err)
+	}
+	return permissions, nil
+}
+
+// newPermission converts a Drive API permission
+func newPermission(p *drive.Permission) Permission {
+	permission := Permission{
+		ID:                 p.Id,
+		Type:               p.Type,
+		Role:               p.Role,
+		EmailAddress:       p.EmailAddress,
+		Domain:             p.Domain,
+		DisplayName:        p.DisplayName,
+		AllowFileDiscovery: p.AllowFileDiscovery,
+		ExpirationTime:     p.ExpirationTime,
+		Deleted:            p.Deleted,
+	}
+	for _, detail := range p.PermissionDetails {
+		if detail.Inherited {
+			permission.Inherited = true
+			permission.InheritedFrom = detail.InheritedFrom
+			break
+		}
+	}
+	return permission
+}
@@ -0,0 +1,177 @@
+package gdrive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// ErrWriteDenied is returned when the access policy does not allow changing a file
+var ErrWriteDenied = errors.New("write denied by access policy")
+
+// AccessRules selects files by ID, by a folder they are below, or by MIME type
+type AccessRules struct {
+	// Files are file IDs
+	Files []string `json:"files,omitempty"`
+	// Folders are folder IDs; a folder matches itself and everything below it
+	Folders []string `json:"folders,omitempty"`
+	// MimeTypes are MIME types. An entry ending in "/*" matches the whole type, e.g. "image/*".
+	MimeTypes []string `json:"mimeTypes,omitempty"`
+}
+
+// AccessPolicy restricts which files the server may change. Reads are not affected.
+//
+// A file may be changed unless it matches any deny rule. If allow rules are given, the file must also be listed
+// in Allow.Files or be below one of Allow.Folders (when either is given), and have one of Allow.MimeTypes
+// (when given). Creating a file counts as changing the folder it is created in.
+type AccessPolicy struct {
+	Allow AccessRules `json:"allow"`
+	Deny  AccessRules `json:"deny"`
+}
+
+// LoadAccessPolicy reads an access policy from a JSON file
+func LoadAccessPolicy(path string) (*AccessPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read access policy: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var policy AccessPolicy
+	if err := decoder.Decode(&policy); err != nil {
+		return nil, fmt.Errorf("failed to parse access policy %s: %w", path, err)
+	}
+
+	// File and folder URLs are accepted like everywhere else
+	for _, rules := range []*AccessRules{&policy.Allow, &policy.Deny} {
+		for i, id := range rules.Files {
+			rules.Files[i] = ResolveFileID(id)
+		}
+		for i, id := range rules.Folders {
+			rules.Folders[i] = ResolveFileID(id)
+		}
+	}
+	return &policy, nil
+}
+
+// WithAccessPolicy restricts which files the server may change. nil allows changing any file.
+func WithAccessPolicy(policy *AccessPolicy) Option {
+	return func(ds *DriveService) {
+		ds.accessPolicy = policy
+	}
+}
+
+// checkWrite returns an error wrapping ErrWriteDenied unless the access policy allows changing every
+// non-empty file ID. Without a policy, every file may be changed.
+func (ds *DriveService) checkWrite(ctx context.Context, fileIDs ...string) error {
+	policy := ds.accessPolicy
+	if policy == nil {
+		return nil
+	}
+
+	for _, fileID := range fileIDs {
+		if fileID == "" {
+			continue
+		}
+
+		file, err := ds.driveService.Files.Get(fileID).
+			Fields("id, mimeType, parents").
+			SupportsAllDrives(true).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return fmt.Errorf("failed to get file: %w", err)
+		}
+
+		// The file and its ancestors are matched against folder rules
+		folders := []string{file.Id}
+		if len(policy.Allow.Folders) > 0 || len(policy.Deny.Folders) > 0 {
+			ancestors, err := ds.ancestorIDs(ctx, file.Parents)
+			if err != nil {
+				return err
+			}
+			folders = append(folders, ancestors...)
+		}
+
+		if reason := policy.denies(file.Id, file.MimeType, folders); reason != "" {
+			return fmt.Errorf("%s %s: %w", fileID, reason, ErrWriteDenied)
+		}
+	}
+	return nil
+}
+
+// denies explains why the policy does not allow changing a file, or returns "" if it does.
+// folders holds the file's own ID and the IDs of all its ancestors.
+func (p *AccessPolicy) denies(fileID, mimeType string, folders []string) string {
+	below := func(folderIDs []string) bool {
+		return slices.ContainsFunc(folders, func(id string) bool { return slices.Contains(folderIDs, id) })
+	}
+
+	switch {
+	case slices.Contains(p.Deny.Files, fileID):
+		return "is listed as denied"
+	case below(p.Deny.Folders):
+		return "is in a denied folder"
+	case mediaTypeMatches(p.Deny.MimeTypes, mimeType):
+		return "has denied type " + mimeType
+	}
+
+	located := len(p.Allow.Files) == 0 && len(p.Allow.Folders) == 0
+	if !located && !slices.Contains(p.Allow.Files, fileID) && !below(p.Allow.Folders) {
+		return "is not in an allowed folder or listed as allowed"
+	}
+	if len(p.Allow.MimeTypes) > 0 && !mediaTypeMatches(p.Allow.MimeTypes, mimeType) {
+		return "has type " + mimeType + ", which is not allowed"
+	}
+	return ""
+}
+
+// ancestorIDs returns the IDs of every folder above the given parents, through all parents of each folder
+func (ds *DriveService) ancestorIDs(ctx context.Context, parents []string) ([]string, error) {
+	var ancestors []string
+	seen := make(map[string]bool)
+	level := parents
+	for depth := 0; len(level) > 0 && depth < maxFolderDepth; depth++ {
+		var next []string
+		for _, id := range level {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			ancestors = append(ancestors, id)
+
+			folder, err := ds.driveService.Files.Get(id).
+				Fields("id, parents").
+				SupportsAllDrives(true).
+				Context(ctx).
+				Do()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get folder %s: %w", id, err)
+			}
+			next = append(next, folder.Parents...)
+		}
+		level = next
+	}
+	return ancestors, nil
+}
+
+// mediaTypeMatches reports whether mediaType matches one of patterns. A pattern ending in "/*" matches the
+// whole type, e.g. "image/*".
+func mediaTypeMatches(patterns []string, mediaType string) bool {
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, "/*"); ok {
+			if strings.HasPrefix(mediaType, prefix+"/") {
+				return true
+			}
+		} else if mediaType == pattern {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package gdrive
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// pptxSlideTexts returns the text of each slide of a .pptx file in presentation order, one line per paragraph
+func pptxSlideTexts(data []byte) ([]string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open presentation: %w", err)
+	}
+
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, f := range archive.File {
+		files[f.Name] = f
+	}
+
+	// Slide order is given by the slide list, which refers to slide parts through relationships
+	var presentation struct {
+		Slides []struct {
+			RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sldIdLst>sldId"`
+	}
+	if err := decodeZipXML(files, "ppt/presentation.xml", &presentation); err != nil {
+		return nil, err
+	}
+
+	var rels struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if err := decodeZipXML(files, "ppt/_rels/presentation.xml.rels", &rels); err != nil {
+		return nil, err
+	}
+	targets := make(map[string]string, len(rels.Relationships))
+	for _, rel := range rels.Relationships {
+		targets[rel.ID] = path.Join("ppt", rel.Target)
+	}
+
+	texts := make([]string, len(presentation.Slides))
+	for i, slide := range presentation.Slides {
+		name, ok := targets[slide.RelID]
+		if !ok {
+			return nil, fmt.Errorf("slide %d has no part", i+1)
+		}
+		f, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("slide part %s is missing", name)
+		}
+
+		r, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		texts[i], err = drawingMLText(r)
+		r.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+		}
+	}
+
+	return texts, nil
+}
+
+// decodeZipXML decodes the XML part name of an OOXML package into v
+func decodeZipXML(files map[string]*zip.File, name string, v any) error {
+	f, ok := files[name]
+	if !ok {
+		return fmt.Errorf("presentation part %s is missing", name)
+	}
+	r, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	defer r.Close()
+
+	if err := xml.NewDecoder(r).Decode(v); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return nil
+}
+
+// drawingMLText extracts the text runs (a:t) of a DrawingML part, one line per non-empty paragraph (a:p)
+func drawingMLText(r io.Reader) (string, error) {
+	const drawingML = "http://schemas.openxmlformats.org/drawingml/2006/main"
+
+	var lines []string
+	var paragraph strings.Builder
+	inText := false
+
+	decoder := xml.NewDecoder(r)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Space == drawingML && t.Name.Local == "t" {
+				inText = true
+			}
+		case xml.EndElement:
+			if t.Name.Space != drawingML {
+				continue
+			}
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				if line := strings.TrimSpace(paragraph.String()); line != "" {
+					lines = append(lines, line)
+				}
+				paragraph.Reset()
+			}
+		case xml.CharData:
+			if inText {
+				paragraph.Write(t)
+			}
+		}
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
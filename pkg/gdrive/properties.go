@@ -0,0 +1,156 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// quoteQuery quotes s as a string literal in the Drive query language
+func quoteQuery(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// SearchFilesByProperties lists non-trashed files carrying all the given custom properties.
+// When appProperties is set, the private properties of this application are matched instead of the public ones.
+func (ds *DriveService) SearchFilesByProperties(ctx context.Context, properties map[string]string, appProperties bool, opts ListOptions) (*FileList, error) {
+	if len(properties) == 0 {
+		return nil, errors.New("properties are empty")
+	}
+
+	// Build one clause per property, in a stable order
+	field := "properties"
+	if appProperties {
+		field = "appProperties"
+	}
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var clauses []string
+	for _, key := range keys {
+		clauses = append(clauses, fmt.Sprintf("%s has { key=%s and value=%s }", field, quoteQuery(key), quoteQuery(properties[key])))
+	}
+	clauses = append(clauses, "trashed = false")
+
+	// Execute search with Google Drive API
+	list, err := ds.listFiles(ctx, ds.driveService.Files.List().Q(strings.Join(clauses, " and ")), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search files by properties: %w", err)
+	}
+
+	return list, nil
+}
+
+// maxPropertySize is the most bytes Drive allows for the key and value of one custom property together
+const maxPropertySize = 124
+
+// FileProperties are the custom key/value properties of a file
+type FileProperties struct {
+	FileID string `json:"fileId"`
+	Name   string `json:"name"`
+	// Properties are visible to all apps
+	Properties map[string]string `json:"properties"`
+	// AppProperties are private to this application
+	AppProperties map[string]string `json:"appProperties"`
+}
+
+// GetFileProperties reads the public and application-private custom properties of a file
+func (ds *DriveService) GetFileProperties(ctx context.Context, fileID string) (*FileProperties, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if err := ds.checkScope(ctx, fileID); err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Get(fileID).
+		Fields("id, name, properties, appProperties").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	return newFileProperties(file), nil
+}
+
+// SetFileProperties adds, changes, or, for nil values, removes custom properties of a file, leaving the other
+// properties unchanged. When appProperties is set, the properties private to this application are changed instead
+// of the public ones. A key and its value may total at most 124 bytes.
+func (ds *DriveService) SetFileProperties(ctx context.Context, fileID string, properties map[string]*string, appProperties bool) (*FileProperties, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if len(properties) == 0 {
+		return nil, errors.New("properties are empty")
+	}
+	if err := ds.checkScope(ctx, fileID); err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, fileID); err != nil {
+		return nil, err
+	}
+
+	// Removed keys are sent as JSON null, which the map itself cannot hold
+	field := "Properties"
+	if appProperties {
+		field = "AppProperties"
+	}
+	values := make(map[string]string)
+	var removed []string
+	for key, value := range properties {
+		if key == "" {
+			return nil, errors.New("property key is empty")
+		}
+		if value == nil {
+			removed = append(removed, field+"."+key)
+			continue
+		}
+		if len(key)+len(*value) > maxPropertySize {
+			return nil, fmt.Errorf("property %q is too long: a key and its value may total at most %d bytes", key, maxPropertySize)
+		}
+		values[key] = *value
+	}
+	sort.Strings(removed)
+
+	file := &drive.File{Properties: values, NullFields: removed}
+	if appProperties {
+		file = &drive.File{AppProperties: values, NullFields: removed}
+	}
+
+	updated, err := ds.driveService.Files.Update(fileID, file).
+		Fields("id, name, properties, appProperties").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to set file properties: %w", err)
+	}
+
+	return newFileProperties(updated), nil
+}
+
+// newFileProperties converts the properties of a Drive API file, with empty maps for files without any
+func newFileProperties(file *drive.File) *FileProperties {
+	result := &FileProperties{
+		FileID:        file.Id,
+		Name:          file.Name,
+		Properties:    file.Properties,
+		AppProperties: file.AppProperties,
+	}
+	if result.Properties == nil {
+		result.Properties = map[string]string{}
+	}
+	if result.AppProperties == nil {
+		result.AppProperties = map[string]string{}
+	}
+	return result
+}
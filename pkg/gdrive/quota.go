@@ -0,0 +1,56 @@
+package gdrive
+
+import (
+	"context"
+	"fmt"
+)
+
+// StorageQuota describes the storage used by the authenticated account and the limits that apply to it.
+// Sizes are in bytes.
+type StorageQuota struct {
+	EmailAddress string `json:"emailAddress"`
+	DisplayName  string `json:"displayName"`
+	// Limit is the total storage available to the account, shared with Gmail and Google Photos; it is omitted when
+	// the account has unlimited storage
+	Limit     int64 `json:"limit,omitempty"`
+	Unlimited bool  `json:"unlimited"`
+	// Usage is the storage used across all Google services, of which UsageInDrive is used by Drive files and
+	// UsageInDriveTrash by files in the trash
+	Usage             int64 `json:"usage"`
+	UsageInDrive      int64 `json:"usageInDrive"`
+	UsageInDriveTrash int64 `json:"usageInDriveTrash"`
+	// UsedPercent is Usage as a percentage of Limit, for limited accounts
+	UsedPercent float64 `json:"usedPercent,omitempty"`
+	// MaxUploadSize is the largest file the account can upload
+	MaxUploadSize int64 `json:"maxUploadSize,omitempty"`
+}
+
+// GetStorageQuota returns the storage usage and limits of the authenticated account along with its identity.
+// Files in shared drives do not count toward the quota.
+func (ds *DriveService) GetStorageQuota(ctx context.Context) (*StorageQuota, error) {
+	about, err := ds.driveService.About.Get().
+		Fields("user(displayName, emailAddress), storageQuota(limit, usage, usageInDrive, usageInDriveTrash), maxUploadSize").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage quota: %w", err)
+	}
+
+	quota := &StorageQuota{MaxUploadSize: about.MaxUploadSize}
+	if about.User != nil {
+		quota.EmailAddress = about.User.EmailAddress
+		quota.DisplayName = about.User.DisplayName
+	}
+	if about.StorageQuota != nil {
+		quota.Limit = about.StorageQuota.Limit
+		quota.Usage = about.StorageQuota.Usage
+		quota.UsageInDrive = about.StorageQuota.UsageInDrive
+		quota.UsageInDriveTrash = about.StorageQuota.UsageInDriveTrash
+	}
+	// Drive leaves the limit out for accounts without one
+	quota.Unlimited = quota.Limit == 0
+	if !quota.Unlimited {
+		quota.UsedPercent = float64(quota.Usage) * 100 / float64(quota.Limit)
+	}
+	return quota, nil
+}
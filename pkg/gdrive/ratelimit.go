@@ -0,0 +1,121 @@
+package gdrive
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/time/rate"
+)
+
+// Names of the Google APIs, used to configure per-API settings
+const (
+	APIDrive    = "drive"
+	APIDocs     = "docs"
+	APISlides   = "slides"
+	APISheets   = "sheets"
+	APILabels   = "labels"
+	APIActivity = "activity"
+)
+
+// APIs lists the names of all Google APIs used by DriveService
+var APIs = []string{APIDrive, APIDocs, APISlides, APISheets, APILabels, APIActivity}
+
+// WithRateLimit limits requests to api (one of APIs) to qps requests per second.
+// Requests beyond the budget wait for a token instead of failing. qps <= 0 removes the limit.
+func WithRateLimit(api string, qps float64) Option {
+	return func(ds *DriveService) {
+		if ds.rateLimits == nil {
+			ds.rateLimits = make(map[string]float64)
+		}
+		ds.rateLimits[api] = qps
+	}
+}
+
+// rateLimitedTransport waits for a token from its limiter before sending each request
+type rateLimitedTransport struct {
+	api     string
+	base    http.RoundTripper
+	limiter *rate.Limiter
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, fmt.Errorf("%s API rate limit: %w", t.api, err)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// newLimiter returns a token bucket allowing qps requests per second with a burst of one second's budget
+func newLimiter(qps float64) *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(qps), int(math.Max(1, math.Ceil(qps))))
+}
+
+// apiHTTPClient returns the HTTP client for api, sending requests through the shared authenticated transport
+// and enforcing the API's rate limit, call budget, and circuit breaker and tracing its requests if configured
+func (ds *DriveService) apiHTTPClient(api string) *http.Client {
+	var client http.Client
+	if ds.httpClient != nil {
+		client = *ds.httpClient
+	}
+	client.Transport = ds.auth
+
+	if ds.tracer != nil {
+		client.Transport = &tracingTransport{api: api, base: client.Transport, tracer: ds.tracer}
+	}
+	if qps := ds.rateLimits[api]; qps > 0 {
+		client.Transport = &rateLimitedTransport{api: api, base: client.Transport, limiter: newLimiter(qps)}
+	}
+	// Calls over budget fail before waiting for the rate limiter
+	if ds.budget.limited(api) {
+		client.Transport = &budgetTransport{api: api, base: client.Transport, budget: &ds.budget}
+	}
+	// Calls to an API that is down fail before anything else
+	if ds.breakerThreshold > 0 {
+		breaker := &circuitBreaker{api: api, threshold: ds.breakerThreshold, cooldown: ds.breakerCooldown}
+		client.Transport = &breakerTransport{base: client.Transport, breaker: breaker}
+	}
+	return &client
+}
+
+// RateLimits holds per-API rate limits parsed from "api=qps" flags
+type RateLimits map[string]float64
+
+// String returns the limits in "api=qps" form
+func (r RateLimits) String() string {
+	var pairs []string
+	for api, qps := range r {
+		pairs = append(pairs, api+"="+strconv.FormatFloat(qps, 'g', -1, 64))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// Set parses an "api=qps" limit
+func (r RateLimits) Set(value string) error {
+	api, limit, ok := strings.Cut(value, "=")
+	if !ok || !slices.Contains(APIs, api) {
+		return fmt.Errorf("invalid rate limit %q: expected api=qps with api one of %s", value, strings.Join(APIs, ", "))
+	}
+
+	qps, err := strconv.ParseFloat(limit, 64)
+	if err != nil {
+		return fmt.Errorf("invalid rate limit %q: %w", value, err)
+	}
+
+	r[api] = qps
+	return nil
+}
+
+// Options returns a WithRateLimit option for each configured API
+func (r RateLimits) Options() []Option {
+	var opts []Option
+	for api, qps := range r {
+		opts = append(opts, WithRateLimit(api, qps))
+	}
+	return opts
+}
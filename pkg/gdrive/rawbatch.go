@@ -0,0 +1,151 @@
+package gdrive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/slides/v1"
+)
+
+// MaxBatchUpdateRequests bounds the number of raw requests accepted in one batch update
+const MaxBatchUpdateRequests = 500
+
+// maxBatchUpdateSize bounds the total size in bytes of the raw requests in one batch update
+const maxBatchUpdateSize = 1 << 20
+
+// BatchUpdateDocument executes raw Docs API requests, each a JSON object such as {"insertText": {...}}, in one
+// batch update. Requests are validated against the API's request schema before anything is sent.
+// If requiredRevisionID is set, the update fails unless the document is still at that revision.
+func (ds *DriveService) BatchUpdateDocument(ctx context.Context, documentID string, requests []json.RawMessage, requiredRevisionID string) (*docs.BatchUpdateDocumentResponse, error) {
+	if documentID == "" {
+		return nil, errors.New("document ID is empty")
+	}
+
+	decoded, err := decodeRawRequests[docs.Request](requests)
+	if err != nil {
+		return nil, err
+	}
+
+	documentID, err = ds.resolveFileID(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, documentID); err != nil {
+		return nil, err
+	}
+	var texts []string
+	for _, request := range decoded {
+		if request.InsertText != nil {
+			texts = append(texts, request.InsertText.Text)
+		}
+		if request.ReplaceAllText != nil {
+			texts = append(texts, request.ReplaceAllText.ReplaceText)
+		}
+	}
+	if err := ds.budget.spend(BudgetCharacters, countCharacters(texts...)); err != nil {
+		return nil, err
+	}
+
+	batch := &docs.BatchUpdateDocumentRequest{Requests: decoded}
+	if requiredRevisionID != "" {
+		batch.WriteControl = &docs.WriteControl{RequiredRevisionId: requiredRevisionID}
+	}
+
+	resp, err := ds.docsService.Documents.BatchUpdate(documentID, batch).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to update document: %w", err)
+	}
+	ds.cache.invalidate(documentID)
+
+	return resp, nil
+}
+
+// BatchUpdatePresentation executes raw Slides API requests, each a JSON object such as {"createSlide": {...}}, in one
+// batch update. Requests are validated against the API's request schema before anything is sent.
+// If requiredRevisionID is set, the update fails unless the presentation is still at that revision.
+func (ds *DriveService) BatchUpdatePresentation(ctx context.Context, presentationID string, requests []json.RawMessage, requiredRevisionID string) (*slides.BatchUpdatePresentationResponse, error) {
+	if presentationID == "" {
+		return nil, errors.New("presentation ID is empty")
+	}
+
+	decoded, err := decodeRawRequests[slides.Request](requests)
+	if err != nil {
+		return nil, err
+	}
+
+	presentationID, err = ds.resolveFileID(ctx, presentationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, presentationID); err != nil {
+		return nil, err
+	}
+	var texts []string
+	for _, request := range decoded {
+		if request.InsertText != nil {
+			texts = append(texts, request.InsertText.Text)
+		}
+		if request.ReplaceAllText != nil {
+			texts = append(texts, request.ReplaceAllText.ReplaceText)
+		}
+	}
+	if err := ds.budget.spend(BudgetCharacters, countCharacters(texts...)); err != nil {
+		return nil, err
+	}
+
+	batch := &slides.BatchUpdatePresentationRequest{Requests: decoded}
+	if requiredRevisionID != "" {
+		batch.WriteControl = &slides.WriteControl{RequiredRevisionId: requiredRevisionID}
+	}
+
+	resp, err := ds.slidesService.Presentations.BatchUpdate(presentationID, batch).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to update presentation: %w", err)
+	}
+	ds.cache.invalidate(presentationID)
+
+	return resp, nil
+}
+
+// decodeRawRequests decodes raw batch update requests into T, rejecting unknown fields and
+// requests that do not set exactly one request type
+func decodeRawRequests[T any](requests []json.RawMessage) ([]*T, error) {
+	if len(requests) == 0 {
+		return nil, errors.New("no requests given")
+	}
+	if len(requests) > MaxBatchUpdateRequests {
+		return nil, fmt.Errorf("%d requests exceed the maximum of %d per batch", len(requests), MaxBatchUpdateRequests)
+	}
+
+	size := 0
+	for _, request := range requests {
+		size += len(request)
+	}
+	if size > maxBatchUpdateSize {
+		return nil, fmt.Errorf("requests total %d bytes, exceeding the maximum of %d", size, maxBatchUpdateSize)
+	}
+
+	decoded := make([]*T, len(requests))
+	for i, request := range requests {
+		var kinds map[string]json.RawMessage
+		if err := json.Unmarshal(request, &kinds); err != nil {
+			return nil, fmt.Errorf("request %d: must be a JSON object: %w", i, err)
+		}
+		if len(kinds) != 1 {
+			return nil, fmt.Errorf("request %d: must set exactly one request type, got %d", i, len(kinds))
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(request))
+		decoder.DisallowUnknownFields()
+		decoded[i] = new(T)
+		if err := decoder.Decode(decoded[i]); err != nil {
+			return nil, fmt.Errorf("request %d: %w", i, err)
+		}
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,34 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ListRecentFiles lists non-folder files most recently modified first, or most recently viewed by the user first
+// when viewed is set, in which case files the user never opened are left out. The timestamp the files are ordered
+// by is always included in the result alongside opts.Fields. The order is fixed, so opts.OrderBy must be empty.
+func (ds *DriveService) ListRecentFiles(ctx context.Context, viewed bool, opts ListOptions) (*FileList, error) {
+	if opts.OrderBy != "" {
+		return nil, errors.New("recent files are always listed most recent first; orderBy is not supported")
+	}
+
+	timeField := "modifiedTime"
+	q := fmt.Sprintf("mimeType != '%s' and trashed = false", folderMimeType)
+	if viewed {
+		timeField = "viewedByMeTime"
+		q += " and viewedByMeTime > '1970-01-01T00:00:00Z'"
+	}
+	opts.OrderBy = timeField + " desc"
+	if !slices.Contains(opts.Fields, timeField) {
+		opts.Fields = append(opts.Fields[:len(opts.Fields):len(opts.Fields)], timeField)
+	}
+
+	list, err := ds.listFiles(ctx, ds.driveService.Files.List().Q(q), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recent files: %w", err)
+	}
+	return list, nil
+}
@@ -0,0 +1,259 @@
+package gdrive
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// DefaultRevisionPageSize is the number of revisions ListRevisions returns per page when ListOptions sets no page size
+const DefaultRevisionPageSize = 100
+
+// revisionFields are the revision fields read for Revision
+const revisionFields = "id, mimeType, modifiedTime, lastModifyingUser(displayName, emailAddress), size, keepForever, originalFilename"
+
+// Revision is a saved version of a file
+type Revision struct {
+	ID             string    `json:"id"`
+	MimeType       string    `json:"mimeType"`
+	ModifiedTime   string    `json:"modifiedTime"`
+	LastModifiedBy *FileUser `json:"lastModifiedBy,omitempty"`
+	// Size is the content size in bytes; revisions of Google Workspace files have none
+	Size int64 `json:"size,omitempty"`
+	// KeepForever is set when the revision is kept instead of being purged 30 days after a newer one. Binary files only.
+	KeepForever      bool   `json:"keepForever,omitempty"`
+	OriginalFilename string `json:"originalFilename,omitempty"`
+}
+
+// RevisionList is a page of revisions, oldest first
+type RevisionList struct {
+	Revisions []Revision `json:"revisions"`
+	// NextPageToken fetches the next page; empty on the last page
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// ListRevisions lists the saved versions of a file, oldest first. Drive merges the revisions of Google Docs, Sheets,
+// and Slides made close together, so fewer are listed than the version history shows. opts.OrderBy and opts.Fields
+// are not supported. For a shortcut, the revisions of its target are listed.
+func (ds *DriveService) ListRevisions(ctx context.Context, fileID string, opts ListOptions) (*RevisionList, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if opts.OrderBy != "" || len(opts.Fields) > 0 {
+		return nil, errors.New("orderBy and fields are not supported for revisions")
+	}
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := ds.driveService.Revisions.List(fileID).
+		PageSize(int64(opts.pageSize(DefaultRevisionPageSize))).
+		PageToken(opts.PageToken).
+		Fields("nextPageToken, revisions(" + revisionFields + ")").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	list := &RevisionList{Revisions: make([]Revision, 0, len(r.Revisions)), NextPageToken: r.NextPageToken}
+	for _, revision := range r.Revisions {
+		list.Revisions = append(list.Revisions, newRevision(revision))
+	}
+	return list, nil
+}
+
+// revisionTextFormats are the formats revisions of Google Workspace files are exported as unless another is asked
+// for, chosen so that revisions can be compared as text
+var revisionTextFormats = map[string]string{
+	documentMimeType:     "txt",
+	spreadsheetMimeType:  "csv",
+	presentationMimeType: "txt",
+	drawingMimeType:      "svg",
+}
+
+// RevisionContent is the content of a file at one of its revisions
+type RevisionContent struct {
+	FileID     string `json:"fileId"`
+	RevisionID string `json:"revisionId"`
+	MimeType   string `json:"mimeType"`
+	Size       int64  `json:"size"`
+	// Text holds textual content, such as exported documents, as is
+	Text string `json:"text,omitempty"`
+	// Content holds any other content, encoded as base64 in JSON
+	Content []byte `json:"content,omitempty"`
+}
+
+// GetRevisionContent returns the content of a file as it was at a revision, without changing the file. Revisions of
+// Google Docs, Sheets, Slides, and Drawings are exported to format, given as a file name extension as for ExportFile;
+// an empty format exports them as plain text, CSV (the first sheet only), or SVG so they can be compared as text.
+// Binary revisions are returned as they are, and format must be empty. Content is limited to 10MB.
+func (ds *DriveService) GetRevisionContent(ctx context.Context, fileID, revisionID, format string) (*RevisionContent, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if revisionID == "" {
+		return nil, errors.New("revision ID is empty")
+	}
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Get(fileID).
+		Fields("id, mimeType").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	result := &RevisionContent{FileID: file.Id, RevisionID: revisionID}
+	var data []byte
+	if strings.HasPrefix(file.MimeType, "application/vnd.google-apps.") {
+		_, mimeType, err := exportTarget(file.MimeType, file.Id, format, revisionTextFormats)
+		if err != nil {
+			return nil, err
+		}
+		if data, err = ds.exportRevision(ctx, file.Id, revisionID, mimeType); err != nil {
+			return nil, err
+		}
+		result.MimeType = mimeType
+	} else {
+		if format != "" {
+			return nil, fmt.Errorf("%s is not a Google Workspace file, so its revisions are returned as is and cannot be exported as %s", fileID, format)
+		}
+		resp, err := ds.driveService.Revisions.Get(file.Id, revisionID).
+			Context(ctx).
+			Download()
+		if err != nil {
+			return nil, fmt.Errorf("failed to download revision: %w", err)
+		}
+		defer resp.Body.Close()
+
+		data, err = io.ReadAll(io.LimitReader(resp.Body, maxExportSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read revision: %w", err)
+		}
+		if len(data) > maxExportSize {
+			return nil, fmt.Errorf("revision exceeds the maximum of %d bytes", maxExportSize)
+		}
+		result.MimeType = resp.Header.Get("Content-Type")
+	}
+
+	result.Size = int64(len(data))
+	if isTextMimeType(result.MimeType) && utf8.Valid(data) {
+		// Plain text exports start with a byte order mark
+		result.Text = strings.TrimPrefix(string(data), "\ufeff")
+	} else {
+		result.Content = data
+	}
+	return result, nil
+}
+
+// isTextMimeType reports whether content of mimeType is text
+func isTextMimeType(mimeType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(mimeType)
+	return strings.HasPrefix(mediaType, "text/") || mediaType == "image/svg+xml" || mediaType == "application/json"
+}
+
+// restoredFileFields are the extra fields returned for a file restored to a revision
+var restoredFileFields = []string{"modifiedTime", "headRevisionId"}
+
+// RestoreRevision overwrites the content of a file with that of one of its revisions, e.g. to undo an edit.
+// Google Docs, Sheets, and Slides revisions are exported to .docx, .xlsx, or .pptx (up to 10MB) and imported back,
+// so formatting those formats cannot hold may be lost; other Workspace files cannot be restored. The replaced content
+// becomes a revision itself, so a restore can be undone the same way. For a shortcut, its target is restored.
+func (ds *DriveService) RestoreRevision(ctx context.Context, fileID, revisionID string) (*DriveFile, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if revisionID == "" {
+		return nil, errors.New("revision ID is empty")
+	}
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, fileID); err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Get(fileID).
+		Fields("id, name, mimeType").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	var content io.Reader
+	var contentType string
+	if officeType, ok := officeTypeForGoogle[file.MimeType]; ok {
+		// Workspace revisions can only be exported; uploading the export to the file converts it back
+		data, err := ds.exportRevision(ctx, file.Id, revisionID, officeType)
+		if err != nil {
+			return nil, err
+		}
+		content, contentType = bytes.NewReader(data), officeType
+	} else if strings.HasPrefix(file.MimeType, "application/vnd.google-apps.") {
+		return nil, fmt.Errorf("revisions of %s files cannot be restored", file.MimeType)
+	} else {
+		resp, err := ds.driveService.Revisions.Get(file.Id, revisionID).
+			Context(ctx).
+			Download()
+		if err != nil {
+			return nil, fmt.Errorf("failed to download revision: %w", err)
+		}
+		defer resp.Body.Close()
+		content, contentType = resp.Body, resp.Header.Get("Content-Type")
+		if contentType == "" {
+			contentType = file.MimeType
+		}
+	}
+
+	fields, err := fileFields(restoredFileFields)
+	if err != nil {
+		return nil, err
+	}
+
+	restored, err := ds.driveService.Files.Update(file.Id, &drive.File{}).
+		Media(content, googleapi.ContentType(contentType)).
+		Fields(googleapi.Field(fields)).
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore revision: %w", err)
+	}
+
+	driveFile, err := newDriveFile(restored, restoredFileFields)
+	if err != nil {
+		return nil, err
+	}
+	return &driveFile, nil
+}
+
+// newRevision converts a Drive API revision
+func newRevision(r *drive.Revision) Revision {
+	return Revision{
+		ID:               r.Id,
+		MimeType:         r.MimeType,
+		ModifiedTime:     r.ModifiedTime,
+		LastModifiedBy:   newFileUser(r.LastModifyingUser),
+		Size:             r.Size,
+		KeepForever:      r.KeepForever,
+		OriginalFilename: r.OriginalFilename,
+	}
+}
@@ -0,0 +1,246 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	// scopeCacheTTL bounds how long a folder is remembered as inside or outside the root folder,
+	// so folders moved while the server runs are re-checked
+	scopeCacheTTL = time.Minute
+	// maxScopedListPages bounds the pages read to fill a listing when files outside the root folder are skipped
+	maxScopedListPages = 10
+)
+
+// ErrOutsideRootFolder is returned for files outside the folder the server is confined to
+var ErrOutsideRootFolder = errors.New("file is outside the root folder")
+
+// WithRootFolder confines every operation to folderID and the files below it. Files elsewhere are rejected with
+// ErrOutsideRootFolder, and searches skip them. folderID may also be a folder URL. Empty allows all of Drive.
+func WithRootFolder(folderID string) Option {
+	return func(ds *DriveService) {
+		ds.rootFolder = ResolveFileID(folderID)
+	}
+}
+
+// scopeCache remembers whether folders are inside the root folder
+type scopeCache struct {
+	mu      sync.Mutex
+	entries map[string]scopeEntry
+}
+
+type scopeEntry struct {
+	inside  bool
+	expires time.Time
+}
+
+func (c *scopeCache) get(folderID string) (inside, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[folderID]
+	if !ok || time.Now().After(entry.expires) {
+		return false, false
+	}
+	return entry.inside, true
+}
+
+func (c *scopeCache) put(folderID string, inside bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil || len(c.entries) >= maxShortcutCacheEntries {
+		c.entries = make(map[string]scopeEntry)
+	}
+	c.entries[folderID] = scopeEntry{inside: inside, expires: time.Now().Add(scopeCacheTTL)}
+}
+
+// folderOrRoot returns folderID, or the root folder if folderID is empty and the server is confined to one
+func (ds *DriveService) folderOrRoot(folderID string) string {
+	if folderID == "" {
+		return ds.rootFolder
+	}
+	return folderID
+}
+
+// rootFolderID returns the canonical ID of the root folder, looking it up once so that aliases such as "root"
+// compare equal to the IDs in files' parents
+func (ds *DriveService) rootFolderID(ctx context.Context) (string, error) {
+	ds.rootMu.Lock()
+	defer ds.rootMu.Unlock()
+	if ds.rootID != "" {
+		return ds.rootID, nil
+	}
+
+	folder, err := ds.driveService.Files.Get(ds.rootFolder).
+		Fields("id, mimeType").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return "", fmt.Errorf("failed to get root folder: %w", err)
+	}
+	if folder.MimeType != folderMimeType {
+		return "", fmt.Errorf("root folder %s is not a folder", ds.rootFolder)
+	}
+	ds.rootID = folder.Id
+	return ds.rootID, nil
+}
+
+// checkScope returns an error wrapping ErrOutsideRootFolder unless every non-empty file ID is the root folder
+// or below it. Without a root folder, every file is allowed.
+func (ds *DriveService) checkScope(ctx context.Context, fileIDs ...string) error {
+	if ds.rootFolder == "" {
+		return nil
+	}
+
+	for _, fileID := range fileIDs {
+		if fileID == "" {
+			continue
+		}
+
+		file, err := ds.driveService.Files.Get(fileID).
+			Fields("id, parents").
+			SupportsAllDrives(true).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return fmt.Errorf("failed to get file: %w", err)
+		}
+
+		inside, err := ds.inRootFolder(ctx, file.Id, file.Parents)
+		if err != nil {
+			return err
+		}
+		if !inside {
+			return fmt.Errorf("%s: %w", fileID, ErrOutsideRootFolder)
+		}
+	}
+	return nil
+}
+
+// inRootFolder reports whether the file with the given parents is the root folder or below it
+func (ds *DriveService) inRootFolder(ctx context.Context, fileID string, parents []string) (bool, error) {
+	rootID, err := ds.rootFolderID(ctx)
+	if err != nil {
+		return false, err
+	}
+	if fileID == rootID {
+		return true, nil
+	}
+
+	for _, parentID := range parents {
+		inside, err := ds.folderInRoot(ctx, rootID, parentID, 0)
+		if err != nil || inside {
+			return inside, err
+		}
+	}
+	return false, nil
+}
+
+// folderInRoot walks up from folderID, through every parent, to find rootID
+func (ds *DriveService) folderInRoot(ctx context.Context, rootID, folderID string, depth int) (bool, error) {
+	if folderID == rootID {
+		return true, nil
+	}
+	if depth >= maxFolderDepth {
+		return false, nil
+	}
+	if inside, ok := ds.scope.get(folderID); ok {
+		return inside, nil
+	}
+
+	folder, err := ds.driveService.Files.Get(folderID).
+		Fields("id, parents").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return false, fmt.Errorf("failed to get folder %s: %w", folderID, err)
+	}
+
+	inside := false
+	for _, parentID := range folder.Parents {
+		inside, err = ds.folderInRoot(ctx, rootID, parentID, depth+1)
+		if err != nil {
+			return false, err
+		}
+		if inside {
+			break
+		}
+	}
+	ds.scope.put(folderID, inside)
+	return inside, nil
+}
+
+// listFiles runs a file listing and returns a page of files with the default and extra fields. Files in shared drives
+// are included, or only those of opts.DriveID when set. When the server is confined to a root folder, files outside
+// it are skipped and further pages are read to make up for them.
+func (ds *DriveService) listFiles(ctx context.Context, call *drive.FilesListCall, opts ListOptions) (*FileList, error) {
+	pageSize := opts.pageSize(DefaultPageSize)
+	if opts.PageToken != "" {
+		call = call.PageToken(opts.PageToken)
+	}
+	if opts.OrderBy != "" {
+		if err := checkFileOrderBy(opts.OrderBy); err != nil {
+			return nil, err
+		}
+		call = call.OrderBy(opts.OrderBy)
+	}
+	call = call.SupportsAllDrives(true).IncludeItemsFromAllDrives(true)
+	if opts.DriveID != "" {
+		call = call.Corpora("drive").DriveId(opts.DriveID)
+	} else {
+		call = call.Corpora("allDrives")
+	}
+
+	// Parents are needed to check each file, but are only returned if requested
+	fields := opts.Fields
+	if ds.rootFolder != "" {
+		fields = append(fields[:len(fields):len(fields)], "parents")
+	}
+	mask, err := fileFieldsMask(fields)
+	if err != nil {
+		return nil, err
+	}
+	call = call.Fields(googleapi.Field("nextPageToken, " + mask))
+
+	list := &FileList{}
+	for page := 0; page < maxScopedListPages && len(list.Files) < pageSize; page++ {
+		// Ask for no more than is missing, so a page ends where the next one starts
+		r, err := call.PageSize(int64(pageSize - len(list.Files))).Context(ctx).Do()
+		if err != nil {
+			return nil, err
+		}
+		list.NextPageToken = r.NextPageToken
+
+		for _, file := range r.Files {
+			if ds.rootFolder != "" {
+				inside, err := ds.inRootFolder(ctx, file.Id, file.Parents)
+				if err != nil {
+					return nil, err
+				}
+				if !inside {
+					continue
+				}
+			}
+			driveFile, err := newDriveFile(file, opts.Fields)
+			if err != nil {
+				return nil, err
+			}
+			list.Files = append(list.Files, driveFile)
+		}
+		if ds.rootFolder == "" || r.NextPageToken == "" {
+			break
+		}
+		call = call.PageToken(r.NextPageToken)
+	}
+	return list, nil
+}
@@ -0,0 +1,77 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SearchFilter narrows a file search with structured conditions; zero fields match any file
+type SearchFilter struct {
+	// MimeType matches files of exactly this MIME type, e.g. "application/vnd.google-apps.spreadsheet"
+	MimeType string
+	// ModifiedAfter matches files last modified at or after this time
+	ModifiedAfter time.Time
+	// ModifiedBefore matches files last modified before this time
+	ModifiedBefore time.Time
+	// Owner matches files owned by this email address, or by the signed-in user with "me"
+	Owner string
+	// FolderID matches files directly inside this folder
+	FolderID string
+	// Trashed matches files in the trash when true, or outside it when false; nil matches both
+	Trashed *bool
+	// Starred matches starred files when true, or unstarred files when false; nil matches both
+	Starred *bool
+}
+
+// clauses returns the Drive query clauses for the filter
+func (f SearchFilter) clauses() ([]string, error) {
+	if !f.ModifiedAfter.IsZero() && !f.ModifiedBefore.IsZero() && !f.ModifiedBefore.After(f.ModifiedAfter) {
+		return nil, errors.New("modifiedBefore must be after modifiedAfter")
+	}
+
+	var clauses []string
+	if f.MimeType != "" {
+		clauses = append(clauses, "mimeType = "+quoteQuery(f.MimeType))
+	}
+	if !f.ModifiedAfter.IsZero() {
+		clauses = append(clauses, fmt.Sprintf("modifiedTime >= '%s'", f.ModifiedAfter.UTC().Format(time.RFC3339)))
+	}
+	if !f.ModifiedBefore.IsZero() {
+		clauses = append(clauses, fmt.Sprintf("modifiedTime < '%s'", f.ModifiedBefore.UTC().Format(time.RFC3339)))
+	}
+	if f.Owner != "" {
+		clauses = append(clauses, quoteQuery(f.Owner)+" in owners")
+	}
+	if f.FolderID != "" {
+		clauses = append(clauses, quoteQuery(f.FolderID)+" in parents")
+	}
+	if f.Trashed != nil {
+		clauses = append(clauses, fmt.Sprintf("trashed = %t", *f.Trashed))
+	}
+	if f.Starred != nil {
+		clauses = append(clauses, fmt.Sprintf("starred = %t", *f.Starred))
+	}
+	return clauses, nil
+}
+
+// searchQuery composes the Drive query matching textClause, if any, and the filter, after checking that the
+// filter's folder is within scope
+func (ds *DriveService) searchQuery(ctx context.Context, textClause string, filter SearchFilter) (string, error) {
+	if err := ds.checkScope(ctx, filter.FolderID); err != nil {
+		return "", err
+	}
+	clauses, err := filter.clauses()
+	if err != nil {
+		return "", err
+	}
+	if textClause != "" {
+		clauses = append([]string{textClause}, clauses...)
+	}
+	if len(clauses) == 0 {
+		return "", errors.New("search query and filters are empty")
+	}
+	return strings.Join(clauses, " and "), nil
+}
@@ -0,0 +1,90 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// ShareRequest describes the access ShareFile grants
+type ShareRequest struct {
+	// Role is reader, commenter, or writer
+	Role string
+	// Type is user, group, domain, or anyone, the latter sharing with anyone who has the link
+	Type string
+	// EmailAddress is the user or group to share with
+	EmailAddress string
+	// Domain is the domain to share with, e.g. "example.com"
+	Domain string
+	// SendNotificationEmail emails the user or group that the file was shared with them
+	SendNotificationEmail bool
+	// EmailMessage is added to the notification email
+	EmailMessage string
+}
+
+// shareRoles are the roles ShareFile grants; ownership and shared drive roles are left to the Drive UI
+var shareRoles = []string{"reader", "commenter", "writer"}
+
+// ShareFile grants a user, group, domain, or anyone with the link access to a file. Notification emails can only
+// be sent to users and groups. For a shortcut, its target is shared.
+func (ds *DriveService) ShareFile(ctx context.Context, fileID string, share ShareRequest) (*Permission, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if !slices.Contains(shareRoles, share.Role) {
+		return nil, fmt.Errorf("invalid role %q: expected reader, commenter, or writer", share.Role)
+	}
+
+	permission := &drive.Permission{Type: share.Type, Role: share.Role}
+	switch share.Type {
+	case "user", "group":
+		if share.EmailAddress == "" {
+			return nil, fmt.Errorf("an email address is required to share with a %s", share.Type)
+		}
+		permission.EmailAddress = share.EmailAddress
+	case "domain":
+		if share.Domain == "" {
+			return nil, errors.New("a domain is required to share with a domain")
+		}
+		permission.Domain = share.Domain
+	case "anyone":
+	default:
+		return nil, fmt.Errorf("invalid type %q: expected user, group, domain, or anyone", share.Type)
+	}
+	notify := share.Type == "user" || share.Type == "group"
+	if !notify && (share.SendNotificationEmail || share.EmailMessage != "") {
+		return nil, fmt.Errorf("notification emails can only be sent when sharing with a user or group, not %s", share.Type)
+	}
+	if share.EmailMessage != "" && !share.SendNotificationEmail {
+		return nil, errors.New("an email message needs the notification email to be sent")
+	}
+
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, fileID); err != nil {
+		return nil, err
+	}
+
+	call := ds.driveService.Permissions.Create(fileID, permission).
+		SupportsAllDrives(true).
+		Fields(permissionFields).
+		Context(ctx)
+	if notify {
+		call.SendNotificationEmail(share.SendNotificationEmail)
+		if share.EmailMessage != "" {
+			call.EmailMessage(share.EmailMessage)
+		}
+	}
+	created, err := call.Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to share file: %w", err)
+	}
+
+	result := newPermission(created)
+	return &result, nil
+}
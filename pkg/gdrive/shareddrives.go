@@ -0,0 +1,64 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// SharedDrive is a shared drive the user is a member of
+type SharedDrive struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	CreatedTime string `json:"createdTime,omitempty"`
+	// Hidden is set when the user hid the drive from the default view
+	Hidden bool `json:"hidden,omitempty"`
+	// CanAddChildren reports whether the user can add files to the drive
+	CanAddChildren bool `json:"canAddChildren"`
+}
+
+// SharedDriveList is a page of shared drives
+type SharedDriveList struct {
+	Drives []SharedDrive `json:"drives"`
+	// NextPageToken fetches the next page; empty on the last page
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// ListSharedDrives lists the shared drives the user is a member of. Their IDs can be passed as ListOptions.DriveID
+// to search or list one drive. opts.OrderBy and opts.Fields are not supported. The drives lie outside any root
+// folder, so listing them is refused when the server is confined to one.
+func (ds *DriveService) ListSharedDrives(ctx context.Context, opts ListOptions) (*SharedDriveList, error) {
+	if ds.rootFolder != "" {
+		return nil, errors.New("shared drives cannot be listed when the server is confined to a root folder")
+	}
+	if opts.OrderBy != "" || len(opts.Fields) > 0 {
+		return nil, errors.New("orderBy and fields are not supported for shared drives")
+	}
+
+	r, err := ds.driveService.Drives.List().
+		PageSize(int64(opts.pageSize(DefaultPageSize))).
+		PageToken(opts.PageToken).
+		Fields("nextPageToken, drives(id, name, createdTime, hidden, capabilities(canAddChildren))").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shared drives: %w", err)
+	}
+
+	list := &SharedDriveList{Drives: make([]SharedDrive, 0, len(r.Drives)), NextPageToken: r.NextPageToken}
+	for _, d := range r.Drives {
+		list.Drives = append(list.Drives, newSharedDrive(d))
+	}
+	return list, nil
+}
+
+// newSharedDrive converts a Drive API shared drive
+func newSharedDrive(d *drive.Drive) SharedDrive {
+	sharedDrive := SharedDrive{ID: d.Id, Name: d.Name, CreatedTime: d.CreatedTime, Hidden: d.Hidden}
+	if d.Capabilities != nil {
+		sharedDrive.CanAddChildren = d.Capabilities.CanAddChildren
+	}
+	return sharedDrive
+}
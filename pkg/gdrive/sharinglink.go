@@ -0,0 +1,84 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// SharingLink is the link to a file, with who can open it
+type SharingLink struct {
+	FileID      string `json:"fileId"`
+	Name        string `json:"name"`
+	WebViewLink string `json:"webViewLink"`
+	// AnyoneRole is the role granted to anyone with the link, empty when only people given access can open it
+	AnyoneRole string `json:"anyoneRole,omitempty"`
+}
+
+// GetSharingLink returns the link to open a file in the browser. When anyoneRole is set, anyone with the link is
+// first given that role, replacing the role link sharing had; an empty anyoneRole leaves access unchanged.
+// For a shortcut, the link to its target is returned.
+func (ds *DriveService) GetSharingLink(ctx context.Context, fileID, anyoneRole string) (*SharingLink, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if anyoneRole != "" && !slices.Contains(shareRoles, anyoneRole) {
+		return nil, fmt.Errorf("invalid role %q: expected reader, commenter, or writer", anyoneRole)
+	}
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Get(fileID).
+		Fields("id, name, webViewLink").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+	permissions, err := ds.listPermissions(ctx, file.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	link := &SharingLink{FileID: file.Id, Name: file.Name, WebViewLink: file.WebViewLink}
+	var anyone *Permission
+	for i, p := range permissions {
+		if p.Type == "anyone" {
+			anyone = &permissions[i]
+			link.AnyoneRole = p.Role
+			break
+		}
+	}
+	if anyoneRole == "" || anyoneRole == link.AnyoneRole {
+		return link, nil
+	}
+
+	if err := ds.checkWrite(ctx, file.Id); err != nil {
+		return nil, err
+	}
+	if anyone == nil {
+		_, err = ds.driveService.Permissions.Create(file.Id, &drive.Permission{Type: "anyone", Role: anyoneRole}).
+			SupportsAllDrives(true).
+			Fields("id").
+			Context(ctx).
+			Do()
+	} else {
+		_, err = ds.driveService.Permissions.Update(file.Id, anyone.ID, &drive.Permission{Role: anyoneRole}).
+			SupportsAllDrives(true).
+			Fields("id").
+			Context(ctx).
+			Do()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to enable link sharing: %w", err)
+	}
+	link.AnyoneRole = anyoneRole
+
+	return link, nil
+}
@@ -0,0 +1,153 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// SpreadsheetAudit reports who can change a spreadsheet: its protected ranges and its share permissions
+type SpreadsheetAudit struct {
+	SpreadsheetID string             `json:"spreadsheetId"`
+	Title         string             `json:"title"`
+	Sheets        []SheetProtections `json:"sheets"`
+	Permissions   []Permission       `json:"permissions"`
+}
+
+// SheetProtections lists the protected ranges of one sheet
+type SheetProtections struct {
+	SheetID int64  `json:"sheetId"`
+	Title   string `json:"title"`
+	// Protected is set when the whole sheet is protected
+	Protected       bool             `json:"protected"`
+	ProtectedRanges []ProtectedRange `json:"protectedRanges,omitempty"`
+}
+
+// ProtectedRange is a protected range or sheet and who may edit it
+type ProtectedRange struct {
+	ID int64 `json:"id"`
+	// Range is the protected A1 range; for a protected sheet it is the sheet itself
+	Range        string `json:"range"`
+	NamedRangeID string `json:"namedRangeId,omitempty"`
+	Description  string `json:"description,omitempty"`
+	// WarningOnly is set when edits are allowed after a warning rather than restricted
+	WarningOnly bool `json:"warningOnly"`
+	// UnprotectedRanges are exceptions within a protected sheet
+	UnprotectedRanges []string `json:"unprotectedRanges,omitempty"`
+	// EditorUsers, EditorGroups, and DomainUsersCanEdit are only known when the caller can edit the range
+	EditorUsers        []string `json:"editorUsers,omitempty"`
+	EditorGroups       []string `json:"editorGroups,omitempty"`
+	DomainUsersCanEdit bool     `json:"domainUsersCanEdit,omitempty"`
+	// CanEdit reports whether the server's account may edit the range
+	CanEdit bool `json:"canEdit"`
+}
+
+// AuditSpreadsheetProtection reports the protected ranges of every sheet in a spreadsheet along with
+// the spreadsheet's share permissions, for reviewing who can change which data
+func (ds *DriveService) AuditSpreadsheetProtection(ctx context.Context, spreadsheetID string) (*SpreadsheetAudit, error) {
+	if spreadsheetID == "" {
+		return nil, errors.New("spreadsheet ID is empty")
+	}
+
+	spreadsheetID, err := ds.resolveFileID(ctx, spreadsheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the protections and permissions concurrently
+	var (
+		spreadsheet *sheets.Spreadsheet
+		permissions []Permission
+	)
+	err = forEachConcurrent(ctx, ds.parallelism, 2, func(ctx context.Context, i int) error {
+		var err error
+		if i == 0 {
+			spreadsheet, err = ds.sheetsService.Spreadsheets.Get(spreadsheetID).
+				Fields("properties(title),sheets(properties(sheetId,title),protectedRanges)").
+				Context(ctx).
+				Do()
+			if err != nil {
+				return fmt.Errorf("failed to get spreadsheet: %w", err)
+			}
+			return nil
+		}
+		permissions, err = ds.listPermissions(ctx, spreadsheetID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit := &SpreadsheetAudit{
+		SpreadsheetID: spreadsheetID,
+		Sheets:        []SheetProtections{},
+		Permissions:   permissions,
+	}
+	if spreadsheet.Properties != nil {
+		audit.Title = spreadsheet.Properties.Title
+	}
+
+	for _, sheet := range spreadsheet.Sheets {
+		protections := SheetProtections{
+			SheetID: sheet.Properties.SheetId,
+			Title:   sheet.Properties.Title,
+		}
+		for _, p := range sheet.ProtectedRanges {
+			protected := ProtectedRange{
+				ID:           p.ProtectedRangeId,
+				Range:        gridRangeA1(sheet.Properties.Title, p.Range),
+				NamedRangeID: p.NamedRangeId,
+				Description:  p.Description,
+				WarningOnly:  p.WarningOnly,
+				CanEdit:      p.RequestingUserCanEdit,
+			}
+			if isWholeSheet(p.Range) && p.NamedRangeId == "" {
+				protections.Protected = true
+			}
+			for _, r := range p.UnprotectedRanges {
+				protected.UnprotectedRanges = append(protected.UnprotectedRanges, gridRangeA1(sheet.Properties.Title, r))
+			}
+			if p.Editors != nil {
+				protected.EditorUsers = p.Editors.Users
+				protected.EditorGroups = p.Editors.Groups
+				protected.DomainUsersCanEdit = p.Editors.DomainUsersCanEdit
+			}
+			protections.ProtectedRanges = append(protections.ProtectedRanges, protected)
+		}
+		audit.Sheets = append(audit.Sheets, protections)
+	}
+
+	return audit, nil
+}
+
+// isWholeSheet reports whether a grid range covers its entire sheet
+func isWholeSheet(r *sheets.GridRange) bool {
+	return r != nil && r.StartRowIndex == 0 && r.EndRowIndex == 0 && r.StartColumnIndex == 0 && r.EndColumnIndex == 0
+}
+
+// gridRangeA1 formats a grid range on the sheet titled title in A1 notation. Unbounded sides are
+// omitted, e.g. "'Sheet1'!A:C" for whole columns and "'Sheet1'" for the whole sheet.
+func gridRangeA1(title string, r *sheets.GridRange) string {
+	sheet := quoteSheetName(title)
+	if r == nil || isWholeSheet(r) {
+		return sheet
+	}
+
+	var start, end string
+	if r.StartColumnIndex > 0 || r.EndColumnIndex > 0 {
+		start = columnName(int(r.StartColumnIndex))
+		if r.EndColumnIndex > 0 {
+			end = columnName(int(r.EndColumnIndex - 1))
+		}
+	}
+	if r.StartRowIndex > 0 || r.EndRowIndex > 0 {
+		start += strconv.FormatInt(r.StartRowIndex+1, 10)
+		if r.EndRowIndex > 0 {
+			end += strconv.FormatInt(r.EndRowIndex, 10)
+		}
+	}
+	return sheet + "!" + start + ":" + end
+}
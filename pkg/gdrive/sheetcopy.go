@@ -0,0 +1,178 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// Modes of CopySpreadsheetRange
+const (
+	// CopyAll copies values and formatting
+	CopyAll = "all"
+	// CopyValues copies values only, keeping the destination's formatting
+	CopyValues = "values"
+	// CopyFormats copies formatting only, keeping the destination's values
+	CopyFormats = "formats"
+)
+
+// SpreadsheetRange is an A1 range in a Google Spreadsheet
+type SpreadsheetRange struct {
+	SpreadsheetID string `json:"spreadsheetId"`
+	// Range is an A1 range such as "Sheet1!A1:C10"
+	Range string `json:"range"`
+}
+
+// SpreadsheetRangeCopy reports the result of CopySpreadsheetRange
+type SpreadsheetRangeCopy struct {
+	Source SpreadsheetRange `json:"source"`
+	// Destination is the range written to, starting at the top-left cell of the requested destination range
+	Destination SpreadsheetRange `json:"destination"`
+	Mode        string           `json:"mode"`
+	Rows        int              `json:"rows"`
+	Columns     int              `json:"columns"`
+}
+
+// CopySpreadsheetRange copies a range of one spreadsheet into another, or into another place in the same spreadsheet.
+// The source is written starting at the top-left cell of the destination range. Formulas are copied as their
+// computed values, since references rarely hold across spreadsheets. mode is CopyAll, CopyValues, or CopyFormats.
+func (ds *DriveService) CopySpreadsheetRange(ctx context.Context, source, destination SpreadsheetRange, mode string) (*SpreadsheetRangeCopy, error) {
+	if source.SpreadsheetID == "" || destination.SpreadsheetID == "" {
+		return nil, errors.New("spreadsheet ID is empty")
+	}
+	if source.Range == "" || destination.Range == "" {
+		return nil, errors.New("range name is empty")
+	}
+
+	var fields string
+	switch mode {
+	case CopyAll:
+		fields = "userEnteredValue,userEnteredFormat"
+	case CopyValues:
+		fields = "userEnteredValue"
+	case CopyFormats:
+		fields = "userEnteredFormat"
+	default:
+		return nil, fmt.Errorf("invalid copy mode %q: must be %s, %s, or %s", mode, CopyAll, CopyValues, CopyFormats)
+	}
+
+	var err error
+	source.SpreadsheetID, err = ds.resolveFileID(ctx, source.SpreadsheetID)
+	if err != nil {
+		return nil, err
+	}
+	destination.SpreadsheetID, err = ds.resolveFileID(ctx, destination.SpreadsheetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, destination.SpreadsheetID); err != nil {
+		return nil, err
+	}
+
+	src, err := ds.sheetsService.Spreadsheets.Get(source.SpreadsheetID).
+		Ranges(source.Range).
+		IncludeGridData(true).
+		Fields("sheets(data(rowData(values(effectiveValue,formattedValue,userEnteredFormat))))").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source range: %w", err)
+	}
+
+	// Only the destination's sheet and start cell are needed; the field mask keeps its cell data out of the response
+	dst, err := ds.sheetsService.Spreadsheets.Get(destination.SpreadsheetID).
+		Ranges(destination.Range).
+		IncludeGridData(true).
+		Fields("sheets(properties(sheetId,title),data(startRow,startColumn))").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get destination range: %w", err)
+	}
+	if len(src.Sheets) == 0 || len(src.Sheets[0].Data) == 0 {
+		return nil, fmt.Errorf("source range %s not found", source.Range)
+	}
+	if len(dst.Sheets) == 0 || dst.Sheets[0].Properties == nil {
+		return nil, fmt.Errorf("destination range %s not found", destination.Range)
+	}
+
+	var rows []*sheets.RowData
+	columns := 0
+	for _, rowData := range src.Sheets[0].Data[0].RowData {
+		row := &sheets.RowData{Values: make([]*sheets.CellData, len(rowData.Values))}
+		for i, cell := range rowData.Values {
+			row.Values[i] = &sheets.CellData{
+				UserEnteredValue:  copiedValue(cell),
+				UserEnteredFormat: cell.UserEnteredFormat,
+			}
+		}
+		columns = max(columns, len(row.Values))
+		rows = append(rows, row)
+	}
+
+	result := &SpreadsheetRangeCopy{
+		Source:  source,
+		Mode:    mode,
+		Rows:    len(rows),
+		Columns: columns,
+	}
+	result.Destination.SpreadsheetID = destination.SpreadsheetID
+	if len(rows) == 0 {
+		// Nothing to copy; report the destination as requested
+		result.Destination.Range = destination.Range
+		return result, nil
+	}
+
+	var cells int64
+	for _, row := range rows {
+		cells += int64(len(row.Values))
+	}
+	if err := ds.budget.spend(BudgetCells, cells); err != nil {
+		return nil, err
+	}
+
+	sheet := dst.Sheets[0].Properties
+	var startRow, startColumn int64
+	if len(dst.Sheets[0].Data) > 0 {
+		startRow, startColumn = dst.Sheets[0].Data[0].StartRow, dst.Sheets[0].Data[0].StartColumn
+	}
+
+	_, err = ds.sheetsService.Spreadsheets.BatchUpdate(destination.SpreadsheetID, &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{{
+			UpdateCells: &sheets.UpdateCellsRequest{
+				Start: &sheets.GridCoordinate{
+					SheetId:         sheet.SheetId,
+					RowIndex:        startRow,
+					ColumnIndex:     startColumn,
+					ForceSendFields: []string{"SheetId", "RowIndex", "ColumnIndex"},
+				},
+				Rows:   rows,
+				Fields: fields,
+			},
+		}},
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to write destination range: %w", err)
+	}
+	ds.cache.invalidate(destination.SpreadsheetID)
+
+	result.Destination.Range = fmt.Sprintf("%s!%s%d:%s%d", quoteSheetName(sheet.Title),
+		columnName(int(startColumn)), startRow+1, columnName(int(startColumn)+columns-1), int(startRow)+len(rows))
+	return result, nil
+}
+
+// copiedValue returns the value to write for a copied cell: its computed value, or its displayed text for errors
+func copiedValue(cell *sheets.CellData) *sheets.ExtendedValue {
+	value := cell.EffectiveValue
+	if value == nil {
+		return nil
+	}
+	if value.ErrorValue != nil {
+		// Error values cannot be written; keep what the source displays, such as "#REF!"
+		text := cell.FormattedValue
+		return &sheets.ExtendedValue{StringValue: &text}
+	}
+	return value
+}
@@ -0,0 +1,255 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// SheetTableLocator identifies a table region on a sheet by its header row
+type SheetTableLocator struct {
+	// Sheet is the title of the sheet to search for Header; ignored when MetadataKey is set
+	Sheet string
+	// Header is the text of a header cell of the table, e.g. its first column's name. The first cell on the sheet
+	// with this text (case-insensitive) anchors the table: its row is the header row and the table starts at its column.
+	Header string
+	// MetadataKey is the key of developer metadata attached to the table's header row
+	MetadataKey string
+}
+
+// SheetAppendResult reports where rows were appended to a sheet table
+type SheetAppendResult struct {
+	Sheet string `json:"sheet"`
+	// HeaderRange is the A1 range of the table's header row
+	HeaderRange string `json:"headerRange"`
+	// UpdatedRange is the A1 range the rows were written to
+	UpdatedRange string `json:"updatedRange"`
+	RowsAppended int    `json:"rowsAppended"`
+	// DataRows is the number of rows below the header after appending
+	DataRows int `json:"dataRows"`
+}
+
+// AppendSheetTableRows appends rows directly below the last non-empty row of a table on a sheet, rather than
+// below the sheet's last row, so several tables can share a sheet. The table spans the header row's contiguous
+// non-empty cells and ends at its first empty row. Cells below the table are shifted down to make room.
+func (ds *DriveService) AppendSheetTableRows(ctx context.Context, spreadsheetID string, locator SheetTableLocator, rows [][]interface{}) (*SheetAppendResult, error) {
+	if spreadsheetID == "" {
+		return nil, errors.New("spreadsheet ID is empty")
+	}
+	if locator.Header == "" && locator.MetadataKey == "" {
+		return nil, errors.New("either a header or a metadata key is required to locate the table")
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("no rows to append")
+	}
+
+	spreadsheetID, err := ds.resolveFileID(ctx, spreadsheetID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, spreadsheetID); err != nil {
+		return nil, err
+	}
+	if err := ds.budget.spend(BudgetCells, countCells(rows)); err != nil {
+		return nil, err
+	}
+
+	spreadsheet, err := ds.sheetsService.Spreadsheets.Get(spreadsheetID).
+		Fields("sheets(properties(sheetId,title))").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spreadsheet: %w", err)
+	}
+
+	// Find the sheet, and the header row if it is marked with metadata
+	var sheet *sheets.SheetProperties
+	headerRow := -1
+	if locator.MetadataKey != "" {
+		var sheetID int64
+		sheetID, headerRow, err = ds.metadataRow(ctx, spreadsheetID, locator.MetadataKey)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range spreadsheet.Sheets {
+			if s.Properties.SheetId == sheetID {
+				sheet = s.Properties
+			}
+		}
+		if sheet == nil {
+			return nil, fmt.Errorf("sheet %d with metadata %q not found", sheetID, locator.MetadataKey)
+		}
+	} else {
+		for _, s := range spreadsheet.Sheets {
+			if locator.Sheet == "" || s.Properties.Title == locator.Sheet {
+				sheet = s.Properties
+				break
+			}
+		}
+	}
+	if sheet == nil {
+		return nil, fmt.Errorf("sheet %q not found", locator.Sheet)
+	}
+
+	resp, err := ds.sheetsService.Spreadsheets.Values.Get(spreadsheetID, quoteSheetName(sheet.Title)).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spreadsheet values: %w", err)
+	}
+	values := resp.Values
+
+	// Find the header cell the table starts at
+	headerColumn := -1
+	if headerRow >= 0 {
+		if headerRow < len(values) {
+			for col, cell := range values[headerRow] {
+				if cellText(cell) != "" {
+					headerColumn = col
+					break
+				}
+			}
+		}
+		if headerColumn < 0 {
+			return nil, fmt.Errorf("header row %d marked by %q is empty", headerRow+1, locator.MetadataKey)
+		}
+	} else {
+		headerRow, headerColumn = findCell(values, locator.Header)
+		if headerRow < 0 {
+			return nil, fmt.Errorf("no cell with text %q found on sheet %q", locator.Header, sheet.Title)
+		}
+	}
+
+	width := 0
+	for headerColumn+width < len(values[headerRow]) && cellText(values[headerRow][headerColumn+width]) != "" {
+		width++
+	}
+	for i, row := range rows {
+		if len(row) > width {
+			return nil, fmt.Errorf("row %d has %d values but the table has %d columns", i, len(row), width)
+		}
+	}
+
+	// The table ends at its first empty row
+	end := headerRow + 1
+	for end < len(values) && rowHasValues(values[end], headerColumn, headerColumn+width) {
+		end++
+	}
+
+	// Shift anything below the table down instead of overwriting it
+	below := false
+	for _, row := range values[end:] {
+		if rowHasValues(row, headerColumn, headerColumn+width) {
+			below = true
+			break
+		}
+	}
+	if below {
+		_, err = ds.sheetsService.Spreadsheets.BatchUpdate(spreadsheetID, &sheets.BatchUpdateSpreadsheetRequest{
+			Requests: []*sheets.Request{{
+				InsertRange: &sheets.InsertRangeRequest{
+					Range: &sheets.GridRange{
+						SheetId:          sheet.SheetId,
+						StartRowIndex:    int64(end),
+						EndRowIndex:      int64(end + len(rows)),
+						StartColumnIndex: int64(headerColumn),
+						EndColumnIndex:   int64(headerColumn + width),
+						ForceSendFields:  []string{"SheetId", "StartRowIndex", "StartColumnIndex"},
+					},
+					ShiftDimension: "ROWS",
+				},
+			}},
+		}).Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert rows: %w", err)
+		}
+		ds.cache.invalidate(spreadsheetID)
+	}
+
+	updatedRange := fmt.Sprintf("%s!%s%d:%s%d", quoteSheetName(sheet.Title),
+		columnName(headerColumn), end+1, columnName(headerColumn+width-1), end+len(rows))
+	_, err = ds.sheetsService.Spreadsheets.Values.Update(spreadsheetID, updatedRange, &sheets.ValueRange{Values: rows}).
+		ValueInputOption("USER_ENTERED").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to update spreadsheet values: %w", err)
+	}
+	ds.cache.invalidate(spreadsheetID)
+
+	return &SheetAppendResult{
+		Sheet:        sheet.Title,
+		HeaderRange:  fmt.Sprintf("%s!%s%d:%s%d", quoteSheetName(sheet.Title), columnName(headerColumn), headerRow+1, columnName(headerColumn+width-1), headerRow+1),
+		UpdatedRange: updatedRange,
+		RowsAppended: len(rows),
+		DataRows:     end - headerRow - 1 + len(rows),
+	}, nil
+}
+
+// metadataRow returns the sheet and 0-based row of the row range the developer metadata key is attached to
+func (ds *DriveService) metadataRow(ctx context.Context, spreadsheetID, key string) (int64, int, error) {
+	resp, err := ds.sheetsService.Spreadsheets.DeveloperMetadata.Search(spreadsheetID, &sheets.SearchDeveloperMetadataRequest{
+		DataFilters: []*sheets.DataFilter{{
+			DeveloperMetadataLookup: &sheets.DeveloperMetadataLookup{MetadataKey: key},
+		}},
+	}).Context(ctx).Do()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to search developer metadata: %w", err)
+	}
+
+	for _, match := range resp.MatchedDeveloperMetadata {
+		location := match.DeveloperMetadata.Location
+		if location != nil && location.DimensionRange != nil && location.DimensionRange.Dimension == "ROWS" {
+			return location.DimensionRange.SheetId, int(location.DimensionRange.StartIndex), nil
+		}
+	}
+	return 0, 0, fmt.Errorf("no developer metadata %q attached to a row found", key)
+}
+
+// findCell returns the 0-based row and column of the first cell whose text is text, ignoring case, or -1, -1
+func findCell(values [][]interface{}, text string) (int, int) {
+	text = strings.TrimSpace(text)
+	for r, row := range values {
+		for c, cell := range row {
+			if strings.EqualFold(cellText(cell), text) {
+				return r, c
+			}
+		}
+	}
+	return -1, -1
+}
+
+// rowHasValues reports whether row has a non-empty cell in columns [start, end)
+func rowHasValues(row []interface{}, start, end int) bool {
+	for c := start; c < end && c < len(row); c++ {
+		if cellText(row[c]) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// cellText returns a cell value as trimmed text
+func cellText(cell interface{}) string {
+	if cell == nil {
+		return ""
+	}
+	return strings.TrimSpace(fmt.Sprint(cell))
+}
+
+// quoteSheetName quotes a sheet title for use in A1 notation
+func quoteSheetName(title string) string {
+	return "'" + strings.ReplaceAll(title, "'", "''") + "'"
+}
+
+// columnName returns the A1 name of a 0-based column index, e.g. 0 is "A" and 27 is "AB"
+func columnName(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+	return name
+}
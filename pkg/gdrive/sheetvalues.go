@@ -0,0 +1,147 @@
+package gdrive
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// Value render options of SpreadsheetValuesOptions
+const (
+	// ValueFormatted renders values as they are displayed in the sheet
+	ValueFormatted = "FORMATTED_VALUE"
+	// ValueUnformatted renders values as plain numbers, strings, and booleans
+	ValueUnformatted = "UNFORMATTED_VALUE"
+	// ValueFormula renders formulas instead of their results
+	ValueFormula = "FORMULA"
+)
+
+// Date and time render options of SpreadsheetValuesOptions
+const (
+	// DateTimeSerialNumber renders dates and times as serial numbers: days since 1899-12-30, with the time as a fraction
+	DateTimeSerialNumber = "SERIAL_NUMBER"
+	// DateTimeFormattedString renders dates and times as displayed in the sheet, in its locale's format
+	DateTimeFormattedString = "FORMATTED_STRING"
+	// DateTimeISO8601 renders dates and times in ISO 8601, e.g. "2024-06-10", "13:45:00", or "2024-06-10T13:45:00".
+	// Spreadsheets have no time zone per cell, so the values carry no offset.
+	DateTimeISO8601 = "ISO8601"
+)
+
+// SpreadsheetValuesOptions controls how GetSpreadsheetValues renders cells. Empty options use the API defaults:
+// formatted values, and serial numbers for dates when values are unformatted.
+type SpreadsheetValuesOptions struct {
+	// ValueRenderOption is ValueFormatted, ValueUnformatted, or ValueFormula
+	ValueRenderOption string
+	// DateTimeRenderOption is DateTimeSerialNumber, DateTimeFormattedString, or DateTimeISO8601.
+	// The API ignores it for formatted values, but DateTimeISO8601 applies to every render option.
+	DateTimeRenderOption string
+}
+
+// validate checks the options against the supported values
+func (o SpreadsheetValuesOptions) validate() error {
+	switch o.ValueRenderOption {
+	case "", ValueFormatted, ValueUnformatted, ValueFormula:
+	default:
+		return fmt.Errorf("invalid value render option %q", o.ValueRenderOption)
+	}
+	switch o.DateTimeRenderOption {
+	case "", DateTimeSerialNumber, DateTimeFormattedString, DateTimeISO8601:
+	default:
+		return fmt.Errorf("invalid date time render option %q", o.DateTimeRenderOption)
+	}
+	return nil
+}
+
+// sheetsEpoch is day zero of spreadsheet serial dates
+var sheetsEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// SerialToTime converts a spreadsheet date/time serial number to a time, rounded to the second.
+// Spreadsheet times have no time zone; the result is in UTC so its fields match the sheet's wall-clock time.
+func SerialToTime(serial float64) time.Time {
+	return sheetsEpoch.Add(time.Duration(math.Round(serial*86400)) * time.Second)
+}
+
+// formatSerial formats a serial number in ISO 8601 according to the cell's number format type
+func formatSerial(serial float64, formatType string) string {
+	t := SerialToTime(serial)
+	switch formatType {
+	case "DATE":
+		return t.Format(time.DateOnly)
+	case "TIME":
+		return t.Format(time.TimeOnly)
+	default:
+		return t.Format("2006-01-02T15:04:05")
+	}
+}
+
+// isoSpreadsheetValues reads a range cell by cell, so dates and times can be recognized by their
+// number format and rendered in ISO 8601. Other cells are rendered as valueRender asks.
+func (ds *DriveService) isoSpreadsheetValues(ctx context.Context, spreadsheetID, rangeName, valueRender string) ([][]interface{}, error) {
+	resp, err := ds.sheetsService.Spreadsheets.Get(spreadsheetID).
+		Ranges(rangeName).
+		IncludeGridData(true).
+		Fields("sheets(data(rowData(values(userEnteredValue(formulaValue),effectiveValue,formattedValue,effectiveFormat(numberFormat(type))))))").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spreadsheet values: %w", err)
+	}
+	if len(resp.Sheets) == 0 || len(resp.Sheets[0].Data) == 0 {
+		return nil, nil
+	}
+
+	values := [][]interface{}{}
+	for _, rowData := range resp.Sheets[0].Data[0].RowData {
+		row := make([]interface{}, len(rowData.Values))
+		last := -1
+		for i, cell := range rowData.Values {
+			row[i] = cellValue(cell, valueRender)
+			if row[i] != "" {
+				last = i
+			}
+		}
+		values = append(values, row[:last+1])
+	}
+
+	// Like the values API, leave out trailing empty rows
+	for len(values) > 0 && len(values[len(values)-1]) == 0 {
+		values = values[:len(values)-1]
+	}
+	return values, nil
+}
+
+// cellValue renders a cell like the values API would for valueRender, but with dates and times in ISO 8601
+func cellValue(cell *sheets.CellData, valueRender string) interface{} {
+	if valueRender == ValueFormula && cell.UserEnteredValue != nil && cell.UserEnteredValue.FormulaValue != nil {
+		return *cell.UserEnteredValue.FormulaValue
+	}
+
+	value := cell.EffectiveValue
+	if value == nil {
+		return ""
+	}
+	if value.NumberValue != nil && cell.EffectiveFormat != nil && cell.EffectiveFormat.NumberFormat != nil {
+		switch formatType := cell.EffectiveFormat.NumberFormat.Type; formatType {
+		case "DATE", "TIME", "DATE_TIME":
+			return formatSerial(*value.NumberValue, formatType)
+		}
+	}
+
+	if valueRender == "" || valueRender == ValueFormatted {
+		return cell.FormattedValue
+	}
+	switch {
+	case value.NumberValue != nil:
+		return *value.NumberValue
+	case value.BoolValue != nil:
+		return *value.BoolValue
+	case value.StringValue != nil:
+		return *value.StringValue
+	default:
+		// Errors render as displayed, e.g. "#DIV/0!"
+		return cell.FormattedValue
+	}
+}
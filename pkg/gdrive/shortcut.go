@@ -0,0 +1,189 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// createdShortcutFields are the extra fields returned for a created shortcut, so callers can see what it points to
+var createdShortcutFields = []string{"webViewLink", "parents", "shortcutDetails(targetId, targetMimeType)"}
+
+// maxShortcutCacheEntries bounds the shortcut resolution cache; it is cleared when full
+const maxShortcutCacheEntries = 1024
+
+// WithShortcutResolution sets whether file IDs of shortcuts passed to content reads and updates are replaced by
+// the ID of the shortcut's target. Enabled by default.
+func WithShortcutResolution(enabled bool) Option {
+	return func(ds *DriveService) {
+		ds.resolveShortcuts = enabled
+	}
+}
+
+// shortcutCache remembers what each file ID resolved to. A shortcut's target cannot change, so entries never go stale.
+type shortcutCache struct {
+	mu      sync.Mutex
+	targets map[string]string
+}
+
+func (c *shortcutCache) get(fileID string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	target, ok := c.targets[fileID]
+	return target, ok
+}
+
+func (c *shortcutCache) put(fileID, target string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.targets == nil || len(c.targets) >= maxShortcutCacheEntries {
+		c.targets = make(map[string]string)
+	}
+	c.targets[fileID] = target
+}
+
+// ShortcutInfo describes a file and, if it is a shortcut, the file it points to
+type ShortcutInfo struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	MimeType   string `json:"mimeType"`
+	IsShortcut bool   `json:"isShortcut"`
+	// TargetID and TargetMimeType identify the shortcut's target; for other files they repeat the file's own
+	TargetID       string `json:"targetId"`
+	TargetMimeType string `json:"targetMimeType"`
+}
+
+// ResolveShortcut reports whether a file is a shortcut and which file it points to
+func (ds *DriveService) ResolveShortcut(ctx context.Context, fileID string) (*ShortcutInfo, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if err := ds.checkScope(ctx, fileID); err != nil {
+		return nil, err
+	}
+
+	return ds.resolveShortcut(ctx, fileID)
+}
+
+// CreateShortcut creates a shortcut to targetID inside folderID. An empty name uses the target's name, and an empty
+// folderID creates it in My Drive root, or in the root folder the server is confined to; when an output folder is
+// set, the shortcut is created there instead. A shortcut to a shortcut points to the latter's target.
+func (ds *DriveService) CreateShortcut(ctx context.Context, targetID, name, folderID string) (*DriveFile, error) {
+	if targetID == "" {
+		return nil, errors.New("target ID is empty")
+	}
+	if err := ds.checkScope(ctx, targetID, folderID); err != nil {
+		return nil, err
+	}
+
+	target, err := ds.resolveShortcut(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = target.Name
+	}
+
+	var parents []string
+	if parent := ds.folderOrRoot(folderID); parent != "" {
+		parents = []string{parent}
+	}
+	if parents, err = ds.outputParents(ctx, parents); err != nil {
+		return nil, err
+	}
+	writeTo := parents
+	if len(writeTo) == 0 {
+		writeTo = []string{"root"}
+	}
+	if err := ds.checkWrite(ctx, writeTo...); err != nil {
+		return nil, err
+	}
+
+	fields, err := fileFields(createdShortcutFields)
+	if err != nil {
+		return nil, err
+	}
+
+	shortcut := &drive.File{
+		Name:            name,
+		MimeType:        shortcutMimeType,
+		Parents:         parents,
+		ShortcutDetails: &drive.FileShortcutDetails{TargetId: target.TargetID},
+	}
+	created, err := ds.driveService.Files.Create(shortcut).
+		Fields(googleapi.Field(fields)).
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create shortcut: %w", err)
+	}
+
+	driveFile, err := newDriveFile(created, createdShortcutFields)
+	if err != nil {
+		return nil, err
+	}
+	return &driveFile, nil
+}
+
+// resolveShortcut looks up a file and the target it points to if it is a shortcut
+func (ds *DriveService) resolveShortcut(ctx context.Context, fileID string) (*ShortcutInfo, error) {
+
+	file, err := ds.driveService.Files.Get(fileID).
+		Fields("id, name, mimeType, shortcutDetails(targetId, targetMimeType)").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	info := &ShortcutInfo{
+		ID:             file.Id,
+		Name:           file.Name,
+		MimeType:       file.MimeType,
+		TargetID:       file.Id,
+		TargetMimeType: file.MimeType,
+	}
+	if file.MimeType == shortcutMimeType && file.ShortcutDetails != nil {
+		info.IsShortcut = true
+		info.TargetID = file.ShortcutDetails.TargetId
+		info.TargetMimeType = file.ShortcutDetails.TargetMimeType
+	}
+	ds.shortcuts.put(fileID, info.TargetID)
+
+	return info, nil
+}
+
+// resolveFileID returns the target's ID if fileID is a shortcut and shortcut resolution is enabled, or fileID otherwise.
+// If the file cannot be looked up, fileID is returned unchanged so that the caller reports the error.
+// It fails if the resolved file is outside the root folder the server is confined to.
+func (ds *DriveService) resolveFileID(ctx context.Context, fileID string) (string, error) {
+	resolved := ds.resolveShortcutID(ctx, fileID)
+	if err := ds.checkScope(ctx, resolved); err != nil {
+		return "", err
+	}
+	return resolved, nil
+}
+
+// resolveShortcutID returns the target's ID if fileID is a shortcut and shortcut resolution is enabled, or fileID otherwise
+func (ds *DriveService) resolveShortcutID(ctx context.Context, fileID string) string {
+	if !ds.resolveShortcuts || fileID == "" {
+		return fileID
+	}
+	if target, ok := ds.shortcuts.get(fileID); ok {
+		return target
+	}
+
+	info, err := ds.resolveShortcut(ctx, fileID)
+	if err != nil {
+		return fileID
+	}
+	return info.TargetID
+}
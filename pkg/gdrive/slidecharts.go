@@ -0,0 +1,104 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+)
+
+// LinkedChart is a chart on a slide linked to a Google Sheets chart
+type LinkedChart struct {
+	ObjectID      string `json:"objectId"`
+	SlideIndex    int    `json:"slideIndex"`
+	SpreadsheetID string `json:"spreadsheetId"`
+	ChartID       int64  `json:"chartId"`
+}
+
+// RefreshSheetsCharts updates linked Sheets charts in a presentation with the current spreadsheet data and returns them.
+// If objectIDs is empty, all linked charts are refreshed, including those in groups.
+func (ds *DriveService) RefreshSheetsCharts(ctx context.Context, presentationID string, objectIDs []string) ([]LinkedChart, error) {
+	if presentationID == "" {
+		return nil, errors.New("presentation ID is empty")
+	}
+
+	presentationID, err := ds.resolveFileID(ctx, presentationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, presentationID); err != nil {
+		return nil, err
+	}
+
+	presentation, err := ds.slidesService.Presentations.Get(presentationID).
+		Fields("slides(pageElements(objectId,sheetsChart(spreadsheetId,chartId),elementGroup(children)))").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get presentation: %w", err)
+	}
+
+	wanted := make(map[string]bool, len(objectIDs))
+	for _, id := range objectIDs {
+		wanted[id] = true
+	}
+
+	charts := []LinkedChart{}
+	for i, slide := range presentation.Slides {
+		for _, element := range sheetsChartElements(slide.PageElements) {
+			if len(wanted) > 0 && !wanted[element.ObjectId] {
+				continue
+			}
+			delete(wanted, element.ObjectId)
+			charts = append(charts, LinkedChart{
+				ObjectID:      element.ObjectId,
+				SlideIndex:    i,
+				SpreadsheetID: element.SheetsChart.SpreadsheetId,
+				ChartID:       element.SheetsChart.ChartId,
+			})
+		}
+	}
+	if len(objectIDs) > 0 && len(wanted) > 0 {
+		var missing []string
+		for _, id := range objectIDs {
+			if wanted[id] {
+				missing = append(missing, id)
+			}
+		}
+		return nil, fmt.Errorf("no linked Sheets chart found with object ID %s", strings.Join(missing, ", "))
+	}
+	if len(charts) == 0 {
+		return charts, nil
+	}
+
+	requests := make([]*slides.Request, len(charts))
+	for i, chart := range charts {
+		requests[i] = &slides.Request{RefreshSheetsChart: &slides.RefreshSheetsChartRequest{ObjectId: chart.ObjectID}}
+	}
+
+	_, err = ds.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: requests,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh charts: %w", err)
+	}
+	ds.cache.invalidate(presentationID)
+
+	return charts, nil
+}
+
+// sheetsChartElements returns the linked Sheets charts among elements, descending into groups
+func sheetsChartElements(elements []*slides.PageElement) []*slides.PageElement {
+	var charts []*slides.PageElement
+	for _, element := range elements {
+		switch {
+		case element.SheetsChart != nil:
+			charts = append(charts, element)
+		case element.ElementGroup != nil:
+			charts = append(charts, sheetsChartElements(element.ElementGroup.Children)...)
+		}
+	}
+	return charts
+}
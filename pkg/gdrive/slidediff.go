@@ -0,0 +1,142 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Slide change kinds
+const (
+	SlideAdded   = "added"
+	SlideRemoved = "removed"
+	SlideChanged = "changed"
+)
+
+// SlideChange is a slide added, removed, or with changed text between two revisions of a presentation
+type SlideChange struct {
+	Change string `json:"change"`
+	// BaseIndex and OtherIndex are the slide's 0-based positions in each revision, if it exists there
+	BaseIndex  *int `json:"baseIndex,omitempty"`
+	OtherIndex *int `json:"otherIndex,omitempty"`
+	// Title is the first line of the slide's text
+	Title string `json:"title,omitempty"`
+	// Diff is a unified diff of the slide's text, for changed slides
+	Diff string `json:"diff,omitempty"`
+}
+
+// PresentationDiff reports the slide changes between two revisions of a presentation
+type PresentationDiff struct {
+	PresentationID  string `json:"presentationId"`
+	BaseRevisionID  string `json:"baseRevisionId"`
+	OtherRevisionID string `json:"otherRevisionId,omitempty"`
+	BaseSlides      int    `json:"baseSlides"`
+	OtherSlides     int    `json:"otherSlides"`
+	// Unchanged is the number of slides whose text is the same in both revisions
+	Unchanged int           `json:"unchanged"`
+	Changes   []SlideChange `json:"changes"`
+}
+
+// DiffPresentationRevisions compares the slides of two revisions of a presentation by their text.
+// An empty otherRevisionID compares against the current content. Slides are matched by text in order,
+// so a slide whose text changed appears as changed when it stays between the same unchanged slides.
+func (ds *DriveService) DiffPresentationRevisions(ctx context.Context, presentationID, baseRevisionID, otherRevisionID string) (*PresentationDiff, error) {
+	if presentationID == "" {
+		return nil, errors.New("presentation ID is empty")
+	}
+	if baseRevisionID == "" {
+		return nil, errors.New("base revision ID is empty")
+	}
+	if baseRevisionID == otherRevisionID {
+		return nil, fmt.Errorf("both sides refer to revision %s", baseRevisionID)
+	}
+
+	presentationID, err := ds.resolveFileID(ctx, presentationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Export both revisions concurrently
+	revisionIDs := []string{baseRevisionID, otherRevisionID}
+	slideTexts := make([][]string, len(revisionIDs))
+	err = forEachConcurrent(ctx, ds.parallelism, len(revisionIDs), func(ctx context.Context, i int) error {
+		var data []byte
+		var err error
+		if revisionIDs[i] == "" {
+			data, err = ds.exportFile(ctx, presentationID, pptxMimeType)
+		} else {
+			data, err = ds.exportRevision(ctx, presentationID, revisionIDs[i], pptxMimeType)
+		}
+		if err != nil {
+			return err
+		}
+		slideTexts[i], err = pptxSlideTexts(data)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	base, other := slideTexts[0], slideTexts[1]
+
+	diff := &PresentationDiff{
+		PresentationID:  presentationID,
+		BaseRevisionID:  baseRevisionID,
+		OtherRevisionID: otherRevisionID,
+		BaseSlides:      len(base),
+		OtherSlides:     len(other),
+		Changes:         []SlideChange{},
+	}
+
+	// Pair up the removed and added slides between two unchanged slides as changed slides
+	var removed, added []int
+	flush := func() {
+		for i := 0; i < max(len(removed), len(added)); i++ {
+			switch {
+			case i < len(removed) && i < len(added):
+				b, o := removed[i], added[i]
+				hunks := diffHunks(diffLines(splitLines(base[b]), splitLines(other[o])), 1)
+				diff.Changes = append(diff.Changes, SlideChange{
+					Change:     SlideChanged,
+					BaseIndex:  &b,
+					OtherIndex: &o,
+					Title:      slideTitle(other[o]),
+					Diff:       unifiedDiff(fmt.Sprintf("slide %d", b+1), fmt.Sprintf("slide %d", o+1), hunks),
+				})
+			case i < len(removed):
+				b := removed[i]
+				diff.Changes = append(diff.Changes, SlideChange{Change: SlideRemoved, BaseIndex: &b, Title: slideTitle(base[b])})
+			default:
+				o := added[i]
+				diff.Changes = append(diff.Changes, SlideChange{Change: SlideAdded, OtherIndex: &o, Title: slideTitle(other[o])})
+			}
+		}
+		removed, added = nil, nil
+	}
+
+	baseIndex, otherIndex := 0, 0
+	for _, op := range diffLines(base, other) {
+		switch op.Op {
+		case DiffEqual:
+			flush()
+			diff.Unchanged++
+			baseIndex++
+			otherIndex++
+		case DiffDelete:
+			removed = append(removed, baseIndex)
+			baseIndex++
+		case DiffInsert:
+			added = append(added, otherIndex)
+			otherIndex++
+		}
+	}
+	flush()
+
+	return diff, nil
+}
+
+// slideTitle returns the first line of a slide's text
+func slideTitle(text string) string {
+	title, _, _ := strings.Cut(text, "\n")
+	return truncateRunes(title, maxElementTextPreview)
+}
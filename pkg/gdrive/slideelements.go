@@ -0,0 +1,221 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+)
+
+// maxElementTextPreview is the number of characters of a shape's text shown when listing elements
+const maxElementTextPreview = 100
+
+// SlideElement is the position and size of a top-level element on a slide. Lengths are in points,
+// measured from the top-left corner of the slide; the size includes the element's scaling.
+type SlideElement struct {
+	ObjectID string `json:"objectId"`
+	// Type is the element kind, e.g. "TEXT_BOX", "RECTANGLE", "IMAGE", "TABLE", or "GROUP"
+	Type   string  `json:"type"`
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	// Text is the start of the element's text, for shapes
+	Text string `json:"text,omitempty"`
+}
+
+// SlideElementUpdate holds the new position and size of a slide element in points. Nil fields are left unchanged.
+type SlideElementUpdate struct {
+	X      *float64
+	Y      *float64
+	Width  *float64
+	Height *float64
+}
+
+const slideElementFields = "slides(objectId,pageElements(objectId,size,transform,shape(shapeType,text(textElements(textRun(content)))),image(contentUrl),table(rows),line(lineType),video(id),sheetsChart(chartId),elementGroup(children(objectId)),wordArt(renderedText)))"
+
+// GetSlideElements lists the top-level elements on a slide with their position and size
+func (ds *DriveService) GetSlideElements(ctx context.Context, presentationID string, slideIndex int) ([]SlideElement, error) {
+	if presentationID == "" {
+		return nil, errors.New("presentation ID is empty")
+	}
+
+	presentationID, err := ds.resolveFileID(ctx, presentationID)
+	if err != nil {
+		return nil, err
+	}
+
+	presentation, err := ds.slidesService.Presentations.Get(presentationID).
+		Fields(slideElementFields).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get presentation: %w", err)
+	}
+
+	if slideIndex < 0 || slideIndex >= len(presentation.Slides) {
+		return nil, fmt.Errorf("slide index %d is out of range (0-%d)", slideIndex, len(presentation.Slides)-1)
+	}
+
+	var elements []SlideElement
+	for _, element := range presentation.Slides[slideIndex].PageElements {
+		elements = append(elements, newSlideElement(element))
+	}
+
+	return elements, nil
+}
+
+// UpdateSlideElement moves and resizes a top-level element on any slide of a presentation and returns its new geometry.
+// Resizing changes the element's scale, so text and images are stretched like dragging a handle in the editor.
+func (ds *DriveService) UpdateSlideElement(ctx context.Context, presentationID, objectID string, update SlideElementUpdate) (*SlideElement, error) {
+	if presentationID == "" {
+		return nil, errors.New("presentation ID is empty")
+	}
+	if objectID == "" {
+		return nil, errors.New("object ID is empty")
+	}
+
+	presentationID, err := ds.resolveFileID(ctx, presentationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, presentationID); err != nil {
+		return nil, err
+	}
+
+	presentation, err := ds.slidesService.Presentations.Get(presentationID).
+		Fields(slideElementFields).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get presentation: %w", err)
+	}
+
+	var element *slides.PageElement
+	for _, slide := range presentation.Slides {
+		for _, e := range slide.PageElements {
+			if e.ObjectId == objectID {
+				element = e
+			}
+		}
+	}
+	if element == nil {
+		return nil, fmt.Errorf("no top-level element %s found on the slides", objectID)
+	}
+
+	// Work in points, starting from the element's current transform
+	current := newSlideElement(element)
+	transform := &slides.AffineTransform{ScaleX: 1, ScaleY: 1, Unit: "PT"}
+	if t := element.Transform; t != nil {
+		transform.ScaleX, transform.ScaleY = t.ScaleX, t.ScaleY
+		transform.ShearX, transform.ShearY = t.ShearX, t.ShearY
+		transform.TranslateX = toPoints(t.TranslateX, t.Unit)
+		transform.TranslateY = toPoints(t.TranslateY, t.Unit)
+	}
+
+	if update.X != nil {
+		transform.TranslateX = *update.X
+	}
+	if update.Y != nil {
+		transform.TranslateY = *update.Y
+	}
+	if update.Width != nil || update.Height != nil {
+		// Scale maps directly to size only without rotation or shear
+		if transform.ShearX != 0 || transform.ShearY != 0 {
+			return nil, fmt.Errorf("cannot resize %s because it is rotated or skewed", objectID)
+		}
+		width, height := elementSize(element)
+		if update.Width != nil {
+			if *update.Width <= 0 || width == 0 {
+				return nil, fmt.Errorf("cannot set width of %s to %g", objectID, *update.Width)
+			}
+			transform.ScaleX = *update.Width / width
+		}
+		if update.Height != nil {
+			if *update.Height <= 0 || height == 0 {
+				return nil, fmt.Errorf("cannot set height of %s to %g", objectID, *update.Height)
+			}
+			transform.ScaleY = *update.Height / height
+		}
+	}
+	transform.ForceSendFields = []string{"ScaleX", "ScaleY", "ShearX", "ShearY", "TranslateX", "TranslateY"}
+
+	_, err = ds.slidesService.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: []*slides.Request{{
+			UpdatePageElementTransform: &slides.UpdatePageElementTransformRequest{
+				ObjectId:  objectID,
+				ApplyMode: "ABSOLUTE",
+				Transform: transform,
+			},
+		}},
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to update element: %w", err)
+	}
+	ds.cache.invalidate(presentationID)
+
+	element.Transform = transform
+	updated := newSlideElement(element)
+	updated.Text = current.Text
+	return &updated, nil
+}
+
+// newSlideElement describes a page element read with slideElementFields
+func newSlideElement(element *slides.PageElement) SlideElement {
+	e := SlideElement{ObjectID: element.ObjectId}
+	e.Width, e.Height = displayedSize(element)
+	if t := element.Transform; t != nil {
+		e.X = toPoints(t.TranslateX, t.Unit)
+		e.Y = toPoints(t.TranslateY, t.Unit)
+	}
+
+	switch {
+	case element.Shape != nil:
+		e.Type = element.Shape.ShapeType
+		e.Text = truncateRunes(strings.TrimSpace(shapeText(element.Shape)), maxElementTextPreview)
+	case element.Image != nil:
+		e.Type = "IMAGE"
+	case element.Table != nil:
+		e.Type = "TABLE"
+	case element.Line != nil:
+		e.Type = "LINE"
+	case element.Video != nil:
+		e.Type = "VIDEO"
+	case element.SheetsChart != nil:
+		e.Type = "SHEETS_CHART"
+	case element.ElementGroup != nil:
+		e.Type = "GROUP"
+	case element.WordArt != nil:
+		e.Type = "WORD_ART"
+		e.Text = element.WordArt.RenderedText
+	default:
+		e.Type = "OTHER"
+	}
+
+	return e
+}
+
+// elementSize returns the unscaled size of a page element in points
+func elementSize(element *slides.PageElement) (width, height float64) {
+	if element.Size == nil {
+		return 0, 0
+	}
+	if element.Size.Width != nil {
+		width = toPoints(element.Size.Width.Magnitude, element.Size.Width.Unit)
+	}
+	if element.Size.Height != nil {
+		height = toPoints(element.Size.Height.Magnitude, element.Size.Height.Unit)
+	}
+	return width, height
+}
+
+// truncateRunes shortens s to at most n characters, marking the cut with an ellipsis
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "…"
+}
@@ -0,0 +1,267 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+)
+
+// SkippedElement is a slide element that could not be carried over to a re-themed presentation
+type SkippedElement struct {
+	SlideIndex int    `json:"slideIndex"`
+	ObjectID   string `json:"objectId"`
+	Type       string `json:"type"`
+}
+
+// ThemedPresentation is a copy of a presentation rebuilt on a template's theme
+type ThemedPresentation struct {
+	PresentationID string `json:"presentationId"`
+	Name           string `json:"name"`
+	Slides         int    `json:"slides"`
+	// Skipped lists the elements that were not copied, such as tables and charts
+	Skipped []SkippedElement `json:"skipped,omitempty"`
+}
+
+const themeSourceFields = "title,slides(objectId,slideProperties(layoutObjectId),pageElements(objectId,size,transform,shape(shapeType,placeholder(type,index),text(textElements(textRun(content)))),image(contentUrl),table(rows),line(lineType),video(id),sheetsChart(chartId),elementGroup(children(objectId)),wordArt(renderedText))),layouts(objectId,layoutProperties(name,displayName))"
+
+const themeTargetFields = "slides(objectId),layouts(objectId,layoutProperties(name,displayName),pageElements(shape(placeholder(type,index))))"
+
+// ApplyPresentationTemplate creates a copy of a template presentation and rebuilds the slides of a presentation in it,
+// so they take on the template's theme, masters, and layouts. Each slide uses the template layout with the same name
+// as its original layout; text in placeholders moves to the matching placeholders, so it is styled by the template.
+// Other text boxes, shapes, and images are recreated with their position and size, without their original styling.
+// Tables, charts, videos, lines, groups, and word art are skipped and reported. The original presentation is not changed.
+func (ds *DriveService) ApplyPresentationTemplate(ctx context.Context, presentationID, templateID, name, folderID string) (*ThemedPresentation, error) {
+	if presentationID == "" {
+		return nil, errors.New("presentation ID is empty")
+	}
+	if templateID == "" {
+		return nil, errors.New("template ID is empty")
+	}
+
+	presentationID, err := ds.resolveFileID(ctx, presentationID)
+	if err != nil {
+		return nil, err
+	}
+	templateID, err = ds.resolveFileID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := ds.slidesService.Presentations.Get(presentationID).
+		Fields(themeSourceFields).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get presentation: %w", err)
+	}
+
+	// Put the copy next to the original unless a folder is given
+	copied := &drive.File{Name: name}
+	if copied.Name == "" {
+		copied.Name = source.Title + " (themed)"
+	}
+	if folderID != "" {
+		if err := ds.checkScope(ctx, folderID); err != nil {
+			return nil, err
+		}
+		copied.Parents = []string{folderID}
+	} else {
+		file, err := ds.driveService.Files.Get(presentationID).
+			Fields("parents").
+			SupportsAllDrives(true).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get file: %w", err)
+		}
+		copied.Parents = file.Parents
+	}
+	if copied.Parents, err = ds.outputParents(ctx, copied.Parents); err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, copied.Parents...); err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Copy(templateID, copied).
+		Fields("id,name").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy template: %w", err)
+	}
+
+	target, err := ds.slidesService.Presentations.Get(file.Id).
+		Fields(themeTargetFields).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get copied template: %w", err)
+	}
+	if len(target.Layouts) == 0 {
+		return nil, errors.New("template has no layouts")
+	}
+
+	requests, skipped := rethemeRequests(source, target)
+
+	// Replace the template's own slides with the rebuilt ones
+	for _, slide := range target.Slides {
+		requests = append(requests, &slides.Request{DeleteObject: &slides.DeleteObjectRequest{ObjectId: slide.ObjectId}})
+	}
+
+	if len(requests) > 0 {
+		_, err = ds.slidesService.Presentations.BatchUpdate(file.Id, &slides.BatchUpdatePresentationRequest{
+			Requests: requests,
+		}).Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to rebuild slides in %s: %w", file.Id, err)
+		}
+	}
+
+	return &ThemedPresentation{
+		PresentationID: file.Id,
+		Name:           file.Name,
+		Slides:         len(source.Slides),
+		Skipped:        skipped,
+	}, nil
+}
+
+// rethemeRequests returns the requests recreating the slides of source in target, and the elements left out
+func rethemeRequests(source, target *slides.Presentation) ([]*slides.Request, []SkippedElement) {
+	sourceLayouts := make(map[string]*slides.LayoutProperties)
+	for _, layout := range source.Layouts {
+		sourceLayouts[layout.ObjectId] = layout.LayoutProperties
+	}
+
+	var requests, contents []*slides.Request
+	var skipped []SkippedElement
+	for i, slide := range source.Slides {
+		slideID := fmt.Sprintf("themed_%d", i)
+		var properties *slides.LayoutProperties
+		if slide.SlideProperties != nil {
+			properties = sourceLayouts[slide.SlideProperties.LayoutObjectId]
+		}
+		layout := matchLayout(target.Layouts, properties)
+
+		createSlide := &slides.CreateSlideRequest{
+			ObjectId:             slideID,
+			InsertionIndex:       int64(i),
+			SlideLayoutReference: &slides.LayoutReference{LayoutId: layout.ObjectId},
+		}
+		createSlide.ForceSendFields = []string{"InsertionIndex"}
+		requests = append(requests, &slides.Request{CreateSlide: createSlide})
+
+		usedPlaceholders := make(map[*slides.Placeholder]bool)
+		for j, element := range slide.PageElements {
+			elementID := fmt.Sprintf("themed_%d_%d", i, j)
+			properties := &slides.PageElementProperties{PageObjectId: slideID, Size: element.Size, Transform: element.Transform}
+
+			switch {
+			case element.Shape != nil:
+				text := strings.TrimSuffix(shapeText(element.Shape), "\n")
+				if placeholder := element.Shape.Placeholder; placeholder != nil {
+					if layoutPlaceholder := matchPlaceholder(layout, placeholder, usedPlaceholders); layoutPlaceholder != nil {
+						createSlide.PlaceholderIdMappings = append(createSlide.PlaceholderIdMappings, &slides.LayoutPlaceholderIdMapping{
+							LayoutPlaceholder: &slides.Placeholder{Type: layoutPlaceholder.Type, Index: layoutPlaceholder.Index},
+							ObjectId:          elementID,
+						})
+						if text != "" {
+							contents = append(contents, &slides.Request{InsertText: &slides.InsertTextRequest{ObjectId: elementID, Text: text}})
+						}
+						continue
+					}
+					if text == "" {
+						// An empty placeholder without a counterpart has nothing to carry over
+						continue
+					}
+				}
+
+				shapeType := element.Shape.ShapeType
+				if shapeType == "" {
+					shapeType = "TEXT_BOX"
+				}
+				contents = append(contents, &slides.Request{CreateShape: &slides.CreateShapeRequest{
+					ObjectId:          elementID,
+					ShapeType:         shapeType,
+					ElementProperties: properties,
+				}})
+				if text != "" {
+					contents = append(contents, &slides.Request{InsertText: &slides.InsertTextRequest{ObjectId: elementID, Text: text}})
+				}
+			case element.Image != nil && element.Image.ContentUrl != "":
+				contents = append(contents, &slides.Request{CreateImage: &slides.CreateImageRequest{
+					ObjectId:          elementID,
+					Url:               element.Image.ContentUrl,
+					ElementProperties: properties,
+				}})
+			default:
+				skipped = append(skipped, SkippedElement{SlideIndex: i, ObjectID: element.ObjectId, Type: newSlideElement(element).Type})
+			}
+		}
+	}
+
+	// Slides must exist before their contents are created
+	return append(requests, contents...), skipped
+}
+
+// matchLayout returns the layout of layouts with the same name as properties, falling back to the first layout
+func matchLayout(layouts []*slides.Page, properties *slides.LayoutProperties) *slides.Page {
+	if properties != nil {
+		for _, layout := range layouts {
+			if layout.LayoutProperties != nil && layout.LayoutProperties.Name == properties.Name {
+				return layout
+			}
+		}
+		for _, layout := range layouts {
+			if layout.LayoutProperties != nil && layout.LayoutProperties.DisplayName == properties.DisplayName {
+				return layout
+			}
+		}
+	}
+	return layouts[0]
+}
+
+// matchPlaceholder returns an unused placeholder of layout with the same type as placeholder, preferring the same index
+func matchPlaceholder(layout *slides.Page, placeholder *slides.Placeholder, used map[*slides.Placeholder]bool) *slides.Placeholder {
+	var match *slides.Placeholder
+	for _, element := range layout.PageElements {
+		if element.Shape == nil || element.Shape.Placeholder == nil {
+			continue
+		}
+		candidate := element.Shape.Placeholder
+		if used[candidate] || candidate.Type != placeholder.Type {
+			continue
+		}
+		if candidate.Index == placeholder.Index {
+			match = candidate
+			break
+		}
+		if match == nil {
+			match = candidate
+		}
+	}
+	if match != nil {
+		used[match] = true
+	}
+	return match
+}
+
+// shapeText returns the text of a shape
+func shapeText(shape *slides.Shape) string {
+	if shape.Text == nil {
+		return ""
+	}
+	var text strings.Builder
+	for _, textElement := range shape.Text.TextElements {
+		if textElement.TextRun != nil {
+			text.WriteString(textElement.TextRun.Content)
+		}
+	}
+	return text.String()
+}
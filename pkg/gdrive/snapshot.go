@@ -0,0 +1,150 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// snapshotFolderPrefix starts the name of the dated folders snapshots are kept in, e.g. "Snapshots 2026-10-15"
+const snapshotFolderPrefix = "Snapshots "
+
+// SpreadsheetSnapshot is a backup copy of a spreadsheet made by SnapshotSpreadsheet
+type SpreadsheetSnapshot struct {
+	SpreadsheetID string `json:"spreadsheetId"`
+	// Snapshot is the copy; its ID is what a rollback restores from
+	Snapshot DriveFile `json:"snapshot"`
+	// Folder is the dated backup folder the snapshot was placed in
+	Folder DriveFile `json:"folder"`
+	// Format is "sheets" for a native copy or "xlsx" for an exported workbook
+	Format    string    `json:"format"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// SnapshotSpreadsheet backs up a Google Spreadsheet before it is edited, copying it, or exporting it to .xlsx when
+// asXLSX is set, into a dated folder such as "Snapshots 2026-10-15". The dated folder is created on the first
+// snapshot of the day inside folderID, which defaults to the spreadsheet's folder; when an output folder is set, it
+// is used instead. The snapshot is named after the spreadsheet with the time it was taken.
+func (ds *DriveService) SnapshotSpreadsheet(ctx context.Context, spreadsheetID, folderID string, asXLSX bool) (*SpreadsheetSnapshot, error) {
+	if spreadsheetID == "" {
+		return nil, errors.New("spreadsheet ID is empty")
+	}
+	if err := ds.checkScope(ctx, spreadsheetID, folderID); err != nil {
+		return nil, err
+	}
+
+	source, err := ds.driveService.Files.Get(spreadsheetID).
+		Fields("id, name, mimeType, parents").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spreadsheet: %w", err)
+	}
+	if source.MimeType != spreadsheetMimeType {
+		return nil, fmt.Errorf("%s is not a Google Spreadsheet", spreadsheetID)
+	}
+
+	// Without a folder, the backups are kept next to the spreadsheet
+	parents := source.Parents
+	if folderID != "" {
+		parents = []string{folderID}
+	}
+	if parents, err = ds.outputParents(ctx, parents); err != nil {
+		return nil, err
+	}
+	parent := "root"
+	if len(parents) > 0 {
+		parent = parents[0]
+	}
+	if err := ds.checkWrite(ctx, parent); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	folder, err := ds.snapshotFolder(ctx, parent, snapshotFolderPrefix+now.Format(time.DateOnly))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &SpreadsheetSnapshot{
+		SpreadsheetID: source.Id,
+		Folder:        DriveFile{ID: folder.Id, Name: folder.Name, Type: folder.MimeType},
+		Format:        "sheets",
+		CreatedAt:     now,
+	}
+	copied := &drive.File{
+		Name:    fmt.Sprintf("%s %s", source.Name, now.Format("2006-01-02 15:04:05")),
+		Parents: []string{folder.Id},
+	}
+
+	var file *DriveFile
+	if asXLSX {
+		snapshot.Format = "xlsx"
+		copied.Name += officeExtensions[xlsxMimeType]
+		file, err = ds.exportCopy(ctx, source, copied, xlsxMimeType)
+	} else {
+		file, err = ds.copySnapshot(ctx, source.Id, copied)
+	}
+	if err != nil {
+		return nil, err
+	}
+	snapshot.Snapshot = *file
+
+	return snapshot, nil
+}
+
+// snapshotFolder returns the folder named name inside parent, creating it if it does not exist yet
+func (ds *DriveService) snapshotFolder(ctx context.Context, parent, name string) (*drive.File, error) {
+	r, err := ds.driveService.Files.List().
+		Q(fmt.Sprintf("name = %s and mimeType = '%s' and '%s' in parents and trashed = false", quoteQuery(name), folderMimeType, parent)).
+		PageSize(1).
+		Fields("files(id, name, mimeType)").
+		SupportsAllDrives(true).
+		IncludeItemsFromAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find snapshot folder: %w", err)
+	}
+	if len(r.Files) > 0 {
+		return r.Files[0], nil
+	}
+
+	folder, err := ds.driveService.Files.Create(&drive.File{Name: name, MimeType: folderMimeType, Parents: []string{parent}}).
+		Fields("id, name, mimeType").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create snapshot folder: %w", err)
+	}
+	return folder, nil
+}
+
+// copySnapshot copies a spreadsheet as a native Google Sheets snapshot
+func (ds *DriveService) copySnapshot(ctx context.Context, spreadsheetID string, copied *drive.File) (*DriveFile, error) {
+	fields, err := fileFields(copiedFileFields)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Copy(spreadsheetID, copied).
+		Fields(googleapi.Field(fields)).
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy spreadsheet: %w", err)
+	}
+
+	driveFile, err := newDriveFile(file, copiedFileFields)
+	if err != nil {
+		return nil, err
+	}
+	return &driveFile, nil
+}
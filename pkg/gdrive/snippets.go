@@ -0,0 +1,138 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"unicode"
+)
+
+const (
+	// maxSnippetsPerFile is the number of matches reported for each file
+	maxSnippetsPerFile = 3
+	// snippetContext is the number of characters shown on each side of a match
+	snippetContext = 80
+	// maxExportSize bounds how much of a document's text is read when looking for matches
+	maxExportSize = 10 << 20
+)
+
+// Snippet is a match of the search query in a file's text
+type Snippet struct {
+	// Offset is the character offset of the match in the file's plain text
+	Offset int `json:"offset"`
+	// Text is the match with surrounding context
+	Text string `json:"text"`
+}
+
+// SearchResult is a file matched by a content search, with the matches found in its text
+type SearchResult struct {
+	File     DriveFile `json:"file"`
+	Snippets []Snippet `json:"snippets,omitempty"`
+	// SnippetError explains why snippets could not be extracted from a Google Document
+	SnippetError string `json:"snippetError,omitempty"`
+}
+
+// SearchFilesWithSnippets searches the names and content of files. For each matching Google Document,
+// its text is exported and up to three snippets around the matches are returned with their character offsets.
+// filter narrows the search as for SearchFiles.
+func (ds *DriveService) SearchFilesWithSnippets(ctx context.Context, query string, filter SearchFilter, opts ListOptions) (*SearchResultList, error) {
+	if query == "" {
+		return nil, errors.New("search query is empty")
+	}
+	searchQuery, err := ds.searchQuery(ctx, "fullText contains "+quoteQuery(query), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute full-text search with Google Drive API
+	found, err := ds.listFiles(ctx, ds.driveService.Files.List().Q(searchQuery), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search files: %w", err)
+	}
+
+	results := make([]SearchResult, len(found.Files))
+	for i, file := range found.Files {
+		results[i].File = file
+	}
+
+	// Export the matching documents concurrently to find the matches
+	err = forEachConcurrent(ctx, ds.parallelism, len(results), func(ctx context.Context, i int) error {
+		if results[i].File.Type != documentMimeType {
+			return nil
+		}
+
+		text, err := ds.exportText(ctx, results[i].File.ID)
+		if err != nil {
+			results[i].SnippetError = err.Error()
+			return nil
+		}
+		results[i].Snippets = findSnippets(text, query, maxSnippetsPerFile)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchResultList{Results: results, NextPageToken: found.NextPageToken}, nil
+}
+
+// exportText exports a Google Workspace file as plain text
+func (ds *DriveService) exportText(ctx context.Context, fileID string) (string, error) {
+	resp, err := ds.driveService.Files.Export(fileID, "text/plain").
+		Context(ctx).
+		Download()
+	if err != nil {
+		return "", fmt.Errorf("failed to export file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxExportSize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read exported file: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// findSnippets returns up to limit case-insensitive matches of query in text, each with surrounding context
+func findSnippets(text, query string, limit int) []Snippet {
+	runes := []rune(text)
+	haystack := foldRunes(runes)
+	needle := foldRunes([]rune(query))
+	if len(needle) == 0 {
+		return nil
+	}
+
+	var snippets []Snippet
+	for i := 0; i+len(needle) <= len(haystack) && len(snippets) < limit; i++ {
+		if !equalRunes(haystack[i:i+len(needle)], needle) {
+			continue
+		}
+
+		start := max(0, i-snippetContext)
+		end := min(len(runes), i+len(needle)+snippetContext)
+		snippets = append(snippets, Snippet{Offset: i, Text: string(runes[start:end])})
+		i += len(needle) - 1
+	}
+
+	return snippets
+}
+
+// foldRunes lowercases each rune, keeping offsets aligned with the original text
+func foldRunes(runes []rune) []rune {
+	folded := make([]rune, len(runes))
+	for i, r := range runes {
+		folded[i] = unicode.ToLower(r)
+	}
+	return folded
+}
+
+func equalRunes(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,15 @@
+package gdrive
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListStarredFiles lists the files and folders the user has starred, leaving out those in the trash
+func (ds *DriveService) ListStarredFiles(ctx context.Context, opts ListOptions) (*FileList, error) {
+	list, err := ds.listFiles(ctx, ds.driveService.Files.List().Q("starred = true and trashed = false"), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list starred files: %w", err)
+	}
+	return list, nil
+}
@@ -0,0 +1,111 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// thumbnailSizes maps the thumbnail sizes GetThumbnail accepts to the width in pixels of their longest edge
+var thumbnailSizes = map[string]int{
+	"small":  200,
+	"medium": 800,
+	"large":  1600,
+}
+
+// thumbnailSizeSuffix matches the size parameter at the end of a Drive thumbnail link, e.g. "=s220"
+var thumbnailSizeSuffix = regexp.MustCompile(`=s\d+$`)
+
+// Thumbnail is a rendered preview image of a file, or of one slide of a presentation
+type Thumbnail struct {
+	FileID   string `json:"fileId"`
+	Name     string `json:"name"`
+	MimeType string `json:"mimeType"`
+	// SlideIndex is the 0-based slide rendered, for slide thumbnails
+	SlideIndex *int `json:"slideIndex,omitempty"`
+	// ImageMimeType and Data hold the image
+	ImageMimeType string `json:"imageMimeType"`
+	Data          []byte `json:"-"`
+}
+
+// GetThumbnail fetches a preview image of a file as Drive renders it for its file list, e.g. the first page of a
+// PDF or document or a downscaled photo. For a Google Slides presentation, slideIndex renders that slide instead.
+// size is "small", "medium", or "large", defaulting to "medium"; Drive may return a smaller image than requested.
+// For a shortcut, the thumbnail of its target is fetched.
+func (ds *DriveService) GetThumbnail(ctx context.Context, fileID string, slideIndex *int, size string) (*Thumbnail, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if size == "" {
+		size = "medium"
+	}
+	width, ok := thumbnailSizes[size]
+	if !ok {
+		return nil, fmt.Errorf("unsupported thumbnail size %q; use small, medium, or large", size)
+	}
+	fileID, err := ds.resolveFileID(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Get(fileID).
+		Fields("id, name, mimeType, thumbnailLink").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file: %w", err)
+	}
+
+	thumbnail := &Thumbnail{FileID: file.Id, Name: file.Name, MimeType: file.MimeType, SlideIndex: slideIndex}
+	if slideIndex != nil {
+		if file.MimeType != presentationMimeType {
+			return nil, fmt.Errorf("%s is not a Google Slides presentation; slideIndex is only supported for presentations", fileID)
+		}
+		thumbnail.Data, thumbnail.ImageMimeType, err = ds.slideThumbnail(ctx, file.Id, *slideIndex, strings.ToUpper(size))
+		if err != nil {
+			return nil, err
+		}
+		return thumbnail, nil
+	}
+
+	if file.ThumbnailLink == "" {
+		return nil, fmt.Errorf("no thumbnail is available for %s", fileID)
+	}
+	// The link asks for a small image by default, so its size is replaced with the requested one
+	link := thumbnailSizeSuffix.ReplaceAllString(file.ThumbnailLink, "") + "=s" + strconv.Itoa(width)
+	thumbnail.Data, thumbnail.ImageMimeType, err = fetchImage(ctx, ds.driveClient, link)
+	if err != nil {
+		return nil, err
+	}
+	return thumbnail, nil
+}
+
+// slideThumbnail renders a slide of a presentation as a PNG of the given Slides thumbnail size
+func (ds *DriveService) slideThumbnail(ctx context.Context, presentationID string, slideIndex int, size string) ([]byte, string, error) {
+	presentation, err := ds.slidesService.Presentations.Get(presentationID).
+		Fields("slides(objectId)").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get presentation: %w", err)
+	}
+	if slideIndex < 0 || slideIndex >= len(presentation.Slides) {
+		return nil, "", fmt.Errorf("slide index %d is out of range (0-%d)", slideIndex, len(presentation.Slides)-1)
+	}
+
+	rendered, err := ds.slidesService.Presentations.Pages.GetThumbnail(presentationID, presentation.Slides[slideIndex].ObjectId).
+		ThumbnailPropertiesThumbnailSize(size).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get slide thumbnail: %w", err)
+	}
+
+	// The content URL carries its own authorization
+	return fetchImage(ctx, http.DefaultClient, rendered.ContentUrl)
+}
@@ -0,0 +1,118 @@
+package gdrive
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation name of the spans created for Google API requests
+const TracerName = "github.com/kitagry/drive-mcp/pkg/gdrive"
+
+// WithTracerProvider creates a span for every Google API request using tp. File IDs, ranges, and other
+// identifiers in request paths are hashed, and query strings are not recorded, so traces do not reveal file names
+// or contents. The Google client libraries' own instrumentation is disabled, since it records full URLs.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(ds *DriveService) {
+		ds.tracer = tp.Tracer(TracerName)
+	}
+}
+
+// tracingTransport records a span for each request it sends
+type tracingTransport struct {
+	api    string
+	base   http.RoundTripper
+	tracer trace.Tracer
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	template, hashed := redactPath(req.URL.Path)
+	ctx, span := t.tracer.Start(req.Context(), t.api+" "+req.Method+" "+template,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("gdrive.api", t.api),
+			attribute.String("http.request.method", req.Method),
+			attribute.String("server.address", req.URL.Host),
+			attribute.String("url.template", template),
+			attribute.String("url.path", hashed),
+		),
+	)
+	defer span.End()
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	return resp, nil
+}
+
+// identifiedCollections are the path segments of the Google APIs that are followed by an identifier
+var identifiedCollections = map[string]bool{
+	"files":             true,
+	"documents":         true,
+	"presentations":     true,
+	"spreadsheets":      true,
+	"pages":             true,
+	"values":            true,
+	"sheets":            true,
+	"permissions":       true,
+	"revisions":         true,
+	"comments":          true,
+	"replies":           true,
+	"drives":            true,
+	"labels":            true,
+	"developerMetadata": true,
+}
+
+// redactPath returns path with each identifier replaced by "{id}" as a low-cardinality span name, and with each
+// identifier replaced by a short hash, so spans about the same file can be matched without revealing its ID.
+// A custom method suffix such as ":batchUpdate" is kept.
+func redactPath(path string) (template, hashed string) {
+	segments := strings.Split(path, "/")
+	templated := make([]string, len(segments))
+	hashedSegments := make([]string, len(segments))
+	for i, segment := range segments {
+		templated[i], hashedSegments[i] = segment, segment
+		if i == 0 || !identifiedCollections[segments[i-1]] || segment == "" {
+			continue
+		}
+		id, method := segment, ""
+		if colon := strings.LastIndex(segment, ":"); colon >= 0 && isMethodName(segment[colon+1:]) {
+			id, method = segment[:colon], segment[colon:]
+		}
+		templated[i] = "{id}" + method
+		hashedSegments[i] = hashID(id) + method
+	}
+	return strings.Join(templated, "/"), strings.Join(hashedSegments, "/")
+}
+
+// isMethodName reports whether s looks like a custom method such as "batchUpdate" rather than part of an
+// A1 range such as "A1:B2"
+func isMethodName(s string) bool {
+	if len(s) < 2 || s[0] < 'a' || s[0] > 'z' {
+		return false
+	}
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// hashID returns a short, stable hash of an identifier
+func hashID(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return "h" + hex.EncodeToString(sum[:6])
+}
@@ -0,0 +1,70 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// trashedFileFields are the extra fields returned for a trashed file
+var trashedFileFields = []string{"trashed", "trashedTime"}
+
+// TrashFile moves a file or folder to the trash, where it can be restored from for 30 days. Trashing a folder
+// trashes everything below it.
+func (ds *DriveService) TrashFile(ctx context.Context, fileID string) (*DriveFile, error) {
+	if fileID == "" {
+		return nil, errors.New("file ID is empty")
+	}
+	if err := ds.checkScope(ctx, fileID); err != nil {
+		return nil, err
+	}
+	if err := ds.checkWrite(ctx, fileID); err != nil {
+		return nil, err
+	}
+
+	fields, err := fileFields(trashedFileFields)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := ds.driveService.Files.Update(fileID, &drive.File{Trashed: true}).
+		Fields(googleapi.Field(fields)).
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to trash file: %w", err)
+	}
+
+	driveFile, err := newDriveFile(file, trashedFileFields)
+	if err != nil {
+		return nil, err
+	}
+	return &driveFile, nil
+}
+
+// DeleteFile permanently deletes a file or folder, skipping the trash. Deleting a folder deletes everything below
+// it that the user owns. The file cannot be restored afterwards.
+func (ds *DriveService) DeleteFile(ctx context.Context, fileID string) error {
+	if fileID == "" {
+		return errors.New("file ID is empty")
+	}
+	if err := ds.checkScope(ctx, fileID); err != nil {
+		return err
+	}
+	if err := ds.checkWrite(ctx, fileID); err != nil {
+		return err
+	}
+
+	err := ds.driveService.Files.Delete(fileID).
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+	return nil
+}
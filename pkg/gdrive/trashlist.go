@@ -0,0 +1,35 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ListTrashedFiles lists the files and folders in the trash. When each was trashed is always included in the result
+// alongside opts.Fields.
+func (ds *DriveService) ListTrashedFiles(ctx context.Context, opts ListOptions) (*FileList, error) {
+	if !slices.Contains(opts.Fields, "trashedTime") {
+		opts.Fields = append(opts.Fields[:len(opts.Fields):len(opts.Fields)], "trashedTime")
+	}
+
+	list, err := ds.listFiles(ctx, ds.driveService.Files.List().Q("trashed = true"), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trashed files: %w", err)
+	}
+	return list, nil
+}
+
+// EmptyTrash permanently deletes every file in the user's trash. The files cannot be restored afterwards. It reaches
+// beyond any root folder or access policy, so it is refused when the server is confined by either.
+func (ds *DriveService) EmptyTrash(ctx context.Context) error {
+	if ds.rootFolder != "" || ds.accessPolicy != nil {
+		return errors.New("emptying the trash deletes files anywhere in Drive, so it is not allowed with a root folder or access policy; delete the files one by one instead")
+	}
+
+	if err := ds.driveService.Files.EmptyTrash().Context(ctx).Do(); err != nil {
+		return fmt.Errorf("failed to empty trash: %w", err)
+	}
+	return nil
+}
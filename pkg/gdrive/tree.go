@@ -0,0 +1,100 @@
+package gdrive
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"google.golang.org/api/drive/v3"
+)
+
+// folderNode is a folder found while walking a subtree
+type folderNode struct {
+	id   string
+	name string
+	// path is the slash-separated path from the walked root, empty for the root itself
+	path     string
+	children []*folderNode
+	// hasFiles reports whether the folder directly contains anything besides folders
+	hasFiles bool
+}
+
+// walkSubtree lists the non-trashed folders below rootID. Unless foldersOnly is set, it also
+// records which folders directly contain other items. Each level of the tree is listed
+// concurrently, bounded by the configured parallelism.
+func (ds *DriveService) walkSubtree(ctx context.Context, rootID string, foldersOnly bool) (*folderNode, error) {
+	root := &folderNode{id: rootID}
+	seen := map[string]bool{rootID: true}
+
+	level := []*folderNode{root}
+	for len(level) > 0 {
+		var (
+			mu   sync.Mutex
+			next []*folderNode
+		)
+		err := forEachConcurrent(ctx, ds.parallelism, len(level), func(ctx context.Context, i int) error {
+			parent := level[i]
+			query := fmt.Sprintf("'%s' in parents and trashed = false", parent.id)
+			if foldersOnly {
+				query = fmt.Sprintf("'%s' in parents and mimeType = '%s' and trashed = false", parent.id, folderMimeType)
+			}
+
+			return ds.driveService.Files.List().
+				Q(query).
+				PageSize(1000).
+				Fields("nextPageToken, files(id, name, mimeType)").
+				SupportsAllDrives(true).
+				IncludeItemsFromAllDrives(true).
+				Pages(ctx, func(r *drive.FileList) error {
+					mu.Lock()
+					defer mu.Unlock()
+					for _, file := range r.Files {
+						if file.MimeType != folderMimeType {
+							parent.hasFiles = true
+							continue
+						}
+						// Folders can have several parents, so the same folder may be reached twice
+						if seen[file.Id] {
+							continue
+						}
+						seen[file.Id] = true
+
+						child := &folderNode{id: file.Id, name: file.Name, path: file.Name}
+						if parent.path != "" {
+							child.path = parent.path + "/" + file.Name
+						}
+						parent.children = append(parent.children, child)
+						next = append(next, child)
+					}
+					return nil
+				})
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list subfolders: %w", err)
+		}
+
+		level = next
+	}
+
+	return root, nil
+}
+
+// subtreeFolderIDs returns rootID followed by the IDs of all non-trashed folders below it
+func (ds *DriveService) subtreeFolderIDs(ctx context.Context, rootID string) ([]string, error) {
+	root, err := ds.walkSubtree(ctx, rootID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var folderIDs []string
+	var collect func(node *folderNode)
+	collect = func(node *folderNode) {
+		folderIDs = append(folderIDs, node.id)
+		for _, child := range node.children {
+			collect(child)
+		}
+	}
+	collect(root)
+
+	return folderIDs, nil
+}
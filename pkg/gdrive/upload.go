@@ -0,0 +1,296 @@
+package gdrive
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// DefaultMaxUploadSize is the default largest file UploadFromURL will fetch and UploadFile will accept
+const DefaultMaxUploadSize = 100 << 20
+
+// UploadLimits restricts the content UploadFromURL and UploadFile accept
+type UploadLimits struct {
+	// MaxSize is the largest response body in bytes; 0 means no limit
+	MaxSize int64 `json:"maxSize"`
+	// ContentTypes lists the accepted media types. An entry ending in "/*" accepts the whole type,
+	// e.g. "image/*". Empty accepts any content type.
+	ContentTypes []string `json:"contentTypes,omitempty"`
+}
+
+// allows reports whether contentType is accepted
+func (l UploadLimits) allows(contentType string) bool {
+	return len(l.ContentTypes) == 0 || mediaTypeMatches(l.ContentTypes, contentType)
+}
+
+// WithUploadLimits sets the size and content-type limits for UploadFromURL and UploadFile
+func WithUploadLimits(limits UploadLimits) Option {
+	return func(ds *DriveService) {
+		ds.uploadLimits = limits
+	}
+}
+
+// errUploadTooLarge is returned while streaming a body that exceeds the size limit
+var errUploadTooLarge = errors.New("remote file exceeds the maximum upload size")
+
+// limitedReader reads from r, failing once more than n bytes have been read
+type limitedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	if l.n < 0 {
+		return n, errUploadTooLarge
+	}
+	return n, err
+}
+
+// maxFetchRedirects is the number of redirects UploadFromURL follows
+const maxFetchRedirects = 5
+
+// nonPublicNetworks are the ranges, besides those net.IP classifies as loopback, private, link-local, or unspecified,
+// that UploadFromURL refuses to connect to. 100.64.0.0/10 holds some cloud providers' metadata servers.
+var nonPublicNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),
+	mustParseCIDR("100.64.0.0/10"),
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, network, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
+
+// fetchClient fetches the URLs given to UploadFromURL. It only connects to public addresses, so the tool cannot be
+// used to read the metadata server or other internal services into Drive. The address is checked after name
+// resolution on every connection, redirects included, and connections are made directly, bypassing any proxy, so
+// that the address checked is the one connected to.
+var fetchClient = &http.Client{
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: 30 * time.Second,
+			Control: checkPublicAddress,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: time.Minute,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxFetchRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxFetchRedirects)
+		}
+		if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+			return fmt.Errorf("redirect to unsupported scheme %q", req.URL.Scheme)
+		}
+		return nil
+	},
+}
+
+// checkPublicAddress is a net.Dialer Control function refusing connections to loopback, private, link-local,
+// multicast, and other non-public addresses
+func checkPublicAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("invalid address %s", host)
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("refusing to connect to non-public address %s", ip)
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return fmt.Errorf("refusing to connect to non-public address %s", ip)
+		}
+	}
+	return nil
+}
+
+// UploadFromURL fetches rawURL server-side and stores the response body as a new Drive file, without passing
+// the content through the MCP client. An empty name uses the file name from the URL, and an empty folderID
+// uploads to My Drive root, or to the root folder the server is confined to. When an output folder is set,
+// the file is always uploaded there. When convert is set, Office documents are imported as Google Docs, Sheets, or Slides.
+// Only public http and https URLs are fetched, following at most maxFetchRedirects redirects.
+func (ds *DriveService) UploadFromURL(ctx context.Context, rawURL, name, folderID string, convert bool) (*DriveFile, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid URL %q: only http and https URLs can be fetched", rawURL)
+	}
+	folderID = ds.folderOrRoot(folderID)
+	if ds.outputFolder != "" {
+		folderID = ds.outputFolder
+	}
+	if err := ds.checkScope(ctx, folderID); err != nil {
+		return nil, err
+	}
+
+	// Without a folder, the file is created in My Drive
+	destination := folderID
+	if destination == "" {
+		destination = "root"
+	}
+	if err := ds.checkWrite(ctx, destination); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := fetchClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch URL: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch URL: %s", resp.Status)
+	}
+
+	// Check the limits before uploading anything
+	limits := ds.uploadLimits
+	if limits.MaxSize > 0 && resp.ContentLength > limits.MaxSize {
+		return nil, fmt.Errorf("remote file is %d bytes, over the maximum upload size of %d bytes", resp.ContentLength, limits.MaxSize)
+	}
+
+	contentType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil {
+		contentType = "application/octet-stream"
+	}
+	if !limits.allows(contentType) {
+		return nil, fmt.Errorf("content type %s is not allowed (allowed: %s)", contentType, strings.Join(limits.ContentTypes, ", "))
+	}
+
+	file := &drive.File{Name: name}
+	if file.Name == "" {
+		file.Name = fileNameFromResponse(resp)
+	}
+	if folderID != "" {
+		file.Parents = []string{folderID}
+	}
+	if convert {
+		googleType, ok := googleTypeForOffice[contentType]
+		if !ok {
+			return nil, fmt.Errorf("content type %s cannot be converted", contentType)
+		}
+		file.MimeType = googleType
+		file.Name = strings.TrimSuffix(file.Name, path.Ext(file.Name))
+	}
+
+	var body io.Reader = resp.Body
+	if limits.MaxSize > 0 {
+		body = &limitedReader{r: resp.Body, n: limits.MaxSize}
+	}
+
+	created, err := ds.driveService.Files.Create(file).
+		Media(body, googleapi.ContentType(contentType)).
+		Fields("id, name, mimeType").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		if errors.Is(err, errUploadTooLarge) {
+			return nil, fmt.Errorf("remote file exceeds the maximum upload size of %d bytes", limits.MaxSize)
+		}
+		return nil, fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	return &DriveFile{ID: created.Id, Name: created.Name, Type: created.MimeType}, nil
+}
+
+// UploadFile creates a new Drive file named name from content. An empty mimeType is guessed from the name's
+// extension, then from the content itself. A Google Workspace mimeType such as a Google Doc converts the content
+// while uploading, e.g. plain text or HTML into a document. An empty folderID uploads to My Drive root, or to the
+// root folder the server is confined to, and an output folder, when set, is always used instead. The same size and
+// content-type limits as UploadFromURL apply.
+func (ds *DriveService) UploadFile(ctx context.Context, name, mimeType, folderID string, content []byte) (*DriveFile, error) {
+	if name == "" {
+		return nil, errors.New("file name is empty")
+	}
+	folderID = ds.folderOrRoot(folderID)
+	if ds.outputFolder != "" {
+		folderID = ds.outputFolder
+	}
+	if err := ds.checkScope(ctx, folderID); err != nil {
+		return nil, err
+	}
+
+	// Without a folder, the file is created in My Drive
+	destination := folderID
+	if destination == "" {
+		destination = "root"
+	}
+	if err := ds.checkWrite(ctx, destination); err != nil {
+		return nil, err
+	}
+
+	// Workspace types have no content of their own; the content is uploaded as what it is and converted
+	contentType := mimeType
+	if contentType == "" || strings.HasPrefix(contentType, "application/vnd.google-apps.") {
+		contentType = mime.TypeByExtension(path.Ext(name))
+		if contentType == "" {
+			contentType = http.DetectContentType(content)
+		}
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MIME type %q: %w", contentType, err)
+	}
+
+	limits := ds.uploadLimits
+	if limits.MaxSize > 0 && int64(len(content)) > limits.MaxSize {
+		return nil, fmt.Errorf("content is %d bytes, over the maximum upload size of %d bytes", len(content), limits.MaxSize)
+	}
+	if !limits.allows(mediaType) {
+		return nil, fmt.Errorf("content type %s is not allowed (allowed: %s)", mediaType, strings.Join(limits.ContentTypes, ", "))
+	}
+
+	file := &drive.File{Name: name, MimeType: mimeType}
+	if folderID != "" {
+		file.Parents = []string{folderID}
+	}
+
+	created, err := ds.driveService.Files.Create(file).
+		Media(bytes.NewReader(content), googleapi.ContentType(contentType)).
+		Fields("id, name, mimeType").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	return &DriveFile{ID: created.Id, Name: created.Name, Type: created.MimeType}, nil
+}
+
+// fileNameFromResponse returns the file name given by the Content-Disposition header or, failing that, the URL path
+func fileNameFromResponse(resp *http.Response) string {
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil && params["filename"] != "" {
+		return path.Base(params["filename"])
+	}
+	if base := path.Base(resp.Request.URL.Path); base != "/" && base != "." {
+		return base
+	}
+	return resp.Request.URL.Host
+}
@@ -0,0 +1,237 @@
+package gdrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// MaxDirectoryUploadFiles bounds the number of files UploadDirectory uploads in one call
+const MaxDirectoryUploadFiles = 1000
+
+// UploadProgress reports how far a directory upload has got
+type UploadProgress struct {
+	// Done is the number of files uploaded or failed so far
+	Done  int
+	Total int
+	// Path is the file that just finished, relative to the uploaded directory
+	Path string
+}
+
+// UploadedFile is the outcome of uploading one local file
+type UploadedFile struct {
+	// Path is the file's path relative to the uploaded directory, with slashes as separators
+	Path  string `json:"path"`
+	ID    string `json:"id,omitempty"`
+	Size  int64  `json:"size"`
+	Error string `json:"error,omitempty"`
+}
+
+// DirectoryUpload reports the folders and files created by UploadDirectory
+type DirectoryUpload struct {
+	// Folder is the Drive folder created for the uploaded directory
+	Folder DriveFile `json:"folder"`
+	// Folders is the number of folders created, including Folder
+	Folders  int            `json:"folders"`
+	Files    []UploadedFile `json:"files"`
+	Uploaded int            `json:"uploaded"`
+	Failed   int            `json:"failed"`
+	// Bytes is the total size of the uploaded files
+	Bytes int64 `json:"bytes"`
+}
+
+// localFile is a regular file found below an uploaded directory
+type localFile struct {
+	// dir is the path of the file's directory relative to the uploaded directory, "." for the directory itself
+	dir  string
+	path string
+	size int64
+}
+
+// UploadDirectory uploads a local directory to Drive as a new folder of the same name inside folderID,
+// recreating its subdirectories as folders. Files are uploaded concurrently, bounded by the configured
+// parallelism, and progress is called after each one. A failed file is reported in its result instead of
+// failing the whole upload; failing to create a folder stops it. Symbolic links and other non-regular files
+// are skipped. An empty folderID uploads to My Drive root, or to the root folder the server is confined to,
+// and an output folder, when set, is always used instead.
+func (ds *DriveService) UploadDirectory(ctx context.Context, localPath, folderID string, progress func(UploadProgress)) (*DirectoryUpload, error) {
+	if localPath == "" {
+		return nil, errors.New("local path is empty")
+	}
+	root, err := filepath.Abs(localPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid local path: %w", err)
+	}
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read local directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", localPath)
+	}
+
+	// Check the destination before walking the directory
+	var parents []string
+	if destination := ds.folderOrRoot(folderID); destination != "" {
+		parents = []string{destination}
+	}
+	if parents, err = ds.outputParents(ctx, parents); err != nil {
+		return nil, err
+	}
+	if err := ds.checkScope(ctx, parents...); err != nil {
+		return nil, err
+	}
+	writeTo := parents
+	if len(writeTo) == 0 {
+		writeTo = []string{"root"}
+	}
+	if err := ds.checkWrite(ctx, writeTo...); err != nil {
+		return nil, err
+	}
+
+	// Directories are collected parents first, so they can be created level by level
+	dirs := [][]string{{"."}}
+	var files []localFile
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if rel != "." {
+				depth := strings.Count(filepath.ToSlash(rel), "/") + 1
+				if depth == len(dirs) {
+					dirs = append(dirs, nil)
+				}
+				dirs[depth] = append(dirs[depth], rel)
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		if len(files) == MaxDirectoryUploadFiles {
+			return fmt.Errorf("directory holds more than %d files; upload its subdirectories separately", MaxDirectoryUploadFiles)
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		files = append(files, localFile{dir: filepath.Dir(rel), path: rel, size: info.Size()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read local directory: %w", err)
+	}
+
+	// Create the folders, each level concurrently
+	folderIDs := make(map[string]string)
+	var mu sync.Mutex
+	var top *drive.File
+	for depth, level := range dirs {
+		err := forEachConcurrent(ctx, ds.parallelism, len(level), func(ctx context.Context, i int) error {
+			folder := &drive.File{Name: filepath.Base(filepath.Join(root, level[i])), MimeType: folderMimeType, Parents: parents}
+			if depth > 0 {
+				mu.Lock()
+				folder.Parents = []string{folderIDs[filepath.Dir(level[i])]}
+				mu.Unlock()
+			}
+			created, err := ds.driveService.Files.Create(folder).
+				Fields("id, name, mimeType").
+				SupportsAllDrives(true).
+				Context(ctx).
+				Do()
+			if err != nil {
+				return fmt.Errorf("failed to create folder %s: %w", folder.Name, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			folderIDs[level[i]] = created.Id
+			if depth == 0 {
+				top = created
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	upload := &DirectoryUpload{
+		Folder:  DriveFile{ID: top.Id, Name: top.Name, Type: top.MimeType},
+		Folders: len(folderIDs),
+		Files:   make([]UploadedFile, len(files)),
+	}
+	done := 0
+	err = forEachConcurrent(ctx, ds.parallelism, len(files), func(ctx context.Context, i int) error {
+		file := files[i]
+		result := UploadedFile{Path: filepath.ToSlash(file.path), Size: file.size}
+		id, err := ds.uploadLocalFile(ctx, filepath.Join(root, file.path), folderIDs[file.dir])
+		if err != nil {
+			// The upload goes on without the file, unless the whole call was canceled
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			result.Error = err.Error()
+		}
+		result.ID = id
+
+		mu.Lock()
+		defer mu.Unlock()
+		upload.Files[i] = result
+		if result.Error == "" {
+			upload.Uploaded++
+			upload.Bytes += file.size
+		} else {
+			upload.Failed++
+		}
+		done++
+		if progress != nil {
+			progress(UploadProgress{Done: done, Total: len(files), Path: result.Path})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+// uploadLocalFile uploads a local file into folderID, returning the new file's ID
+func (ds *DriveService) uploadLocalFile(ctx context.Context, path, folderID string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	created, err := ds.driveService.Files.Create(&drive.File{Name: filepath.Base(path), Parents: []string{folderID}}).
+		Media(f, googleapi.ContentType(contentType)).
+		Fields("id").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return "", fmt.Errorf("failed to upload file: %w", err)
+	}
+	return created.Id, nil
+}
@@ -0,0 +1,103 @@
+package gdrive
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// FileRef is a file reference parsed from a Google Docs or Drive URL
+type FileRef struct {
+	ID string `json:"id"`
+	// Type is the kind of file the URL points to: document, spreadsheet, presentation, form, drawing, folder, or file
+	Type string `json:"type"`
+	// MimeType is the Drive MIME type implied by the URL, empty when the URL does not tell
+	MimeType string `json:"mimeType,omitempty"`
+}
+
+// docsURLTypes maps the first path segment of docs.google.com URLs to the file type and MIME type
+var docsURLTypes = map[string]FileRef{
+	"document":     {Type: "document", MimeType: documentMimeType},
+	"spreadsheets": {Type: "spreadsheet", MimeType: spreadsheetMimeType},
+	"presentation": {Type: "presentation", MimeType: presentationMimeType},
+	"forms":        {Type: "form", MimeType: formMimeType},
+	"drawings":     {Type: "drawing", MimeType: drawingMimeType},
+	"file":         {Type: "file"},
+}
+
+// fileURLHosts are the hosts of the URLs ParseFileURL understands
+var fileURLHosts = map[string]bool{
+	"docs.google.com":              true,
+	"drive.google.com":             true,
+	"drive.usercontent.google.com": true,
+}
+
+// ParseFileURL extracts the file ID and type from a docs.google.com, drive.google.com, or
+// drive.usercontent.google.com URL. The scheme may be left out, as in "docs.google.com/document/d/ID/edit".
+func ParseFileURL(rawURL string) (FileRef, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	fullURL := rawURL
+	if !strings.Contains(fullURL, "://") {
+		fullURL = "https://" + fullURL
+	}
+	u, err := url.Parse(fullURL)
+	if err != nil {
+		return FileRef{}, fmt.Errorf("invalid URL: %w", err)
+	}
+	if !fileURLHosts[strings.ToLower(u.Hostname())] {
+		return FileRef{}, fmt.Errorf("not a Google Docs or Drive URL: %s", rawURL)
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	// Drop the Google Workspace domain, e.g. /a/example.com/document/d/ID
+	if len(segments) >= 2 && segments[0] == "a" {
+		segments = segments[2:]
+	}
+
+	// Drop the account selector, e.g. /document/u/1/d/ID or /drive/u/0/folders/ID
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == "u" {
+			segments = append(segments[:i:i], segments[i+2:]...)
+			break
+		}
+	}
+
+	// docs.google.com/document/d/ID and drive.google.com/file/d/ID
+	if len(segments) >= 3 && segments[1] == "d" && segments[2] != "e" {
+		if ref, ok := docsURLTypes[segments[0]]; ok {
+			ref.ID = segments[2]
+			return ref, nil
+		}
+	}
+
+	// drive.google.com/drive/folders/ID
+	if len(segments) >= 3 && segments[0] == "drive" && segments[1] == "folders" {
+		return FileRef{ID: segments[2], Type: "folder", MimeType: folderMimeType}, nil
+	}
+
+	// drive.google.com/open?id=ID, drive.google.com/uc?id=ID, and drive.usercontent.google.com/download?id=ID
+	if id := u.Query().Get("id"); id != "" {
+		return FileRef{ID: id, Type: "file"}, nil
+	}
+
+	return FileRef{}, fmt.Errorf("no file ID found in URL: %s", rawURL)
+}
+
+// IsFileURL reports whether s is a URL, with or without its scheme, rather than a bare file ID. File IDs never
+// contain a slash.
+func IsFileURL(s string) bool {
+	return strings.Contains(strings.TrimSpace(s), "/")
+}
+
+// ResolveFileID returns the file ID from idOrURL, which may be either a bare file ID or a Google Docs or Drive URL.
+// A URL without a file ID is returned unchanged.
+func ResolveFileID(idOrURL string) string {
+	if !IsFileURL(idOrURL) {
+		return idOrURL
+	}
+	if ref, err := ParseFileURL(idOrURL); err == nil {
+		return ref.ID
+	}
+	return idOrURL
+}
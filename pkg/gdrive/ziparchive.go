@@ -0,0 +1,401 @@
+package gdrive
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/googleapi"
+)
+
+// MaxArchiveSize bounds the total size of the files put in a folder archive
+const MaxArchiveSize = 100 << 20
+
+// workspaceKinds maps the kinds of Google Workspace files accepted in FolderArchiveOptions.Formats to their MIME type
+var workspaceKinds = map[string]string{
+	"document":     documentMimeType,
+	"spreadsheet":  spreadsheetMimeType,
+	"presentation": presentationMimeType,
+	"drawing":      drawingMimeType,
+}
+
+// exportFormats maps each exportable Google Workspace MIME type to the formats it can be exported as,
+// keyed by file name extension
+var exportFormats = map[string]map[string]string{
+	documentMimeType: {
+		"docx": docxMimeType,
+		"odt":  "application/vnd.oasis.opendocument.text",
+		"rtf":  "application/rtf",
+		"pdf":  "application/pdf",
+		"txt":  "text/plain",
+		"md":   "text/markdown",
+		"epub": "application/epub+zip",
+	},
+	spreadsheetMimeType: {
+		"xlsx": xlsxMimeType,
+		"ods":  "application/vnd.oasis.opendocument.spreadsheet",
+		"pdf":  "application/pdf",
+		"csv":  "text/csv",
+		"tsv":  "text/tab-separated-values",
+	},
+	presentationMimeType: {
+		"pptx": pptxMimeType,
+		"odp":  "application/vnd.oasis.opendocument.presentation",
+		"pdf":  "application/pdf",
+		"txt":  "text/plain",
+	},
+	drawingMimeType: {
+		"png": "image/png",
+		"jpg": "image/jpeg",
+		"svg": "image/svg+xml",
+		"pdf": "application/pdf",
+	},
+}
+
+// defaultExportFormats are the formats Google Workspace files are exported as unless FolderArchiveOptions.Formats
+// says otherwise
+var defaultExportFormats = map[string]string{
+	documentMimeType:     "docx",
+	spreadsheetMimeType:  "xlsx",
+	presentationMimeType: "pptx",
+	drawingMimeType:      "png",
+}
+
+// FolderArchiveOptions controls how ExportFolderZip converts and delivers a folder
+type FolderArchiveOptions struct {
+	// Formats maps a kind of Google Workspace file (document, spreadsheet, presentation, or drawing) to the
+	// extension of the format it is exported as, e.g. {"document": "pdf"}. Kinds not given use the Office
+	// formats, and PNG for drawings.
+	Formats map[string]string
+	// SaveToDrive uploads the archive to Drive instead of returning its content
+	SaveToDrive bool
+	// DestinationFolderID is the folder the archive is uploaded to. Empty uploads to My Drive root, or to the
+	// root folder the server is confined to.
+	DestinationFolderID string
+	// Name is the archive's file name. Empty uses the folder's name with a .zip extension.
+	Name string
+}
+
+// ArchivedFile is a file stored in a folder archive
+type ArchivedFile struct {
+	ID string `json:"id"`
+	// Path is the file's path in the archive
+	Path string `json:"path"`
+	// MimeType is the type of the stored content, which is the export format for Google Workspace files
+	MimeType string `json:"mimeType"`
+	Size     int64  `json:"size"`
+}
+
+// SkippedFile is a file left out of a folder archive
+type SkippedFile struct {
+	ID       string `json:"id"`
+	Path     string `json:"path"`
+	MimeType string `json:"mimeType"`
+	Reason   string `json:"reason"`
+}
+
+// FolderArchive is a zip archive of a folder's contents
+type FolderArchive struct {
+	FolderID string `json:"folderId"`
+	Name     string `json:"name"`
+	// Size is the size of the zip archive in bytes
+	Size    int64          `json:"size"`
+	Files   []ArchivedFile `json:"files"`
+	Skipped []SkippedFile  `json:"skipped,omitempty"`
+	// Content is the zip archive, encoded as base64 in JSON. It is empty when the archive was saved to Drive.
+	Content []byte `json:"content,omitempty"`
+	// File is the uploaded archive when it was saved to Drive
+	File *DriveFile `json:"file,omitempty"`
+}
+
+// archiveEntry is a file to fetch into an archive
+type archiveEntry struct {
+	file *drive.File
+	path string
+	// exportType is the MIME type a Google Workspace file is exported as; empty downloads the file as is
+	exportType string
+	content    []byte
+}
+
+// ExportFolderZip puts every file below a folder, subfolders included, into a zip archive that mirrors the folder
+// structure. Google Workspace files are exported in the formats chosen by opts.Formats; shortcuts and Workspace
+// files that cannot be exported, such as forms, are listed as skipped. The archive is returned, or uploaded to
+// Drive when opts.SaveToDrive is set. The files together may not exceed MaxArchiveSize bytes.
+func (ds *DriveService) ExportFolderZip(ctx context.Context, folderID string, opts FolderArchiveOptions) (*FolderArchive, error) {
+	if folderID == "" {
+		return nil, errors.New("folder ID is empty")
+	}
+	formats, err := archiveFormats(opts.Formats)
+	if err != nil {
+		return nil, err
+	}
+	if err := ds.checkScope(ctx, folderID); err != nil {
+		return nil, err
+	}
+
+	folder, err := ds.driveService.Files.Get(folderID).
+		Fields("id, name, mimeType").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get folder: %w", err)
+	}
+	if folder.MimeType != folderMimeType {
+		return nil, fmt.Errorf("%s is not a folder", folderID)
+	}
+
+	archive := &FolderArchive{FolderID: folder.Id, Name: opts.Name, Files: []ArchivedFile{}}
+	if archive.Name == "" {
+		archive.Name = archiveName(folder.Name) + ".zip"
+	}
+
+	// Check where the archive goes before fetching anything
+	var parents []string
+	if opts.SaveToDrive {
+		if destination := ds.folderOrRoot(opts.DestinationFolderID); destination != "" {
+			parents = []string{destination}
+		}
+		if parents, err = ds.outputParents(ctx, parents); err != nil {
+			return nil, err
+		}
+		if err := ds.checkScope(ctx, parents...); err != nil {
+			return nil, err
+		}
+		writeTo := parents
+		if len(writeTo) == 0 {
+			writeTo = []string{"root"}
+		}
+		if err := ds.checkWrite(ctx, writeTo...); err != nil {
+			return nil, err
+		}
+	}
+
+	root, err := ds.walkSubtree(ctx, folder.Id, true)
+	if err != nil {
+		return nil, err
+	}
+	folderPaths, entries, err := ds.archiveEntries(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+
+	// Leave out what cannot be stored
+	var fetched []*archiveEntry
+	var size int64
+	for _, entry := range entries {
+		skip := func(reason string) {
+			archive.Skipped = append(archive.Skipped, SkippedFile{ID: entry.file.Id, Path: entry.path, MimeType: entry.file.MimeType, Reason: reason})
+		}
+		switch {
+		case entry.file.MimeType == shortcutMimeType:
+			skip("shortcut")
+		case strings.HasPrefix(entry.file.MimeType, "application/vnd.google-apps."):
+			extension, ok := formats[entry.file.MimeType]
+			if !ok {
+				skip("Google Workspace file type cannot be exported")
+				continue
+			}
+			entry.exportType = exportFormats[entry.file.MimeType][extension]
+			entry.path += "." + extension
+			fetched = append(fetched, entry)
+		default:
+			size += entry.file.Size
+			fetched = append(fetched, entry)
+		}
+	}
+	if size > MaxArchiveSize {
+		return nil, fmt.Errorf("folder holds %d bytes of files, over the maximum archive size of %d bytes", size, MaxArchiveSize)
+	}
+
+	// Fetch the files concurrently, keeping track of the total size since exports have no size up front
+	var mu sync.Mutex
+	size = 0
+	err = forEachConcurrent(ctx, ds.parallelism, len(fetched), func(ctx context.Context, i int) error {
+		entry := fetched[i]
+		content, err := ds.archiveContent(ctx, entry)
+		if err != nil {
+			return fmt.Errorf("%s: %w", entry.path, err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		size += int64(len(content))
+		if size > MaxArchiveSize {
+			return fmt.Errorf("folder contents exceed the maximum archive size of %d bytes", MaxArchiveSize)
+		}
+		entry.content = content
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, folderPath := range folderPaths {
+		if _, err := zw.Create(folderPath + "/"); err != nil {
+			return nil, fmt.Errorf("failed to write archive: %w", err)
+		}
+	}
+	for _, entry := range uniquePaths(fetched) {
+		header := &zip.FileHeader{Name: entry.path, Method: zip.Deflate}
+		if modified, err := time.Parse(time.RFC3339, entry.file.ModifiedTime); err == nil {
+			header.Modified = modified
+		}
+		w, err := zw.CreateHeader(header)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write archive: %w", err)
+		}
+		if _, err := w.Write(entry.content); err != nil {
+			return nil, fmt.Errorf("failed to write archive: %w", err)
+		}
+		mimeType := entry.exportType
+		if mimeType == "" {
+			mimeType = entry.file.MimeType
+		}
+		archive.Files = append(archive.Files, ArchivedFile{ID: entry.file.Id, Path: entry.path, MimeType: mimeType, Size: int64(len(entry.content))})
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+	archive.Size = int64(buf.Len())
+
+	if !opts.SaveToDrive {
+		archive.Content = buf.Bytes()
+		return archive, nil
+	}
+
+	created, err := ds.driveService.Files.Create(&drive.File{Name: archive.Name, Parents: parents}).
+		Media(&buf, googleapi.ContentType("application/zip")).
+		Fields("id, name, mimeType").
+		SupportsAllDrives(true).
+		Context(ctx).
+		Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload archive: %w", err)
+	}
+	archive.File = &DriveFile{ID: created.Id, Name: created.Name, Type: created.MimeType}
+
+	return archive, nil
+}
+
+// archiveFormats resolves the requested export formats to a map from Google Workspace MIME type to extension
+func archiveFormats(requested map[string]string) (map[string]string, error) {
+	formats := make(map[string]string, len(defaultExportFormats))
+	for mimeType, extension := range defaultExportFormats {
+		formats[mimeType] = extension
+	}
+	for kind, extension := range requested {
+		mimeType, ok := workspaceKinds[kind]
+		if !ok {
+			return nil, fmt.Errorf("unknown file kind %q: expected document, spreadsheet, presentation, or drawing", kind)
+		}
+		extension = strings.ToLower(strings.TrimPrefix(extension, "."))
+		if _, ok := exportFormats[mimeType][extension]; !ok {
+			supported := make([]string, 0, len(exportFormats[mimeType]))
+			for supportedExtension := range exportFormats[mimeType] {
+				supported = append(supported, supportedExtension)
+			}
+			sort.Strings(supported)
+			return nil, fmt.Errorf("a %s cannot be exported as %q (supported: %s)", kind, extension, strings.Join(supported, ", "))
+		}
+		formats[mimeType] = extension
+	}
+	return formats, nil
+}
+
+// archiveEntries lists the files directly in each folder of a walked subtree, returning the archive paths of
+// the subfolders and the files with their archive paths
+func (ds *DriveService) archiveEntries(ctx context.Context, root *folderNode) ([]string, []*archiveEntry, error) {
+	// Folder paths are rebuilt from the names, since a name may contain a slash
+	nodes := []*folderNode{root}
+	paths := []string{""}
+	for i := 0; i < len(nodes); i++ {
+		for _, child := range nodes[i].children {
+			nodes = append(nodes, child)
+			paths = append(paths, path.Join(paths[i], archiveName(child.name)))
+		}
+	}
+
+	files := make([][]*archiveEntry, len(nodes))
+	err := forEachConcurrent(ctx, ds.parallelism, len(nodes), func(ctx context.Context, i int) error {
+		return ds.driveService.Files.List().
+			Q(fmt.Sprintf("'%s' in parents and mimeType != '%s' and trashed = false", nodes[i].id, folderMimeType)).
+			PageSize(1000).
+			Fields("nextPageToken, files(id, name, mimeType, size, modifiedTime)").
+			SupportsAllDrives(true).
+			IncludeItemsFromAllDrives(true).
+			Pages(ctx, func(r *drive.FileList) error {
+				for _, file := range r.Files {
+					files[i] = append(files[i], &archiveEntry{file: file, path: path.Join(paths[i], archiveName(file.Name))})
+				}
+				return nil
+			})
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list files: %w", err)
+	}
+
+	var entries []*archiveEntry
+	for _, folderFiles := range files {
+		entries = append(entries, folderFiles...)
+	}
+	return paths[1:], entries, nil
+}
+
+// archiveContent downloads or exports the content of an archived file
+func (ds *DriveService) archiveContent(ctx context.Context, entry *archiveEntry) ([]byte, error) {
+	if entry.exportType != "" {
+		return ds.exportFile(ctx, entry.file.Id, entry.exportType)
+	}
+
+	resp, err := ds.driveService.Files.Get(entry.file.Id).
+		SupportsAllDrives(true).
+		Context(ctx).
+		Download()
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, MaxArchiveSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download file: %w", err)
+	}
+	return content, nil
+}
+
+// archiveName makes a Drive file name usable as one element of an archive path
+func archiveName(name string) string {
+	name = strings.ReplaceAll(name, "/", "_")
+	if name == "" || name == "." || name == ".." {
+		return "_"
+	}
+	return name
+}
+
+// uniquePaths numbers the paths of files that share a name in the same folder, as Drive allows, so that no
+// archive entry overwrites another
+func uniquePaths(entries []*archiveEntry) []*archiveEntry {
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		unique := entry.path
+		extension := path.Ext(entry.path)
+		for n := 2; seen[unique]; n++ {
+			unique = strings.TrimSuffix(entry.path, extension) + " (" + strconv.Itoa(n) + ")" + extension
+		}
+		seen[unique] = true
+		entry.path = unique
+	}
+	return entries
+}
@@ -0,0 +1,29 @@
+package tools
+
+import (
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// rawRequests returns the elements of the 'requests' array parameter as raw JSON,
+// or an error result if the parameter is missing or malformed
+func rawRequests(request mcp.CallToolRequest) ([]json.RawMessage, *mcp.CallToolResult) {
+	requestsParam, ok := request.GetArguments()["requests"].([]any)
+	if !ok || len(requestsParam) == 0 {
+		return nil, mcp.NewToolResultError("Parameter 'requests' is required")
+	}
+
+	requests := make([]json.RawMessage, len(requestsParam))
+	for i, r := range requestsParam {
+		if _, ok := r.(map[string]any); !ok {
+			return nil, mcp.NewToolResultError("Invalid requests format: each request must be an object")
+		}
+		data, err := json.Marshal(r)
+		if err != nil {
+			return nil, mcp.NewToolResultError("Invalid requests format: " + err.Error())
+		}
+		requests[i] = data
+	}
+	return requests, nil
+}
@@ -0,0 +1,465 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/drive/v3"
+)
+
+// DocTools returns the Google Docs tools backed by docEditor
+func DocTools(docEditor gdrive.DocEditor) []Tool {
+	// Define get document tool
+	getDocumentTool := mcp.NewTool(
+		"get_document",
+		mcp.WithDescription("Get the content of a Google Document. For large documents, set maxChars to read it in chunks"),
+		mcp.WithString("documentId", mcp.Description("The ID or URL of the Google Document"), mcp.Required()),
+		mcp.WithNumber("startIndex", mcp.Description("Character offset to start reading from (default: 0). Use nextStartIndex from the previous response to continue"), mcp.DefaultNumber(0)),
+		mcp.WithNumber("maxChars", mcp.Description("Maximum number of characters to return. If set, the response is JSON with the chunk, totalChars, and nextStartIndex")),
+		withFormat(FormatText),
+	)
+
+	// Define update document tool
+	updateDocumentTool := mcp.NewTool(
+		"update_document",
+		mcp.WithDescription("Update the content of a Google Document"),
+		mcp.WithString("documentId", mcp.Description("The ID or URL of the Google Document"), mcp.Required()),
+		mcp.WithString("content", mcp.Description("The new content for the document"), mcp.Required()),
+	)
+
+	// Define append table rows tool
+	appendTableRowsTool := mcp.NewTool(
+		"append_table_rows",
+		mcp.WithDescription("Append rows to the end of an existing table in a Google Document, e.g. to maintain a running log or status table. Locate the table by its position or by the heading it follows"),
+		mcp.WithString("documentId", mcp.Description("The ID or URL of the Google Document"), mcp.Required()),
+		mcp.WithArray("rows", mcp.Description("2D array of cell values to append, one inner array per row. Rows may be shorter than the table is wide"), mcp.Required(), mcp.Items(map[string]any{"type": "array"})),
+		mcp.WithNumber("tableIndex", mcp.Description("0-based position of the table in the document (default: 0). Ignored when heading is set"), mcp.DefaultNumber(0)),
+		mcp.WithString("heading", mcp.Description("Use the first table after the first heading containing this text (case-insensitive)")),
+	)
+
+	// Define replace document image tool
+	replaceDocumentImageTool := mcp.NewTool(
+		"replace_document_image",
+		mcp.WithDescription("Replace an inline image in a Google Document with the image at a URL, keeping the original size and position, e.g. to refresh a chart or screenshot in a report. Locate the image by its object ID or by its position"),
+		mcp.WithString("documentId", mcp.Description("The ID or URL of the Google Document"), mcp.Required()),
+		mcp.WithString("imageUrl", mcp.Description("Publicly accessible http(s) URL of the new image (PNG, JPEG, or GIF, under 50MB)"), mcp.Required()),
+		mcp.WithString("imageObjectId", mcp.Description("The object ID of the inline image to replace")),
+		mcp.WithNumber("imageIndex", mcp.Description("0-based position of the image among the document's inline images (default: 0). Ignored when imageObjectId is set"), mcp.DefaultNumber(0)),
+	)
+
+	// Define get document images tool
+	getDocumentImagesTool := mcp.NewTool(
+		"get_document_images",
+		mcp.WithDescription("List the inline images of a Google Document in document order, with short-lived content URLs. Set includeData to also return the images themselves, e.g. to inspect figures referenced in the text"),
+		mcp.WithString("documentId", mcp.Description("The ID or URL of the Google Document"), mcp.Required()),
+		mcp.WithBoolean("includeData", mcp.Description("Download the images and return them as image content (default: false)"), mcp.DefaultBool(false)),
+	)
+
+	// Define get document style tool
+	getDocumentStyleTool := mcp.NewTool(
+		"get_document_style",
+		mcp.WithDescription("Get the page size, orientation, margins, and named styles (fonts, sizes, and spacing of NORMAL_TEXT, HEADING_1, etc.) of a Google Document. Lengths are in points"),
+		mcp.WithString("documentId", mcp.Description("The ID or URL of the Google Document"), mcp.Required()),
+	)
+
+	// Define update document style tool
+	updateDocumentStyleTool := mcp.NewTool(
+		"update_document_style",
+		mcp.WithDescription("Change the page size, orientation, and margins of a Google Document, e.g. to match an organization's template. Lengths are in points (72 points = 1 inch). Only the given settings are changed"),
+		mcp.WithString("documentId", mcp.Description("The ID or URL of the Google Document"), mcp.Required()),
+		mcp.WithString("pageSize", mcp.Description("Standard page size"), mcp.Enum("LETTER", "LEGAL", "TABLOID", "A3", "A4", "A5")),
+		mcp.WithNumber("pageWidth", mcp.Description("Page width in points; overrides pageSize")),
+		mcp.WithNumber("pageHeight", mcp.Description("Page height in points; overrides pageSize")),
+		mcp.WithBoolean("landscape", mcp.Description("true for landscape, false for portrait orientation")),
+		mcp.WithNumber("marginTop", mcp.Description("Top margin in points")),
+		mcp.WithNumber("marginBottom", mcp.Description("Bottom margin in points")),
+		mcp.WithNumber("marginLeft", mcp.Description("Left margin in points")),
+		mcp.WithNumber("marginRight", mcp.Description("Right margin in points")),
+	)
+
+	// Define docs batch update tool
+	docsBatchUpdateTool := mcp.NewTool(
+		"docs_batch_update",
+		mcp.WithDescription("Execute raw Google Docs API batchUpdate requests on a document, for features the other tools do not cover. Each request is an object with one request type, e.g. {\"insertText\": {\"location\": {\"index\": 1}, \"text\": \"Hello\"}}. Requests are validated against the API schema and applied atomically"),
+		mcp.WithString("documentId", mcp.Description("The ID or URL of the Google Document"), mcp.Required()),
+		mcp.WithArray("requests", mcp.Description(fmt.Sprintf("Docs API Request objects, applied in order (at most %d)", gdrive.MaxBatchUpdateRequests)), mcp.Required(), mcp.Items(map[string]any{"type": "object"})),
+		mcp.WithString("requiredRevisionId", mcp.Description("Fail instead of applying the requests if the document has changed since this revision")),
+	)
+
+	// Define diff documents tool
+	diffDocumentsTool := mcp.NewTool(
+		"diff_documents",
+		mcp.WithDescription("Compare the text of two Google Documents, or two revisions of one document, line by line, e.g. to review what an automated edit changed. Without otherDocumentId, compares revisionId of documentId against otherRevisionId (default: the current content)"),
+		mcp.WithString("documentId", mcp.Description("The ID or URL of the base Google Document"), mcp.Required()),
+		mcp.WithString("revisionId", mcp.Description("Revision of the base document to compare (default: the current content)")),
+		mcp.WithString("otherDocumentId", mcp.Description("The ID or URL of the Google Document to compare against (default: documentId)")),
+		mcp.WithString("otherRevisionId", mcp.Description("Revision of the other document to compare (default: the current content)")),
+		mcp.WithString("format", mcp.Description("Output format: 'unified' for a unified diff, or 'structured' for JSON hunks (default: 'unified')"), mcp.Enum("unified", "structured"), mcp.DefaultString("unified")),
+		mcp.WithNumber("contextLines", mcp.Description("Number of unchanged lines shown around each change (default: 3)"), mcp.DefaultNumber(gdrive.DefaultDiffContext)),
+	)
+
+	return []Tool{
+		{Tool: getDocumentTool, Handler: createGetDocumentHandler(docEditor), Scopes: []string{docs.DocumentsScope}, ReadOnly: true},
+		{Tool: updateDocumentTool, Handler: createUpdateDocumentHandler(docEditor), Scopes: []string{docs.DocumentsScope}},
+		{Tool: appendTableRowsTool, Handler: createAppendTableRowsHandler(docEditor), Scopes: []string{docs.DocumentsScope}},
+		{Tool: replaceDocumentImageTool, Handler: createReplaceDocumentImageHandler(docEditor), Scopes: []string{docs.DocumentsScope}},
+		{Tool: getDocumentImagesTool, Handler: createGetDocumentImagesHandler(docEditor), Scopes: []string{docs.DocumentsScope}, ReadOnly: true},
+		{Tool: getDocumentStyleTool, Handler: createGetDocumentStyleHandler(docEditor), Scopes: []string{docs.DocumentsScope}, ReadOnly: true},
+		{Tool: updateDocumentStyleTool, Handler: createUpdateDocumentStyleHandler(docEditor), Scopes: []string{docs.DocumentsScope}},
+		{Tool: docsBatchUpdateTool, Handler: createDocsBatchUpdateHandler(docEditor), Scopes: []string{docs.DocumentsScope}},
+		{Tool: diffDocumentsTool, Handler: createDiffDocumentsHandler(docEditor), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+	}
+}
+
+func createGetDocumentHandler(docEditor gdrive.DocEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		documentID, err := requireFileID(request, "documentId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'documentId' is required"), nil
+		}
+
+		startIndex := mcp.ParseInt(request, "startIndex", 0)
+		maxChars := mcp.ParseInt(request, "maxChars", 0)
+		format, err := parseFormat(request, FormatText)
+		if err != nil {
+			return mcp.NewToolResultError("Invalid parameter 'format': " + err.Error()), nil
+		}
+
+		// Read a chunk when paginating
+		if startIndex > 0 || maxChars > 0 {
+			chunk, err := docEditor.GetDocumentChunk(ctx, documentID, startIndex, maxChars)
+			if err != nil {
+				return mcp.NewToolResultError("Failed to get document content: " + err.Error()), nil
+			}
+
+			resultData, err := json.Marshal(chunk)
+			if err != nil {
+				return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(resultData)), nil
+		}
+
+		if format != FormatText {
+			paragraphs, err := docEditor.GetDocumentParagraphs(ctx, documentID)
+			if err != nil {
+				return mcp.NewToolResultError("Failed to get document content: " + err.Error()), nil
+			}
+
+			rendered, err := renderParagraphs(documentID, paragraphs, format)
+			if err != nil {
+				return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(rendered), nil
+		}
+
+		// Get document content
+		content, err := docEditor.GetDocumentContent(ctx, documentID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get document content: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(content), nil
+	}
+}
+
+func createUpdateDocumentHandler(docEditor gdrive.DocEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		documentID, err := requireFileID(request, "documentId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'documentId' is required"), nil
+		}
+
+		content, err := request.RequireString("content")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'content' is required"), nil
+		}
+
+		// Update document content
+		err = docEditor.UpdateDocumentContent(ctx, documentID, content)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to update document: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText("Document updated successfully"), nil
+	}
+}
+
+func createAppendTableRowsHandler(docEditor gdrive.DocEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		documentID, err := requireFileID(request, "documentId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'documentId' is required"), nil
+		}
+
+		rowsParam, ok := request.GetArguments()["rows"].([]any)
+		if !ok || len(rowsParam) == 0 {
+			return mcp.NewToolResultError("Parameter 'rows' is required"), nil
+		}
+
+		// Convert rows to strings
+		rows := make([][]string, len(rowsParam))
+		for i, row := range rowsParam {
+			cells, ok := row.([]any)
+			if !ok {
+				return mcp.NewToolResultError("Invalid rows format: each row must be an array"), nil
+			}
+			rows[i] = make([]string, len(cells))
+			for j, cell := range cells {
+				if cell != nil {
+					rows[i][j] = fmt.Sprint(cell)
+				}
+			}
+		}
+
+		locator := gdrive.TableLocator{
+			Index:   mcp.ParseInt(request, "tableIndex", 0),
+			Heading: mcp.ParseString(request, "heading", ""),
+		}
+
+		// Append rows
+		result, err := docEditor.AppendTableRows(ctx, documentID, locator, rows)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to append table rows: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createReplaceDocumentImageHandler(docEditor gdrive.DocEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		documentID, err := requireFileID(request, "documentId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'documentId' is required"), nil
+		}
+
+		imageURL, err := request.RequireString("imageUrl")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'imageUrl' is required"), nil
+		}
+
+		locator := gdrive.ImageLocator{
+			ObjectID: mcp.ParseString(request, "imageObjectId", ""),
+			Index:    mcp.ParseInt(request, "imageIndex", 0),
+		}
+
+		// Replace image
+		objectID, err := docEditor.ReplaceDocumentImage(ctx, documentID, locator, imageURL)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to replace image: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		result := map[string]any{
+			"documentId":    documentID,
+			"imageObjectId": objectID,
+			"imageUrl":      imageURL,
+		}
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createGetDocumentImagesHandler(docEditor gdrive.DocEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		documentID, err := requireFileID(request, "documentId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'documentId' is required"), nil
+		}
+
+		includeData := mcp.ParseBoolean(request, "includeData", false)
+
+		// Get images
+		images, err := docEditor.GetDocumentImages(ctx, documentID, includeData)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get document images: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		result := map[string]any{
+			"documentId": documentID,
+			"images":     images,
+		}
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		// Follow the listing with the downloaded images, in the same order
+		toolResult := mcp.NewToolResultText(string(resultData))
+		for _, image := range images {
+			if len(image.Data) > 0 {
+				toolResult.Content = append(toolResult.Content, mcp.NewImageContent(base64.StdEncoding.EncodeToString(image.Data), image.MimeType))
+			}
+		}
+
+		return toolResult, nil
+	}
+}
+
+func createGetDocumentStyleHandler(docEditor gdrive.DocEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		documentID, err := requireFileID(request, "documentId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'documentId' is required"), nil
+		}
+
+		// Get document style
+		settings, err := docEditor.GetDocumentStyle(ctx, documentID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get document style: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		resultData, err := json.Marshal(settings)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createUpdateDocumentStyleHandler(docEditor gdrive.DocEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		documentID, err := requireFileID(request, "documentId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'documentId' is required"), nil
+		}
+
+		// Only change the settings present in the request
+		args := request.GetArguments()
+		update := gdrive.DocumentStyleUpdate{
+			PageSize: mcp.ParseString(request, "pageSize", ""),
+		}
+		lengths := map[string]**float64{
+			"pageWidth":    &update.PageWidth,
+			"pageHeight":   &update.PageHeight,
+			"marginTop":    &update.MarginTop,
+			"marginBottom": &update.MarginBottom,
+			"marginLeft":   &update.MarginLeft,
+			"marginRight":  &update.MarginRight,
+		}
+		for name, field := range lengths {
+			if _, ok := args[name]; ok {
+				value := mcp.ParseFloat64(request, name, 0)
+				*field = &value
+			}
+		}
+		if _, ok := args["landscape"]; ok {
+			update.Landscape = mcp.ToBoolPtr(mcp.ParseBoolean(request, "landscape", false))
+		}
+
+		// Update document style
+		settings, err := docEditor.UpdateDocumentStyle(ctx, documentID, update)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to update document style: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		resultData, err := json.Marshal(settings)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createDocsBatchUpdateHandler(docEditor gdrive.DocEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		documentID, err := requireFileID(request, "documentId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'documentId' is required"), nil
+		}
+
+		requests, errResult := rawRequests(request)
+		if errResult != nil {
+			return errResult, nil
+		}
+
+		// Execute requests
+		resp, err := docEditor.BatchUpdateDocument(ctx, documentID, requests, mcp.ParseString(request, "requiredRevisionId", ""))
+		if err != nil {
+			return mcp.NewToolResultError("Failed to update document: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		resultData, err := json.Marshal(resp)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createDiffDocumentsHandler(docEditor gdrive.DocEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		documentID, err := requireFileID(request, "documentId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'documentId' is required"), nil
+		}
+
+		otherDocumentID := gdrive.ResolveFileID(mcp.ParseString(request, "otherDocumentId", ""))
+		if otherDocumentID == "" {
+			otherDocumentID = documentID
+		}
+
+		base := gdrive.DocumentVersion{DocumentID: documentID, RevisionID: mcp.ParseString(request, "revisionId", "")}
+		other := gdrive.DocumentVersion{DocumentID: otherDocumentID, RevisionID: mcp.ParseString(request, "otherRevisionId", "")}
+
+		format := mcp.ParseString(request, "format", "unified")
+		if format != "unified" && format != "structured" {
+			return mcp.NewToolResultError("Parameter 'format' must be 'unified' or 'structured'"), nil
+		}
+
+		// Compare documents
+		diff, err := docEditor.DiffDocuments(ctx, base, other, mcp.ParseInt(request, "contextLines", gdrive.DefaultDiffContext))
+		if err != nil {
+			return mcp.NewToolResultError("Failed to diff documents: " + err.Error()), nil
+		}
+
+		if format == "unified" {
+			if diff.Identical {
+				return mcp.NewToolResultText("Documents are identical"), nil
+			}
+			return mcp.NewToolResultText(diff.Unified()), nil
+		}
+
+		// Convert result to JSON
+		resultData, err := json.Marshal(diff)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
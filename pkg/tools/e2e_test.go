@@ -0,0 +1,150 @@
+package tools_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/kitagry/drive-mcp/internal/fakegoogle"
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+	"github.com/kitagry/drive-mcp/pkg/tools"
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/api/drive/v3"
+)
+
+// newFakeTools starts a fake Google API server and returns it with the default tools backed by a DriveService
+// pointed at it
+func newFakeTools(t *testing.T) (*fakegoogle.Server, map[string]tools.Tool) {
+	t.Helper()
+
+	fake := fakegoogle.NewServer()
+	t.Cleanup(fake.Close)
+
+	ds, err := gdrive.NewDriveService(context.Background(), gdrive.WithEndpoint(fake.Endpoint()), gdrive.WithHTTPClient(fake.Client()))
+	if err != nil {
+		t.Fatalf("NewDriveService: %v", err)
+	}
+
+	byName := make(map[string]tools.Tool)
+	for _, tool := range tools.NewDefaultRegistry(ds).Tools() {
+		byName[tool.Tool.Name] = tool
+	}
+	return fake, byName
+}
+
+// callTool calls the named tool with args and returns the text of a successful result
+func callTool(t *testing.T, byName map[string]tools.Tool, name string, args map[string]any) string {
+	t.Helper()
+
+	tool, ok := byName[name]
+	if !ok {
+		t.Fatalf("tool %s is not registered", name)
+	}
+	var request mcp.CallToolRequest
+	request.Params.Name = name
+	request.Params.Arguments = args
+	result, err := tool.Handler(context.Background(), request)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	if len(result.Content) != 1 {
+		t.Fatalf("%s: got %d contents, want 1", name, len(result.Content))
+	}
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatalf("%s: got %T content, want text", name, result.Content[0])
+	}
+	if result.IsError {
+		t.Fatalf("%s failed: %s", name, text.Text)
+	}
+	return text.Text
+}
+
+func TestListFilesE2E(t *testing.T) {
+	fake, byName := newFakeTools(t)
+	fake.AddFile(&drive.File{Id: "file1", Name: "notes.txt", MimeType: "text/plain", Parents: []string{"root"}})
+	fake.AddFile(&drive.File{Id: "folder1", Name: "Projects", MimeType: "application/vnd.google-apps.folder", Parents: []string{"root"}})
+	fake.AddFile(&drive.File{Id: "file2", Name: "plan.txt", MimeType: "text/plain", Parents: []string{"folder1"}})
+
+	var result struct {
+		Count int                `json:"count"`
+		Files []gdrive.DriveFile `json:"files"`
+	}
+	if err := json.Unmarshal([]byte(callTool(t, byName, "list_files", map[string]any{"folderId": "folder1"})), &result); err != nil {
+		t.Fatalf("invalid result: %v", err)
+	}
+	if result.Count != 1 || len(result.Files) != 1 || result.Files[0].ID != "file2" {
+		t.Errorf("list_files = %+v, want only file2", result)
+	}
+}
+
+func TestGetFileMetadataE2E(t *testing.T) {
+	fake, byName := newFakeTools(t)
+	fake.AddFile(&drive.File{Id: "file1", Name: "notes.txt", MimeType: "text/plain", Parents: []string{"root"}})
+
+	// A pasted URL is accepted in place of the ID
+	var file struct {
+		ID       string   `json:"id"`
+		Name     string   `json:"name"`
+		MimeType string   `json:"mimeType"`
+		Parents  []string `json:"parents"`
+	}
+	text := callTool(t, byName, "get_file_metadata", map[string]any{"fileId": "https://drive.google.com/file/d/file1/view"})
+	if err := json.Unmarshal([]byte(text), &file); err != nil {
+		t.Fatalf("invalid result: %v", err)
+	}
+	if file.ID != "file1" || file.Name != "notes.txt" || file.MimeType != "text/plain" || len(file.Parents) != 1 || file.Parents[0] != "root" {
+		t.Errorf("get_file_metadata = %+v", file)
+	}
+}
+
+func TestExportFileE2E(t *testing.T) {
+	fake, byName := newFakeTools(t)
+	fake.AddDocument("doc1", "Meeting notes", "Hello")
+
+	var export struct {
+		Name     string `json:"name"`
+		MimeType string `json:"mimeType"`
+		Content  string `json:"content"`
+	}
+	if err := json.Unmarshal([]byte(callTool(t, byName, "export_file", map[string]any{"fileId": "doc1", "format": "txt"})), &export); err != nil {
+		t.Fatalf("invalid result: %v", err)
+	}
+	content, err := base64.StdEncoding.DecodeString(export.Content)
+	if err != nil {
+		t.Fatalf("invalid content: %v", err)
+	}
+	if export.Name != "Meeting notes.txt" || export.MimeType != "text/plain" || string(content) != "Hello\n" {
+		t.Errorf("export_file = %+v with content %q", export, content)
+	}
+}
+
+func TestUpdateDocumentE2E(t *testing.T) {
+	fake, byName := newFakeTools(t)
+	fake.AddDocument("doc1", "Meeting notes", "Hello")
+
+	callTool(t, byName, "update_document", map[string]any{"documentId": "doc1", "content": "Goodbye"})
+
+	if text, _ := fake.DocumentText("doc1"); text != "Goodbye\n" {
+		t.Errorf("document text = %q, want %q", text, "Goodbye\n")
+	}
+	if got := callTool(t, byName, "get_document", map[string]any{"documentId": "doc1"}); got != "Goodbye\n" {
+		t.Errorf("get_document = %q, want %q", got, "Goodbye\n")
+	}
+}
+
+func TestUpdateFileMetadataE2E(t *testing.T) {
+	fake, byName := newFakeTools(t)
+	fake.AddFile(&drive.File{Id: "file1", Name: "notes.txt", MimeType: "text/plain", Parents: []string{"root"}})
+
+	callTool(t, byName, "update_file_metadata", map[string]any{"fileId": "file1", "description": "Weekly notes", "starred": true})
+
+	file, ok := fake.File("file1")
+	if !ok {
+		t.Fatal("file1 is gone")
+	}
+	if file.Description != "Weekly notes" || !file.Starred {
+		t.Errorf("file1 description = %q, starred = %v", file.Description, file.Starred)
+	}
+}
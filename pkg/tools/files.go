@@ -0,0 +1,1310 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/driveactivity/v2"
+	"google.golang.org/api/drivelabels/v2"
+	"google.golang.org/api/slides/v1"
+)
+
+// fieldsDescription describes the fields parameter shared by file metadata tools
+const fieldsDescription = "Additional Drive file fields to return besides id, name, and mimeType (e.g., 'size', 'modifiedTime', 'owners(emailAddress)'). Omit for the lightest response"
+
+// FileTools returns the Google Drive file tools backed by fileStore
+func FileTools(fileStore gdrive.FileStore) []Tool {
+	// Define file search tool
+	searchFilesTool := mcp.NewTool(
+		"search_files",
+		mcp.WithDescription("Search files in Google Drive by name, narrowed by optional filters on type, modification time, owner, folder, and trashed or starred state. Give a query, at least one filter, or both"),
+		mcp.WithString("query", mcp.Description("File name or keyword to search. If empty, files are matched by the filters alone")),
+		mcp.WithString("mimeType", mcp.Description("Only match files of this MIME type, e.g. 'application/pdf' or 'application/vnd.google-apps.spreadsheet'")),
+		mcp.WithString("modifiedAfter", mcp.Description("Only match files modified at or after this RFC 3339 timestamp or YYYY-MM-DD date (UTC)")),
+		mcp.WithString("modifiedBefore", mcp.Description("Only match files modified before this RFC 3339 timestamp or YYYY-MM-DD date (UTC)")),
+		mcp.WithString("owner", mcp.Description("Only match files owned by this email address, or 'me' for files owned by the signed-in user")),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of a folder; only files directly inside it are matched")),
+		mcp.WithBoolean("trashed", mcp.Description("Only match files in the trash when true, or outside it when false. If omitted, both are matched")),
+		mcp.WithBoolean("starred", mcp.Description("Only match starred files when true, or unstarred files when false. If omitted, both are matched")),
+		withDriveID(),
+		withPageSize(gdrive.DefaultPageSize),
+		withPageToken(),
+		withOrderBy(fileOrderByDescription),
+		mcp.WithArray("fields", mcp.Description(fieldsDescription), mcp.WithStringItems()),
+		mcp.WithBoolean("snippets", mcp.Description("Also search file content, and return snippets with character offsets around the matches in Google Docs (default: false). Slower, as each matching document is exported"), mcp.DefaultBool(false)),
+		withFormat(FormatJSON),
+	)
+
+	// Define search files by properties tool
+	searchFilesByPropertiesTool := mcp.NewTool(
+		"search_files_by_properties",
+		mcp.WithDescription("Find Google Drive files tagged with custom properties. Files must carry every given key with exactly the given value"),
+		mcp.WithObject("properties", mcp.Description("The property keys and values to match, e.g. {\"project\": \"apollo\", \"status\": \"final\"}"), mcp.Required(), mcp.AdditionalProperties(map[string]any{"type": "string"})),
+		mcp.WithBoolean("appProperties", mcp.Description("Match the properties private to this application (appProperties) instead of the public properties visible to all apps (default: false)"), mcp.DefaultBool(false)),
+		withDriveID(),
+		withPageSize(gdrive.DefaultPageSize),
+		withPageToken(),
+		withOrderBy(fileOrderByDescription),
+		mcp.WithArray("fields", mcp.Description(fieldsDescription), mcp.WithStringItems()),
+		withFormat(FormatJSON),
+	)
+
+	// Define list files tool
+	listFilesTool := mcp.NewTool(
+		"list_files",
+		mcp.WithDescription("List files in a Google Drive folder"),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of the folder to list files from. If empty, lists files in My Drive root, or at the top of the shared drive given by driveId")),
+		withDriveID(),
+		withPageSize(gdrive.DefaultPageSize),
+		withPageToken(),
+		withOrderBy(fileOrderByDescription),
+		mcp.WithArray("fields", mcp.Description(fieldsDescription), mcp.WithStringItems()),
+		withFormat(FormatJSON),
+	)
+
+	// Define list modified files tool
+	listModifiedFilesTool := mcp.NewTool(
+		"list_modified_files",
+		mcp.WithDescription("List files modified (or created) within a time range, most recent first. Useful for reports such as everything that changed in a project folder this week"),
+		mcp.WithString("since", mcp.Description("Start of the range, inclusive, as an RFC 3339 timestamp or YYYY-MM-DD date (UTC)"), mcp.Required()),
+		mcp.WithString("until", mcp.Description("End of the range, exclusive, as an RFC 3339 timestamp or YYYY-MM-DD date (UTC). If empty, the range has no end")),
+		mcp.WithString("timeField", mcp.Description("Which timestamp to match: 'modified' or 'created' (default: modified)"), mcp.Enum("modified", "created"), mcp.DefaultString("modified")),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of a folder; only files anywhere below it are listed. If empty, searches all of Drive")),
+		mcp.WithString("owner", mcp.Description("Only list files owned by this email address")),
+		withPageSize(gdrive.DefaultModifiedPageSize),
+		withPageToken(),
+		mcp.WithArray("fields", mcp.Description(fieldsDescription), mcp.WithStringItems()),
+		withFormat(FormatJSON),
+	)
+
+	// Define get changes start token tool
+	getChangesStartTokenTool := mcp.NewTool(
+		"get_changes_start_token",
+		mcp.WithDescription("Get a page token marking the current point in Google Drive's change history. Store it and pass it to list_changes later to see what changed since, instead of re-scanning folders"),
+	)
+
+	// Define list changes tool
+	listChangesTool := mcp.NewTool(
+		"list_changes",
+		mcp.WithDescription("List the files in My Drive and shared drives that were created, modified, trashed, or removed since a page token was issued, oldest change first. Pass nextPageToken back while it is returned; the last page returns newStartPageToken instead, to store for the next run"),
+		mcp.WithString("pageToken", mcp.Description("A token from get_changes_start_token, or the nextPageToken or newStartPageToken of a previous list_changes call"), mcp.Required()),
+		withPageSize(gdrive.DefaultChangePageSize),
+	)
+
+	// Define list largest files tool
+	listLargestFilesTool := mcp.NewTool(
+		"list_largest_files",
+		mcp.WithDescription("List the files using the most storage, largest first, with their size, owners, and last modification time. Useful for storage cleanup, e.g. finding what takes up space in My Drive or a project folder"),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of a folder; only files anywhere below it are listed. If empty, searches all of Drive")),
+		mcp.WithString("owner", mcp.Description("Only list files owned by this email address, or 'me' for files owned by the signed-in user")),
+		withPageSize(gdrive.DefaultLargestPageSize),
+		withPageToken(),
+		mcp.WithArray("fields", mcp.Description(fieldsDescription), mcp.WithStringItems()),
+		withFormat(FormatJSON),
+	)
+
+	// Define find duplicates tool
+	findDuplicatesTool := mcp.NewTool(
+		"find_duplicates",
+		mcp.WithDescription("Find sets of duplicate files in a Google Drive folder, optionally including its subfolders, by identical content, by name, or by name and size. Sets are listed with the storage their extra copies waste, most first, and each set's copies oldest first. Nothing is changed; trash unwanted copies with trash_file"),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of the folder or shared drive to scan"), mcp.Required()),
+		mcp.WithBoolean("recursive", mcp.Description("Also scan the folders anywhere below it (default: false)"), mcp.DefaultBool(false)),
+		mcp.WithString("matchBy", mcp.Description("How files match: 'checksum' for identical content, 'name', or 'nameAndSize' (default: checksum). Google Docs, Sheets, and Slides have no checksum or size, so they are only matched by name"), mcp.Enum(gdrive.DuplicatesByChecksum, gdrive.DuplicatesByName, gdrive.DuplicatesByNameAndSize), mcp.DefaultString(gdrive.DuplicatesByChecksum)),
+		mcp.WithNumber("limit", mcp.Description("Maximum number of duplicate sets to return (default: 100)"), mcp.DefaultNumber(gdrive.DefaultDuplicateSetLimit)),
+	)
+
+	// Define list recent files tool
+	listRecentFilesTool := mcp.NewTool(
+		"list_recent_files",
+		mcp.WithDescription("List the files most recently modified, or most recently viewed by the user, newest first. Useful for questions such as what the user worked on yesterday, without building a search query"),
+		mcp.WithString("by", mcp.Description("Which activity to order by: 'modified' for the last modification by anyone, or 'viewed' for the last time the user opened the file (default: modified)"), mcp.Enum("modified", "viewed"), mcp.DefaultString("modified")),
+		withDriveID(),
+		withPageSize(gdrive.DefaultPageSize),
+		withPageToken(),
+		mcp.WithArray("fields", mcp.Description(fieldsDescription), mcp.WithStringItems()),
+		withFormat(FormatJSON),
+	)
+
+	// Define list shared drives tool
+	listSharedDrivesTool := mcp.NewTool(
+		"list_shared_drives",
+		mcp.WithDescription("List the shared drives the user is a member of, with their IDs. Pass an ID as driveId to search_files or list_files to work within that drive"),
+		withPageSize(gdrive.DefaultPageSize),
+		withPageToken(),
+	)
+
+	// Define list trashed files tool
+	listTrashedFilesTool := mcp.NewTool(
+		"list_trashed_files",
+		mcp.WithDescription("List the files and folders in the Google Drive trash, with when each was trashed. Useful for reviewing what empty_trash would delete, or finding a file to restore"),
+		withPageSize(gdrive.DefaultPageSize),
+		withPageToken(),
+		withOrderBy(fileOrderByDescription),
+		mcp.WithArray("fields", mcp.Description(fieldsDescription), mcp.WithStringItems()),
+		withFormat(FormatJSON),
+	)
+
+	// Define list starred files tool
+	listStarredFilesTool := mcp.NewTool(
+		"list_starred_files",
+		mcp.WithDescription("List the files and folders starred in Google Drive, leaving out trashed ones. Stars can be set with star_file and cleared with unstar_file"),
+		withDriveID(),
+		withPageSize(gdrive.DefaultPageSize),
+		withPageToken(),
+		withOrderBy(fileOrderByDescription),
+		mcp.WithArray("fields", mcp.Description(fieldsDescription), mcp.WithStringItems()),
+		withFormat(FormatJSON),
+	)
+
+	// Define get file metadata tool
+	getFileMetadataTool := mcp.NewTool(
+		"get_file_metadata",
+		mcp.WithDescription("Get the metadata of a Google Drive file: size, owners, created and modified times, last modifying user, webViewLink, parents, shared, starred, and trashed state, and MD5 checksum"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+	)
+
+	// Define get file properties tool
+	getFilePropertiesTool := mcp.NewTool(
+		"get_file_properties",
+		mcp.WithDescription("Get the custom key/value properties of a Google Drive file: the public properties visible to all apps, and the appProperties private to this application. Set them with set_file_properties and find files by them with search_files_by_properties"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+	)
+
+	// Define list labels tool
+	listLabelsTool := mcp.NewTool(
+		"list_labels",
+		mcp.WithDescription("List the Drive labels files can be classified with, with their fields and the choices of selection fields, and whether the user can apply each label. Read a file's labels with get_file_labels and apply them with set_file_label"),
+		withPageSize(gdrive.DefaultLabelPageSize),
+		withPageToken(),
+	)
+
+	// Define get file labels tool
+	getFileLabelsTool := mcp.NewTool(
+		"get_file_labels",
+		mcp.WithDescription("List the Drive labels applied to a Google Drive file, with the values of their fields"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+	)
+
+	// Define list permissions tool
+	listPermissionsTool := mcp.NewTool(
+		"list_permissions",
+		mcp.WithDescription("List who can access a Google Drive file: each user, group, domain, or anyone-with-the-link permission with its role, email address, and domain. Useful for checking who can see a document before sharing anything about it"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		withPageSize(gdrive.DefaultPermissionPageSize),
+		withPageToken(),
+	)
+
+	// Define list revisions tool
+	listRevisionsTool := mcp.NewTool(
+		"list_revisions",
+		mcp.WithDescription("List the saved versions of a Google Drive file, oldest first, with each revision's ID, modification time, and last modifying user. Useful for reviewing a document's history before changing it; revision IDs can be passed to diff_documents"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		withPageSize(gdrive.DefaultRevisionPageSize),
+		withPageToken(),
+	)
+
+	// Define get revision content tool
+	getRevisionContentTool := mcp.NewTool(
+		"get_revision_content",
+		mcp.WithDescription("Get the content of a Google Drive file as it was at an earlier revision, without changing the file, e.g. to compare it with the current content. Google Docs, Sheets, Slides, and Drawings revisions are returned as text by default; other files as base64. At most 10MB"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithString("revisionId", mcp.Description("The ID of the revision, from list_revisions"), mcp.Required()),
+		mcp.WithString("format", mcp.Description("The format to export Google Workspace revisions as, by file extension, as for export_file. Defaults to txt for documents and presentations, csv for spreadsheets (first sheet only), and svg for drawings. Must be empty for other files")),
+	)
+
+	// Define list comments tool
+	listCommentsTool := mcp.NewTool(
+		"list_comments",
+		mcp.WithDescription("List the comments on a Google Drive file with their replies, oldest first, including the text each comment is anchored to and its author. Resolved comments are left out unless includeResolved is true"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithBoolean("includeResolved", mcp.Description("Also list resolved comments (default: false)"), mcp.DefaultBool(false)),
+		withPageSize(gdrive.DefaultCommentPageSize),
+		withPageToken(),
+	)
+
+	// Define get file activity tool
+	getFileActivityTool := mcp.NewTool(
+		"get_file_activity",
+		mcp.WithDescription("Get the history of a Google Drive file or folder from the Drive Activity API, newest first: edits, comments, moves, renames, permission changes, and more, each with who did it and when. Useful for finding out who changed a file and what happened to it"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file or folder"), mcp.Required()),
+		mcp.WithBoolean("includeContents", mcp.Description("For a folder, also report activity on the items anywhere inside it (default: false)"), mcp.DefaultBool(false)),
+		mcp.WithArray("actions", mcp.Description("Only report these actions. If empty, all are reported"), mcp.WithStringEnumItems([]string{"create", "edit", "move", "rename", "delete", "restore", "permissionChange", "comment", "dlpChange", "reference", "settingsChange", "appliedLabelChange"})),
+		mcp.WithString("since", mcp.Description("Only report activity at or after this RFC 3339 timestamp or YYYY-MM-DD date (UTC)")),
+		withPageSize(gdrive.DefaultActivityPageSize),
+		withPageToken(),
+	)
+
+	// Define get files metadata tool
+	getFilesMetadataTool := mcp.NewTool(
+		"get_files_metadata",
+		mcp.WithDescription("Get metadata for multiple Google Drive files in one call"),
+		mcp.WithArray("fileIds", mcp.Description("The IDs or URLs of the files"), mcp.Required(), mcp.WithStringItems()),
+		mcp.WithArray("fields", mcp.Description(fieldsDescription), mcp.WithStringItems()),
+	)
+
+	// Define download file tool
+	downloadFileTool := mcp.NewTool(
+		"download_file",
+		mcp.WithDescription("Download the content of a binary Google Drive file (e.g., PDF, image) as base64, in chunks. Large files are fetched over multiple calls by passing back continuationToken"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithNumber("chunkSize", mcp.Description("Maximum number of bytes to return per call (default: 1048576, max: 8388608)"), mcp.DefaultNumber(gdrive.DefaultChunkSize)),
+		mcp.WithString("continuationToken", mcp.Description("The continuationToken from the previous call, to fetch the next chunk")),
+	)
+
+	// Define get thumbnail tool
+	getThumbnailTool := mcp.NewTool(
+		"get_thumbnail",
+		mcp.WithDescription("Get a preview image of a Google Drive file as Drive renders it, e.g. the first page of a PDF or document or a downscaled photo, returned as image content so the file can be looked at. For a Google Slides presentation, slideIndex renders that slide instead"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithNumber("slideIndex", mcp.Description("For a presentation, the index of the slide to render (0-based). If omitted, the file's own thumbnail is returned")),
+		mcp.WithString("size", mcp.Description("The size of the image: small (200px), medium (800px), or large (1600px) on the longest edge (default: medium)"), mcp.Enum("small", "medium", "large"), mcp.DefaultString("medium")),
+	)
+
+	// Define export file tool
+	exportFileTool := mcp.NewTool(
+		"export_file",
+		mcp.WithDescription("Export a Google Docs, Sheets, Slides, or Drawings file to another format, e.g. a document to PDF or DOCX, a spreadsheet to XLSX or CSV, or a presentation to PDF or PPTX, returned as base64. Drive exports at most 10MB"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithString("format", mcp.Description(exportFormatDescription)),
+	)
+
+	// Define verify file tool
+	verifyFileTool := mcp.NewTool(
+		"verify_file",
+		mcp.WithDescription("Get the size and MD5/SHA-1/SHA-256 checksums of a Google Drive file's content, optionally comparing them against an expected hash. Useful for verifying uploads and detecting silent changes"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithString("expectedHash", mcp.Description("A hex MD5, SHA-1, or SHA-256 digest to compare against; the algorithm is inferred from its length")),
+	)
+
+	// Define get file parents tool
+	getFileParentsTool := mcp.NewTool(
+		"get_file_parents",
+		mcp.WithDescription("List every folder containing a Google Drive file, each with its full path from My Drive or the shared drive. Files can have several parents; for a shortcut, the parents of its target are reported as well"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+	)
+
+	// Define check capabilities tool
+	checkCapabilitiesTool := mcp.NewTool(
+		"check_capabilities",
+		mcp.WithDescription("Check what the current user may do with a Google Drive file (edit, comment, share, copy, download, rename, trash, delete, add children, move, read revisions). Use it before a planned change to know whether it will succeed"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+	)
+
+	// Define resolve shortcut tool
+	resolveShortcutTool := mcp.NewTool(
+		"resolve_shortcut",
+		mcp.WithDescription("Report whether a Google Drive file is a shortcut and return the ID and MIME type of the file it points to. Content tools already follow shortcuts automatically unless the server disables it"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+	)
+
+	// Define resolve path tool
+	resolvePathTool := mcp.NewTool(
+		"resolve_path",
+		mcp.WithDescription("Find the ID of a Google Drive file or folder from its path, such as '/Projects/2025/Q3 Report', by walking down from the top of My Drive or a shared drive. Several files are returned when names are duplicated"),
+		mcp.WithString("path", mcp.Description("Slash-separated path from the top of My Drive, optionally starting with 'My Drive', or of the shared drive given by driveId. Write a slash inside a name as '\\/'"), mcp.Required()),
+		mcp.WithString("driveId", mcp.Description("The ID or URL of a shared drive the path starts at, from list_shared_drives. If empty, the path starts at My Drive")),
+	)
+
+	// Define resolve URL tool
+	resolveURLTool := mcp.NewTool(
+		"resolve_url",
+		mcp.WithDescription("Resolve a docs.google.com or drive.google.com URL to its file ID and type. Tools taking a file ID also accept the URL directly"),
+		mcp.WithString("url", mcp.Description("The Google Docs or Drive URL"), mcp.Required()),
+	)
+
+	// Define extract PDF text tool
+	extractPDFTextTool := mcp.NewTool(
+		"extract_pdf_text",
+		mcp.WithDescription("Extract the text of a PDF stored in Google Drive, including scanned pages via OCR. The PDF is converted with Drive's import into a temporary Google Doc, which is deleted afterwards"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the PDF file"), mcp.Required()),
+		mcp.WithString("ocrLanguage", mcp.Description("ISO 639-1 code of the language of scanned text, e.g. 'ja', to improve OCR. If empty, Drive detects the language")),
+		mcp.WithBoolean("perPage", mcp.Description("Return the text of each page separately instead of as a whole (default: false)"), mcp.DefaultBool(false)),
+	)
+
+	return []Tool{
+		{Tool: searchFilesTool, Handler: createSearchFilesHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: searchFilesByPropertiesTool, Handler: createSearchFilesByPropertiesHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: listFilesTool, Handler: createListFilesHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: listModifiedFilesTool, Handler: createListModifiedFilesHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: getChangesStartTokenTool, Handler: createGetChangesStartTokenHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: listChangesTool, Handler: createListChangesHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: listLargestFilesTool, Handler: createListLargestFilesHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: findDuplicatesTool, Handler: createFindDuplicatesHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: listRecentFilesTool, Handler: createListRecentFilesHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: listSharedDrivesTool, Handler: createListSharedDrivesHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: listTrashedFilesTool, Handler: createListTrashedFilesHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: listStarredFilesTool, Handler: createListStarredFilesHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: getFileMetadataTool, Handler: createGetFileMetadataHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: getFilePropertiesTool, Handler: createGetFilePropertiesHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: listLabelsTool, Handler: createListLabelsHandler(fileStore), Scopes: []string{drivelabels.DriveLabelsReadonlyScope}, ReadOnly: true},
+		{Tool: getFileLabelsTool, Handler: createGetFileLabelsHandler(fileStore), Scopes: []string{drive.DriveScope, drivelabels.DriveLabelsReadonlyScope}, ReadOnly: true},
+		{Tool: listPermissionsTool, Handler: createListPermissionsHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: listRevisionsTool, Handler: createListRevisionsHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: getRevisionContentTool, Handler: createGetRevisionContentHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: listCommentsTool, Handler: createListCommentsHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: getFileActivityTool, Handler: createGetFileActivityHandler(fileStore), Scopes: []string{drive.DriveScope, driveactivity.DriveActivityReadonlyScope}, ReadOnly: true},
+		{Tool: getFilesMetadataTool, Handler: createGetFilesMetadataHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: downloadFileTool, Handler: createDownloadFileHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: getThumbnailTool, Handler: createGetThumbnailHandler(fileStore), Scopes: []string{drive.DriveScope, slides.PresentationsScope}, ReadOnly: true},
+		{Tool: exportFileTool, Handler: createExportFileHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: verifyFileTool, Handler: createVerifyFileHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: getFileParentsTool, Handler: createGetFileParentsHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: checkCapabilitiesTool, Handler: createCheckCapabilitiesHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: resolveShortcutTool, Handler: createResolveShortcutHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: resolvePathTool, Handler: createResolvePathHandler(fileStore), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: resolveURLTool, Handler: createResolveURLHandler(), ReadOnly: true},
+		{Tool: extractPDFTextTool, Handler: createExtractPDFTextHandler(fileStore), Scopes: []string{drive.DriveScope, docs.DocumentsScope}},
+	}
+}
+
+func createSearchFilesHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		query := mcp.ParseString(request, "query", "")
+		filter := gdrive.SearchFilter{
+			MimeType: mcp.ParseString(request, "mimeType", ""),
+			Owner:    mcp.ParseString(request, "owner", ""),
+			FolderID: gdrive.ResolveFileID(mcp.ParseString(request, "folderId", "")),
+		}
+		var err error
+		if modifiedAfter := mcp.ParseString(request, "modifiedAfter", ""); modifiedAfter != "" {
+			filter.ModifiedAfter, err = parseTime(modifiedAfter)
+			if err != nil {
+				return mcp.NewToolResultError("Invalid parameter 'modifiedAfter': " + err.Error()), nil
+			}
+		}
+		if modifiedBefore := mcp.ParseString(request, "modifiedBefore", ""); modifiedBefore != "" {
+			filter.ModifiedBefore, err = parseTime(modifiedBefore)
+			if err != nil {
+				return mcp.NewToolResultError("Invalid parameter 'modifiedBefore': " + err.Error()), nil
+			}
+		}
+
+		// Trashed and starred states are only matched when given
+		args := request.GetArguments()
+		if _, ok := args["trashed"]; ok {
+			filter.Trashed = mcp.ToBoolPtr(mcp.ParseBoolean(request, "trashed", false))
+		}
+		if _, ok := args["starred"]; ok {
+			filter.Starred = mcp.ToBoolPtr(mcp.ParseBoolean(request, "starred", false))
+		}
+
+		opts := parseListOptions(request, gdrive.DefaultPageSize)
+		format, err := parseFormat(request, FormatJSON)
+		if err != nil {
+			return mcp.NewToolResultError("Invalid parameter 'format': " + err.Error()), nil
+		}
+
+		if mcp.ParseBoolean(request, "snippets", false) {
+			// Execute Google Drive content search
+			results, err := fileStore.SearchFilesWithSnippets(ctx, query, filter, opts)
+			if err != nil {
+				return mcp.NewToolResultError("Failed to search files: " + err.Error()), nil
+			}
+
+			rendered, err := renderSearchResults(results, format)
+			if err != nil {
+				return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(rendered), nil
+		}
+
+		// Execute Google Drive search
+		files, err := fileStore.SearchFiles(ctx, query, filter, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to search files: " + err.Error()), nil
+		}
+
+		rendered, err := renderFiles(files, format)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(rendered), nil
+	}
+}
+
+func createSearchFilesByPropertiesHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		rawProperties, ok := request.GetArguments()["properties"].(map[string]any)
+		if !ok || len(rawProperties) == 0 {
+			return mcp.NewToolResultError("Parameter 'properties' is required"), nil
+		}
+
+		properties := make(map[string]string, len(rawProperties))
+		for key, value := range rawProperties {
+			s, ok := value.(string)
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("Property '%s' must be a string", key)), nil
+			}
+			properties[key] = s
+		}
+
+		appProperties := mcp.ParseBoolean(request, "appProperties", false)
+		opts := parseListOptions(request, gdrive.DefaultPageSize)
+		format, err := parseFormat(request, FormatJSON)
+		if err != nil {
+			return mcp.NewToolResultError("Invalid parameter 'format': " + err.Error()), nil
+		}
+
+		// Execute Google Drive property search
+		files, err := fileStore.SearchFilesByProperties(ctx, properties, appProperties, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to search files by properties: " + err.Error()), nil
+		}
+
+		rendered, err := renderFiles(files, format)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(rendered), nil
+	}
+}
+
+func createListFilesHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		folderID := gdrive.ResolveFileID(mcp.ParseString(request, "folderId", ""))
+		opts := parseListOptions(request, gdrive.DefaultPageSize)
+		format, err := parseFormat(request, FormatJSON)
+		if err != nil {
+			return mcp.NewToolResultError("Invalid parameter 'format': " + err.Error()), nil
+		}
+
+		// Execute Google Drive list
+		files, err := fileStore.ListFiles(ctx, folderID, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to list files: " + err.Error()), nil
+		}
+
+		rendered, err := renderFiles(files, format)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(rendered), nil
+	}
+}
+
+func createListModifiedFilesHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		sinceParam, err := request.RequireString("since")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'since' is required"), nil
+		}
+
+		since, err := parseTime(sinceParam)
+		if err != nil {
+			return mcp.NewToolResultError("Invalid parameter 'since': " + err.Error()), nil
+		}
+
+		var until time.Time
+		if untilParam := mcp.ParseString(request, "until", ""); untilParam != "" {
+			until, err = parseTime(untilParam)
+			if err != nil {
+				return mcp.NewToolResultError("Invalid parameter 'until': " + err.Error()), nil
+			}
+		}
+
+		query := gdrive.ModifiedFilesQuery{
+			Since:    since,
+			Until:    until,
+			Created:  mcp.ParseString(request, "timeField", "modified") == "created",
+			FolderID: gdrive.ResolveFileID(mcp.ParseString(request, "folderId", "")),
+			Owner:    mcp.ParseString(request, "owner", ""),
+		}
+		opts := parseListOptions(request, gdrive.DefaultModifiedPageSize)
+		format, err := parseFormat(request, FormatJSON)
+		if err != nil {
+			return mcp.NewToolResultError("Invalid parameter 'format': " + err.Error()), nil
+		}
+
+		// List files changed in range
+		files, err := fileStore.ListModifiedFiles(ctx, query, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to list modified files: " + err.Error()), nil
+		}
+
+		rendered, err := renderFiles(files, format)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(rendered), nil
+	}
+}
+
+func createGetChangesStartTokenHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get start page token
+		token, err := fileStore.GetStartPageToken(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get start page token: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(map[string]string{"startPageToken": token})
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createListChangesHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		if _, err := request.RequireString("pageToken"); err != nil {
+			return mcp.NewToolResultError("Parameter 'pageToken' is required"), nil
+		}
+
+		opts := parseListOptions(request, gdrive.DefaultChangePageSize)
+
+		// List changes since token
+		changes, err := fileStore.ListChanges(ctx, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to list changes: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(changes)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createListLargestFilesHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		query := gdrive.LargestFilesQuery{
+			FolderID: gdrive.ResolveFileID(mcp.ParseString(request, "folderId", "")),
+			Owner:    mcp.ParseString(request, "owner", ""),
+		}
+		opts := parseListOptions(request, gdrive.DefaultLargestPageSize)
+		format, err := parseFormat(request, FormatJSON)
+		if err != nil {
+			return mcp.NewToolResultError("Invalid parameter 'format': " + err.Error()), nil
+		}
+
+		// List files by size
+		files, err := fileStore.ListLargestFiles(ctx, query, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to list largest files: " + err.Error()), nil
+		}
+
+		rendered, err := renderFiles(files, format)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(rendered), nil
+	}
+}
+
+func createFindDuplicatesHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		folderID, err := requireFileID(request, "folderId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'folderId' is required"), nil
+		}
+
+		query := gdrive.DuplicatesQuery{
+			FolderID:  folderID,
+			Recursive: mcp.ParseBoolean(request, "recursive", false),
+			MatchBy:   mcp.ParseString(request, "matchBy", gdrive.DuplicatesByChecksum),
+			Limit:     mcp.ParseInt(request, "limit", gdrive.DefaultDuplicateSetLimit),
+		}
+
+		// Find duplicate files
+		report, err := fileStore.FindDuplicates(ctx, query)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to find duplicates: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(report)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createListRecentFilesHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		viewed := mcp.ParseString(request, "by", "modified") == "viewed"
+		opts := parseListOptions(request, gdrive.DefaultPageSize)
+		format, err := parseFormat(request, FormatJSON)
+		if err != nil {
+			return mcp.NewToolResultError("Invalid parameter 'format': " + err.Error()), nil
+		}
+
+		// List recent files
+		files, err := fileStore.ListRecentFiles(ctx, viewed, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to list recent files: " + err.Error()), nil
+		}
+
+		rendered, err := renderFiles(files, format)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(rendered), nil
+	}
+}
+
+func createListSharedDrivesHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		opts := parseListOptions(request, gdrive.DefaultPageSize)
+
+		// List shared drives
+		drives, err := fileStore.ListSharedDrives(ctx, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to list shared drives: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(drives)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createListTrashedFilesHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		opts := parseListOptions(request, gdrive.DefaultPageSize)
+		format, err := parseFormat(request, FormatJSON)
+		if err != nil {
+			return mcp.NewToolResultError("Invalid parameter 'format': " + err.Error()), nil
+		}
+
+		// List trash
+		files, err := fileStore.ListTrashedFiles(ctx, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to list trashed files: " + err.Error()), nil
+		}
+
+		rendered, err := renderFiles(files, format)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(rendered), nil
+	}
+}
+
+func createListStarredFilesHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		opts := parseListOptions(request, gdrive.DefaultPageSize)
+		format, err := parseFormat(request, FormatJSON)
+		if err != nil {
+			return mcp.NewToolResultError("Invalid parameter 'format': " + err.Error()), nil
+		}
+
+		// List starred files
+		files, err := fileStore.ListStarredFiles(ctx, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to list starred files: " + err.Error()), nil
+		}
+
+		rendered, err := renderFiles(files, format)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(rendered), nil
+	}
+}
+
+// parseTime parses an RFC 3339 timestamp or a YYYY-MM-DD date in UTC
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected an RFC 3339 timestamp or YYYY-MM-DD date, got %q", value)
+	}
+	return t, nil
+}
+
+func createGetFileMetadataHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		// Get metadata
+		metadata, err := fileStore.GetFileMetadata(ctx, fileID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get file metadata: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(metadata)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createGetFilePropertiesHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		// Get file properties
+		properties, err := fileStore.GetFileProperties(ctx, fileID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get file properties: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(properties)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createListLabelsHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		opts := parseListOptions(request, gdrive.DefaultLabelPageSize)
+
+		// List labels
+		labels, err := fileStore.ListLabels(ctx, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to list labels: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(labels)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createGetFileLabelsHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		// Get file labels
+		labels, err := fileStore.GetFileLabels(ctx, fileID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get file labels: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(labels)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createListPermissionsHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		opts := parseListOptions(request, gdrive.DefaultPermissionPageSize)
+
+		// List permissions
+		permissions, err := fileStore.ListPermissions(ctx, fileID, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to list permissions: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		result := listResult(map[string]any{
+			"permissions": permissions.Permissions,
+			"count":       len(permissions.Permissions),
+		}, permissions.NextPageToken)
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createListRevisionsHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		opts := parseListOptions(request, gdrive.DefaultRevisionPageSize)
+
+		// List revisions
+		revisions, err := fileStore.ListRevisions(ctx, fileID, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to list revisions: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		result := listResult(map[string]any{
+			"revisions": revisions.Revisions,
+			"count":     len(revisions.Revisions),
+		}, revisions.NextPageToken)
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createGetRevisionContentHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		revisionID, err := request.RequireString("revisionId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'revisionId' is required"), nil
+		}
+
+		format := mcp.ParseString(request, "format", "")
+
+		// Get revision content
+		content, err := fileStore.GetRevisionContent(ctx, fileID, revisionID, format)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get revision content: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(content)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createListCommentsHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		includeResolved := mcp.ParseBoolean(request, "includeResolved", false)
+		opts := parseListOptions(request, gdrive.DefaultCommentPageSize)
+
+		// List comments
+		comments, err := fileStore.ListComments(ctx, fileID, includeResolved, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to list comments: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(comments)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createGetFileActivityHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		query := gdrive.ActivityQuery{
+			IncludeContents: mcp.ParseBoolean(request, "includeContents", false),
+			Actions:         request.GetStringSlice("actions", nil),
+		}
+		if sinceParam := mcp.ParseString(request, "since", ""); sinceParam != "" {
+			query.Since, err = parseTime(sinceParam)
+			if err != nil {
+				return mcp.NewToolResultError("Invalid parameter 'since': " + err.Error()), nil
+			}
+		}
+		opts := parseListOptions(request, gdrive.DefaultActivityPageSize)
+
+		// Get activity
+		activity, err := fileStore.GetFileActivity(ctx, fileID, query, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get file activity: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(activity)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createGetFilesMetadataHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileIDs, err := request.RequireStringSlice("fileIds")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileIds' is required"), nil
+		}
+		for i, fileID := range fileIDs {
+			fileIDs[i] = gdrive.ResolveFileID(fileID)
+		}
+
+		fields := request.GetStringSlice("fields", nil)
+
+		// Get metadata for all files
+		files, err := fileStore.GetFilesMetadata(ctx, fileIDs, fields)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get files metadata: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		result := map[string]any{
+			"files": files,
+			"count": len(files),
+		}
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createDownloadFileHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		chunkSize := mcp.ParseInt64(request, "chunkSize", gdrive.DefaultChunkSize)
+		continuationToken := mcp.ParseString(request, "continuationToken", "")
+
+		// Download file chunk
+		chunk, err := fileStore.DownloadFileChunk(ctx, fileID, continuationToken, chunkSize)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to download file: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(chunk)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createGetThumbnailHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		var slideIndex *int
+		args := request.GetArguments()
+		if _, ok := args["slideIndex"]; ok {
+			index := mcp.ParseInt(request, "slideIndex", 0)
+			slideIndex = &index
+		}
+		size := mcp.ParseString(request, "size", "medium")
+
+		// Get thumbnail
+		thumbnail, err := fileStore.GetThumbnail(ctx, fileID, slideIndex, size)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get thumbnail: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(thumbnail)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		// Follow the description with the image itself
+		toolResult := mcp.NewToolResultText(string(resultData))
+		toolResult.Content = append(toolResult.Content, mcp.NewImageContent(base64.StdEncoding.EncodeToString(thumbnail.Data), thumbnail.ImageMimeType))
+
+		return toolResult, nil
+	}
+}
+
+// exportFormatDescription describes the format parameter of tools exporting Google Workspace files
+const exportFormatDescription = "The format to export Google Workspace files as, by file extension. " +
+	"Documents: docx, odt, rtf, pdf, txt, md, or epub; spreadsheets: xlsx, ods, pdf, csv, or tsv (csv and tsv hold the first sheet only); " +
+	"presentations: pptx, odp, pdf, or txt; drawings: png, jpg, svg, or pdf. Defaults to docx, xlsx, pptx, or png"
+
+func createExportFileHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		format := mcp.ParseString(request, "format", "")
+
+		// Export file
+		exported, err := fileStore.ExportFile(ctx, fileID, format)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to export file: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(exported)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+// SaveFileTool returns the save_file tool backed by fileStore. It writes files to the machine the server runs on,
+// so it is not part of the default registry: register it only for a local server talking to its client over stdio,
+// never for one serving remote clients.
+func SaveFileTool(fileStore gdrive.FileStore) Tool {
+	// Define save file tool
+	saveFileTool := mcp.NewTool(
+		"save_file",
+		mcp.WithDescription("Download a Google Drive file in full to a path on the machine running the server, without passing the content through the client. Google Docs, Sheets, Slides, and Drawings are exported, e.g. to PDF or an Office format"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithString("path", mcp.Description("The local file to write, preferably as an absolute path. An existing directory saves the file inside it under its Drive name"), mcp.Required()),
+		mcp.WithString("format", mcp.Description(exportFormatDescription)),
+		mcp.WithBoolean("overwrite", mcp.Description("Replace the local file if it already exists (default: false)"), mcp.DefaultBool(false)),
+	)
+
+	return Tool{Tool: saveFileTool, Handler: createSaveFileHandler(fileStore), Scopes: []string{drive.DriveScope}}
+}
+
+func createSaveFileHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		localPath, err := request.RequireString("path")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'path' is required"), nil
+		}
+
+		format := mcp.ParseString(request, "format", "")
+		overwrite := mcp.ParseBoolean(request, "overwrite", false)
+
+		// Save file
+		saved, err := fileStore.SaveFile(ctx, fileID, localPath, format, overwrite)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to save file: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(saved)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createVerifyFileHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		expectedHash := mcp.ParseString(request, "expectedHash", "")
+
+		// Verify file
+		integrity, err := fileStore.VerifyFile(ctx, fileID, expectedHash)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to verify file: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(integrity)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createGetFileParentsHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		// Get parents
+		parents, err := fileStore.GetFileParents(ctx, fileID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get file parents: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(parents)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createCheckCapabilitiesHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		// Get capabilities
+		capabilities, err := fileStore.GetFileCapabilities(ctx, fileID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to check capabilities: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(capabilities)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createResolveShortcutHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		// Resolve shortcut
+		info, err := fileStore.ResolveShortcut(ctx, fileID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to resolve shortcut: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(info)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createResolvePathHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		path, err := request.RequireString("path")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'path' is required"), nil
+		}
+
+		driveID := gdrive.ResolveFileID(mcp.ParseString(request, "driveId", ""))
+
+		// Resolve path
+		resolved, err := fileStore.ResolvePath(ctx, path, driveID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to resolve path: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(resolved)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createExtractPDFTextHandler(fileStore gdrive.FileStore) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		ocrLanguage := mcp.ParseString(request, "ocrLanguage", "")
+		perPage := mcp.ParseBoolean(request, "perPage", false)
+
+		// Extract text
+		text, err := fileStore.ExtractPDFText(ctx, fileID, ocrLanguage, perPage)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to extract PDF text: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(text)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
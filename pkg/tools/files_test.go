@@ -0,0 +1,201 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+	"github.com/kitagry/drive-mcp/pkg/gdrive/gdrivemock"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// callHandler calls handler with args and returns the result's text and whether it is an error
+func callHandler(t *testing.T, handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), args map[string]any) (string, bool) {
+	t.Helper()
+
+	var request mcp.CallToolRequest
+	request.Params.Arguments = args
+	result, err := handler(context.Background(), request)
+	if err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	if len(result.Content) != 1 {
+		t.Fatalf("got %d contents, want 1", len(result.Content))
+	}
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatalf("got %T content, want text", result.Content[0])
+	}
+	return text.Text, result.IsError
+}
+
+func TestGetFileMetadataHandler(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      map[string]any
+		metadata  *gdrive.FileMetadata
+		err       error
+		wantText  string
+		wantError bool
+		wantID    string
+	}{
+		{
+			name:      "missing fileId",
+			args:      map[string]any{},
+			wantText:  "Parameter 'fileId' is required",
+			wantError: true,
+		},
+		{
+			name:      "service error",
+			args:      map[string]any{"fileId": "file1"},
+			err:       errors.New("file not found"),
+			wantText:  "Failed to get file metadata: file not found",
+			wantError: true,
+			wantID:    "file1",
+		},
+		{
+			name:     "metadata",
+			args:     map[string]any{"fileId": "file1"},
+			metadata: &gdrive.FileMetadata{ID: "file1", Name: "notes.txt", MimeType: "text/plain", Size: 5},
+			wantText: `{"id":"file1","name":"notes.txt","mimeType":"text/plain","size":5,"createdTime":"","modifiedTime":"","shared":false,"starred":false,"trashed":false}`,
+			wantID:   "file1",
+		},
+		{
+			name:     "URL in place of the ID",
+			args:     map[string]any{"fileId": "https://drive.google.com/file/d/file1/view"},
+			metadata: &gdrive.FileMetadata{ID: "file1"},
+			wantID:   "file1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fileStore := &gdrivemock.FileStoreMock{
+				GetFileMetadataFunc: func(ctx context.Context, fileID string) (*gdrive.FileMetadata, error) {
+					return tt.metadata, tt.err
+				},
+			}
+
+			text, isError := callHandler(t, createGetFileMetadataHandler(fileStore), tt.args)
+			if isError != tt.wantError {
+				t.Errorf("isError = %v, want %v (%s)", isError, tt.wantError, text)
+			}
+			if tt.wantText != "" && text != tt.wantText {
+				t.Errorf("text = %s, want %s", text, tt.wantText)
+			}
+
+			calls := fileStore.GetFileMetadataCalls()
+			if tt.wantID == "" {
+				if len(calls) != 0 {
+					t.Errorf("GetFileMetadata called %d times, want 0", len(calls))
+				}
+				return
+			}
+			if len(calls) != 1 || calls[0].FileID != tt.wantID {
+				t.Errorf("GetFileMetadata calls = %+v, want one for %s", calls, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestListFilesHandler(t *testing.T) {
+	t.Run("service error", func(t *testing.T) {
+		fileStore := &gdrivemock.FileStoreMock{
+			ListFilesFunc: func(ctx context.Context, folderID string, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+				return nil, errors.New("quota exceeded")
+			},
+		}
+
+		text, isError := callHandler(t, createListFilesHandler(fileStore), map[string]any{})
+		if !isError || text != "Failed to list files: quota exceeded" {
+			t.Errorf("result = %q (isError %v)", text, isError)
+		}
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		fileStore := &gdrivemock.FileStoreMock{}
+
+		_, isError := callHandler(t, createListFilesHandler(fileStore), map[string]any{"format": "xml"})
+		if !isError {
+			t.Error("isError = false, want true")
+		}
+		if len(fileStore.ListFilesCalls()) != 0 {
+			t.Error("ListFiles called for an invalid format")
+		}
+	})
+
+	t.Run("files", func(t *testing.T) {
+		fileStore := &gdrivemock.FileStoreMock{
+			ListFilesFunc: func(ctx context.Context, folderID string, opts gdrive.ListOptions) (*gdrive.FileList, error) {
+				return &gdrive.FileList{
+					Files:         []gdrive.DriveFile{{ID: "file1", Name: "notes.txt", Type: "text/plain"}},
+					NextPageToken: "next",
+				}, nil
+			},
+		}
+
+		text, isError := callHandler(t, createListFilesHandler(fileStore), map[string]any{
+			"folderId":  "https://drive.google.com/drive/folders/folder1",
+			"pageSize":  float64(10),
+			"pageToken": "token",
+		})
+		if isError {
+			t.Fatalf("unexpected error: %s", text)
+		}
+
+		var result struct {
+			Files         []gdrive.DriveFile `json:"files"`
+			Count         int                `json:"count"`
+			NextPageToken string             `json:"nextPageToken"`
+		}
+		if err := json.Unmarshal([]byte(text), &result); err != nil {
+			t.Fatalf("invalid JSON %s: %v", text, err)
+		}
+		if result.Count != 1 || len(result.Files) != 1 || result.Files[0].ID != "file1" || result.NextPageToken != "next" {
+			t.Errorf("result = %+v", result)
+		}
+
+		calls := fileStore.ListFilesCalls()
+		if len(calls) != 1 {
+			t.Fatalf("ListFiles called %d times, want 1", len(calls))
+		}
+		if calls[0].FolderID != "folder1" || calls[0].Opts.PageSize != 10 || calls[0].Opts.PageToken != "token" {
+			t.Errorf("ListFiles called with folder %q and options %+v", calls[0].FolderID, calls[0].Opts)
+		}
+	})
+}
+
+func TestGetFilesMetadataHandler(t *testing.T) {
+	t.Run("missing fileIds", func(t *testing.T) {
+		fileStore := &gdrivemock.FileStoreMock{}
+
+		text, isError := callHandler(t, createGetFilesMetadataHandler(fileStore), map[string]any{})
+		if !isError || text != "Parameter 'fileIds' is required" {
+			t.Errorf("result = %q (isError %v)", text, isError)
+		}
+	})
+
+	t.Run("per-file results", func(t *testing.T) {
+		fileStore := &gdrivemock.FileStoreMock{
+			GetFilesMetadataFunc: func(ctx context.Context, fileIDs []string, extraFields []string) ([]gdrive.FileResult, error) {
+				results := make([]gdrive.FileResult, len(fileIDs))
+				for i, fileID := range fileIDs {
+					results[i] = gdrive.FileResult{ID: fileID, Error: "failed to get file: not found"}
+				}
+				return results, nil
+			},
+		}
+
+		text, isError := callHandler(t, createGetFilesMetadataHandler(fileStore), map[string]any{
+			"fileIds": []any{"file1", "https://docs.google.com/document/d/doc1/edit"},
+		})
+		if isError {
+			t.Fatalf("unexpected error: %s", text)
+		}
+		want := `{"count":2,"files":[{"id":"file1","error":"failed to get file: not found"},{"id":"doc1","error":"failed to get file: not found"}]}`
+		if text != want {
+			t.Errorf("text = %s, want %s", text, want)
+		}
+	})
+}
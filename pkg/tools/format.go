@@ -0,0 +1,286 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Output formats of read tools
+const (
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown"
+	FormatText     = "text"
+)
+
+// formats lists the output formats accepted by the format parameter
+var formats = []string{FormatJSON, FormatMarkdown, FormatText}
+
+// withFormat adds the format parameter, selecting how a read tool renders its result
+func withFormat(defaultFormat string) mcp.ToolOption {
+	return mcp.WithString("format",
+		mcp.Description(fmt.Sprintf("How to render the result: 'json', 'markdown', or 'text' (default: %s). Markdown and text are more compact than JSON", defaultFormat)),
+		mcp.Enum(formats...),
+		mcp.DefaultString(defaultFormat),
+	)
+}
+
+// parseFormat returns the format parameter, or defaultFormat if it is not given
+func parseFormat(request mcp.CallToolRequest, defaultFormat string) (string, error) {
+	format := mcp.ParseString(request, "format", defaultFormat)
+	if !slices.Contains(formats, format) {
+		return "", fmt.Errorf("must be one of %s", strings.Join(formats, ", "))
+	}
+	return format, nil
+}
+
+// renderFiles renders a page of a file listing
+func renderFiles(list *gdrive.FileList, format string) (string, error) {
+	files := list.Files
+	switch format {
+	case FormatMarkdown:
+		columns := fileColumns(files)
+		rows := make([][]string, len(files))
+		for i, file := range files {
+			rows[i] = fileRow(file, columns)
+		}
+		return markdownTable(append([]string{"name", "mimeType", "id"}, columns...), rows) + nextPageLine(list.NextPageToken), nil
+	case FormatText:
+		columns := fileColumns(files)
+		var text strings.Builder
+		text.WriteString(strings.Join(append([]string{"name", "mimeType", "id"}, columns...), "\t"))
+		text.WriteString("\n")
+		for _, file := range files {
+			text.WriteString(strings.Join(fileRow(file, columns), "\t"))
+			text.WriteString("\n")
+		}
+		return text.String() + nextPageLine(list.NextPageToken), nil
+	}
+
+	return marshalResult(listResult(map[string]any{
+		"files": files,
+		"count": len(files),
+	}, list.NextPageToken))
+}
+
+// renderSearchResults renders a page of the results of a content search
+func renderSearchResults(list *gdrive.SearchResultList, format string) (string, error) {
+	results := list.Results
+	switch format {
+	case FormatMarkdown, FormatText:
+		var text strings.Builder
+		for _, result := range results {
+			if format == FormatMarkdown {
+				fmt.Fprintf(&text, "- **%s** (%s, %s)\n", result.File.Name, result.File.Type, result.File.ID)
+			} else {
+				fmt.Fprintf(&text, "%s\t%s\t%s\n", result.File.Name, result.File.Type, result.File.ID)
+			}
+			for _, snippet := range result.Snippets {
+				line := strings.Join(strings.Fields(snippet.Text), " ")
+				if format == FormatMarkdown {
+					fmt.Fprintf(&text, "  - %d: %s\n", snippet.Offset, line)
+				} else {
+					fmt.Fprintf(&text, "\t%d: %s\n", snippet.Offset, line)
+				}
+			}
+		}
+		return text.String() + nextPageLine(list.NextPageToken), nil
+	}
+
+	return marshalResult(listResult(map[string]any{
+		"results": results,
+		"count":   len(results),
+	}, list.NextPageToken))
+}
+
+// renderValues renders spreadsheet values. Markdown uses the first row as the table header.
+func renderValues(values [][]interface{}, rangeName, format string) (string, error) {
+	switch format {
+	case FormatMarkdown, FormatText:
+		width := 0
+		for _, row := range values {
+			width = max(width, len(row))
+		}
+		rows := make([][]string, len(values))
+		for i, row := range values {
+			rows[i] = make([]string, width)
+			for j, value := range row {
+				rows[i][j] = cellString(value)
+			}
+		}
+		if format == FormatText {
+			var text strings.Builder
+			for _, row := range rows {
+				text.WriteString(strings.Join(row, "\t"))
+				text.WriteString("\n")
+			}
+			return text.String(), nil
+		}
+		if len(rows) == 0 {
+			return "", nil
+		}
+		return markdownTable(rows[0], rows[1:]), nil
+	}
+
+	return marshalResult(map[string]any{
+		"values": values,
+		"range":  rangeName,
+	})
+}
+
+// renderParagraphs renders a document's paragraphs as JSON or Markdown. Markdown maps titles and headings
+// to headings and list items to nested bullets.
+func renderParagraphs(documentID string, paragraphs []gdrive.DocumentParagraph, format string) (string, error) {
+	switch format {
+	case FormatMarkdown:
+		var blocks []string
+		for i, paragraph := range paragraphs {
+			if paragraph.Text == "" {
+				continue
+			}
+			line := paragraph.Text
+			switch {
+			case paragraph.Bullet:
+				line = strings.Repeat("  ", int(paragraph.NestingLevel)) + "- " + line
+			case paragraph.Style == "TITLE":
+				line = "# " + line
+			case paragraph.Style == "SUBTITLE":
+				line = "*" + line + "*"
+			case strings.HasPrefix(paragraph.Style, "HEADING_"):
+				var level int
+				fmt.Sscanf(paragraph.Style, "HEADING_%d", &level)
+				line = strings.Repeat("#", max(1, level)) + " " + line
+			}
+			// Consecutive list items form one list
+			if paragraph.Bullet && i > 0 && paragraphs[i-1].Bullet && len(blocks) > 0 {
+				blocks[len(blocks)-1] += "\n" + line
+				continue
+			}
+			blocks = append(blocks, line)
+		}
+		return strings.Join(blocks, "\n\n") + "\n", nil
+	}
+
+	return marshalResult(map[string]any{
+		"documentId": documentID,
+		"paragraphs": paragraphs,
+	})
+}
+
+// renderPresentationText renders a presentation's text as JSON, or as Markdown with a heading per slide
+func renderPresentationText(presentationID string, presentation *gdrive.PresentationText, format string) (string, error) {
+	switch format {
+	case FormatMarkdown:
+		var text strings.Builder
+		fmt.Fprintf(&text, "# %s\n", presentation.Title)
+		for i, slide := range presentation.Slides {
+			fmt.Fprintf(&text, "\n## Slide %d\n", i+1)
+			for _, shapeText := range slide.Texts {
+				fmt.Fprintf(&text, "\n%s\n", shapeText)
+			}
+		}
+		return text.String(), nil
+	}
+
+	return marshalResult(map[string]any{
+		"presentationId": presentationID,
+		"title":          presentation.Title,
+		"slides":         presentation.Slides,
+	})
+}
+
+// listResult adds the token of the next page to a listing's JSON result, unless it is the last page
+func listResult(result map[string]any, nextPageToken string) map[string]any {
+	if nextPageToken != "" {
+		result["nextPageToken"] = nextPageToken
+	}
+	return result
+}
+
+// nextPageLine returns the line giving the token of the next page in Markdown and text output, or "" on the last page
+func nextPageLine(nextPageToken string) string {
+	if nextPageToken == "" {
+		return ""
+	}
+	return "\nnextPageToken: " + nextPageToken + "\n"
+}
+
+// marshalResult encodes a result as JSON
+func marshalResult(result any) (string, error) {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// fileColumns returns the names of the extra fields present in files, sorted
+func fileColumns(files []gdrive.DriveFile) []string {
+	seen := make(map[string]bool)
+	var columns []string
+	for _, file := range files {
+		for name := range file.Fields {
+			if !seen[name] {
+				seen[name] = true
+				columns = append(columns, name)
+			}
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// fileRow returns the name, MIME type, ID, and the given extra fields of a file
+func fileRow(file gdrive.DriveFile, columns []string) []string {
+	row := []string{file.Name, file.Type, file.ID}
+	for _, column := range columns {
+		row = append(row, cellString(file.Fields[column]))
+	}
+	return row
+}
+
+// cellString returns a value as text, encoding values other than strings and numbers as JSON
+func cellString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64, int, int64, bool:
+		return fmt.Sprint(v)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// markdownTable renders a Markdown table, escaping pipes and line breaks in cells
+func markdownTable(header []string, rows [][]string) string {
+	escape := strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+	line := func(cells []string) string {
+		escaped := make([]string, len(cells))
+		for i, cell := range cells {
+			escaped[i] = escape.Replace(cell)
+		}
+		return "| " + strings.Join(escaped, " | ") + " |\n"
+	}
+
+	var table strings.Builder
+	table.WriteString(line(header))
+	separator := make([]string, len(header))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	table.WriteString(line(separator))
+	for _, row := range rows {
+		table.WriteString(line(row))
+	}
+	return table.String()
+}
@@ -0,0 +1,137 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/api/drive/v3"
+)
+
+// ServerInfo holds the build and configuration details reported by the server_info tool
+type ServerInfo struct {
+	Version string         `json:"version"`
+	Tools   []string       `json:"tools"`
+	Config  map[string]any `json:"config"`
+}
+
+// ServerInfoTool returns the server_info tool reporting info and the account of accountInspector.
+// info.Tools is read on every call, so it can be filled in after the tool is registered.
+func ServerInfoTool(accountInspector gdrive.AccountInspector, info *ServerInfo) Tool {
+	// Define server info tool
+	serverInfoTool := mcp.NewTool(
+		"server_info",
+		mcp.WithDescription("Report the server version, enabled tools, authenticated account, granted scopes, and configuration"),
+	)
+
+	return Tool{Tool: serverInfoTool, Handler: createServerInfoHandler(accountInspector, info), Scopes: []string{drive.DriveScope}, ReadOnly: true}
+}
+
+func createServerInfoHandler(accountInspector gdrive.AccountInspector, info *ServerInfo) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result := map[string]any{
+			"version": info.Version,
+			"tools":   info.Tools,
+			"config":  info.Config,
+		}
+
+		// Report account lookup failures instead of failing, since they are often what is being debugged
+		account, err := accountInspector.GetAccountInfo(ctx)
+		if err != nil {
+			result["accountError"] = err.Error()
+		} else {
+			result["account"] = account
+		}
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+// StorageQuotaTool returns the get_storage_quota tool reporting the storage usage and limits of accountInspector's account
+func StorageQuotaTool(accountInspector gdrive.AccountInspector) Tool {
+	// Define storage quota tool
+	storageQuotaTool := mcp.NewTool(
+		"get_storage_quota",
+		mcp.WithDescription("Report the authenticated account's storage usage and limit in bytes, split into usage by Drive and by files in the trash, along with the account's email address and name. Use it for capacity questions or to confirm which account the server is acting as"),
+	)
+
+	return Tool{Tool: storageQuotaTool, Handler: createStorageQuotaHandler(accountInspector), Scopes: []string{drive.DriveScope}, ReadOnly: true}
+}
+
+func createStorageQuotaHandler(accountInspector gdrive.AccountInspector) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		quota, err := accountInspector.GetStorageQuota(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get storage quota: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(quota)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+// DiagnoseAuthTool returns the diagnose_auth tool checking the credentials of accountInspector against each API
+func DiagnoseAuthTool(accountInspector gdrive.AccountInspector) Tool {
+	// Define diagnose auth tool
+	diagnoseAuthTool := mcp.NewTool(
+		"diagnose_auth",
+		mcp.WithDescription("Check the server's credentials, granted scopes, and access to the Drive, Docs, Slides, and Sheets APIs with test calls. Failures such as a missing quota project, a scope not granted, or an API not enabled on the project come with step-by-step remediation instructions"),
+	)
+
+	// Registered without scopes, since it is most needed when scopes are missing
+	return Tool{Tool: diagnoseAuthTool, Handler: createDiagnoseAuthHandler(accountInspector), ReadOnly: true}
+}
+
+func createDiagnoseAuthHandler(accountInspector gdrive.AccountInspector) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		diagnosis, err := accountInspector.DiagnoseAuth(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to diagnose authentication: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(diagnosis)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+// ReloadCredentialsTool returns the reload_credentials tool making accountInspector look up its credentials again
+func ReloadCredentialsTool(accountInspector gdrive.AccountInspector) Tool {
+	// Define reload credentials tool
+	reloadCredentialsTool := mcp.NewTool(
+		"reload_credentials",
+		mcp.WithDescription("Look up the server's Google credentials again and report the account they resolve to. Use it after signing in with gcloud, replacing the credentials file, or fixing an authentication problem, instead of restarting the server"),
+	)
+
+	// Registered without scopes and as read-only, since it changes no files and is needed when scopes are missing
+	return Tool{Tool: reloadCredentialsTool, Handler: createReloadCredentialsHandler(accountInspector), ReadOnly: true}
+}
+
+func createReloadCredentialsHandler(accountInspector gdrive.AccountInspector) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		account, err := accountInspector.ReloadCredentials(ctx)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to reload credentials: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(account)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
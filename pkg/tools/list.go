@@ -0,0 +1,50 @@
+package tools
+
+import (
+	"fmt"
+
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Listing tools share the pageSize, pageToken, orderBy, and fields parameters, so clients page through
+// every listing the same way
+
+// withPageSize adds the pageSize parameter of a listing tool
+func withPageSize(defaultSize int) mcp.ToolOption {
+	return mcp.WithNumber("pageSize",
+		mcp.Description(fmt.Sprintf("Maximum number of items to return (default: %d). Use nextPageToken from the response to fetch more", defaultSize)),
+		mcp.DefaultNumber(float64(defaultSize)),
+	)
+}
+
+// withPageToken adds the pageToken parameter of a listing tool
+func withPageToken() mcp.ToolOption {
+	return mcp.WithString("pageToken", mcp.Description("The nextPageToken from the previous response, to fetch the next page with otherwise identical parameters"))
+}
+
+// withOrderBy adds the orderBy parameter of a listing tool
+func withOrderBy(description string) mcp.ToolOption {
+	return mcp.WithString("orderBy", mcp.Description(description))
+}
+
+// withDriveID adds the driveId parameter of a tool listing Drive files
+func withDriveID() mcp.ToolOption {
+	return mcp.WithString("driveId", mcp.Description("The ID or URL of a shared drive to limit the results to, from list_shared_drives. If empty, covers My Drive and every shared drive"))
+}
+
+// fileOrderByDescription describes the orderBy parameter of Drive file listings
+const fileOrderByDescription = "Comma-separated sort keys, each optionally followed by 'desc', e.g. 'folder,modifiedTime desc,name'. Keys: createdTime, folder, modifiedByMeTime, modifiedTime, name, name_natural, quotaBytesUsed, recency, sharedWithMeTime, starred, viewedByMeTime"
+
+// parseListOptions returns the list options of a listing tool call. The former maxResults parameter is still
+// accepted in place of pageSize.
+func parseListOptions(request mcp.CallToolRequest, defaultPageSize int) gdrive.ListOptions {
+	pageSize := mcp.ParseInt(request, "maxResults", defaultPageSize)
+	return gdrive.ListOptions{
+		PageSize:  mcp.ParseInt(request, "pageSize", pageSize),
+		PageToken: mcp.ParseString(request, "pageToken", ""),
+		OrderBy:   mcp.ParseString(request, "orderBy", ""),
+		Fields:    request.GetStringSlice("fields", nil),
+		DriveID:   gdrive.ResolveFileID(mcp.ParseString(request, "driveId", "")),
+	}
+}
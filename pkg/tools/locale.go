@@ -0,0 +1,73 @@
+package tools
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// DefaultLocale is the language the tool descriptions are written in
+const DefaultLocale = "en"
+
+// ToolText is the translated description of a tool and of its parameters
+type ToolText struct {
+	Description string
+	// Parameters maps parameter names to their descriptions
+	Parameters map[string]string
+}
+
+// translations maps each locale besides DefaultLocale to its tool texts, by tool name
+var translations = map[string]map[string]ToolText{
+	"ja": japanese,
+}
+
+// Locales returns the locales tool descriptions are available in
+func Locales() []string {
+	return append([]string{DefaultLocale}, slices.Sorted(maps.Keys(translations))...)
+}
+
+// Localize replaces the descriptions of the registered tools with their translations into locale, so
+// models prompted in that language pick tools more reliably. Tools and parameters without a translation,
+// such as third-party tools, keep their descriptions.
+func (r *Registry) Localize(locale string) error {
+	if locale == DefaultLocale {
+		return nil
+	}
+	texts, ok := translations[locale]
+	if !ok {
+		return fmt.Errorf("unsupported locale %q: must be one of %s", locale, strings.Join(Locales(), ", "))
+	}
+
+	for i, tool := range r.tools {
+		if text, ok := texts[tool.Tool.Name]; ok {
+			r.tools[i] = localize(tool, text)
+		}
+	}
+	return nil
+}
+
+// localize returns tool with the descriptions in text. The input schema is copied, not modified,
+// since its property maps may be shared with other tools.
+func localize(tool Tool, text ToolText) Tool {
+	if text.Description != "" {
+		tool.Tool.Description = text.Description
+	}
+
+	properties := make(map[string]any, len(tool.Tool.InputSchema.Properties))
+	for name, property := range tool.Tool.InputSchema.Properties {
+		description, translated := text.Parameters[name]
+		schema, isMap := property.(map[string]any)
+		if !translated || !isMap {
+			properties[name] = property
+			continue
+		}
+
+		schema = maps.Clone(schema)
+		schema["description"] = description
+		properties[name] = schema
+	}
+	tool.Tool.InputSchema.Properties = properties
+
+	return tool
+}
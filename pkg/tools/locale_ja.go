@@ -0,0 +1,726 @@
+package tools
+
+// japanese holds the Japanese tool descriptions, by tool name
+var japanese = map[string]ToolText{
+	// File tools
+	"search_files": {
+		Description: "Google Drive のファイルを名前で検索し、種類、更新日時、オーナー、フォルダ、ゴミ箱やスターの状態による任意のフィルタで絞り込みます。query、1 つ以上のフィルタ、またはその両方を指定します",
+		Parameters: map[string]string{
+			"query":          "検索するファイル名またはキーワード。空の場合はフィルタのみで一致を判定します",
+			"mimeType":       "この MIME タイプのファイルのみに一致します (例: 'application/pdf'、'application/vnd.google-apps.spreadsheet')",
+			"modifiedAfter":  "この日時以降に更新されたファイルのみに一致します。RFC 3339 のタイムスタンプまたは YYYY-MM-DD 形式の日付 (UTC)",
+			"modifiedBefore": "この日時より前に更新されたファイルのみに一致します。RFC 3339 のタイムスタンプまたは YYYY-MM-DD 形式の日付 (UTC)",
+			"owner":          "このメールアドレスのユーザーが所有するファイルのみに一致します。'me' はログイン中のユーザーが所有するファイルです",
+			"folderId":       "フォルダの ID または URL。その直下にあるファイルのみに一致します",
+			"trashed":        "true の場合はゴミ箱内のファイルのみ、false の場合はゴミ箱外のファイルのみに一致します。省略すると両方が対象です",
+			"starred":        "true の場合はスター付きのファイルのみ、false の場合はスターなしのファイルのみに一致します。省略すると両方が対象です",
+			"driveId":        "結果を絞り込む共有ドライブの ID または URL (list_shared_drives で取得)。空の場合はマイドライブとすべての共有ドライブが対象です",
+			"pageSize":       "返す項目の最大数 (デフォルト: 10)。続きはレスポンスの nextPageToken を使って取得します",
+			"pageToken":      "次のページを取得するための、前回のレスポンスの nextPageToken。その他のパラメータは前回と同じにします",
+			"orderBy":        "カンマ区切りの並べ替えキー。各キーの後に 'desc' を付けると降順になります (例: 'folder,modifiedTime desc,name')。キー: createdTime、folder、modifiedByMeTime、modifiedTime、name、name_natural、quotaBytesUsed、recency、sharedWithMeTime、starred、viewedByMeTime",
+			"snippets":       "ファイルの内容も検索し、Google ドキュメントでは一致箇所の前後のスニペットを文字オフセットとともに返します (デフォルト: false)。一致した各ドキュメントをエクスポートするため低速です",
+			"fields":         "id、name、mimeType に加えて返す Drive ファイルフィールド (例: 'size'、'modifiedTime'、'owners(emailAddress)')。省略すると最も軽いレスポンスになります",
+			"format":         "結果の表示形式: 'json'、'markdown'、'text' (デフォルト: json)。Markdown と text は JSON よりコンパクトです",
+		},
+	},
+	"search_files_by_properties": {
+		Description: "カスタムプロパティでタグ付けされた Google Drive のファイルを検索します。指定したすべてのキーを、指定した値どおりに持つファイルが対象です",
+		Parameters: map[string]string{
+			"properties":    "照合するプロパティのキーと値。例: {\"project\": \"apollo\", \"status\": \"final\"}",
+			"appProperties": "すべてのアプリに公開されるプロパティではなく、このアプリ専用のプロパティ (appProperties) を照合します (デフォルト: false)",
+			"driveId":       "結果を絞り込む共有ドライブの ID または URL (list_shared_drives で取得)。空の場合はマイドライブとすべての共有ドライブが対象です",
+			"pageSize":      "返す項目の最大数 (デフォルト: 10)。続きはレスポンスの nextPageToken を使って取得します",
+			"pageToken":     "次のページを取得するための、前回のレスポンスの nextPageToken。その他のパラメータは前回と同じにします",
+			"orderBy":       "カンマ区切りの並べ替えキー。各キーの後に 'desc' を付けると降順になります (例: 'folder,modifiedTime desc,name')。キー: createdTime、folder、modifiedByMeTime、modifiedTime、name、name_natural、quotaBytesUsed、recency、sharedWithMeTime、starred、viewedByMeTime",
+			"fields":        "id、name、mimeType に加えて返す Drive ファイルフィールド (例: 'size'、'modifiedTime'、'owners(emailAddress)')。省略すると最も軽いレスポンスになります",
+			"format":        "結果の表示形式: 'json'、'markdown'、'text' (デフォルト: json)。Markdown と text は JSON よりコンパクトです",
+		},
+	},
+	"list_files": {
+		Description: "Google Drive のフォルダ内のファイルを一覧表示します",
+		Parameters: map[string]string{
+			"folderId":  "ファイルを一覧表示するフォルダの ID または URL。空の場合はマイドライブのルート、または driveId で指定した共有ドライブの最上位を一覧表示します",
+			"driveId":   "結果を絞り込む共有ドライブの ID または URL (list_shared_drives で取得)。空の場合はマイドライブとすべての共有ドライブが対象です",
+			"pageSize":  "返す項目の最大数 (デフォルト: 10)。続きはレスポンスの nextPageToken を使って取得します",
+			"pageToken": "次のページを取得するための、前回のレスポンスの nextPageToken。その他のパラメータは前回と同じにします",
+			"orderBy":   "カンマ区切りの並べ替えキー。各キーの後に 'desc' を付けると降順になります (例: 'folder,modifiedTime desc,name')。キー: createdTime、folder、modifiedByMeTime、modifiedTime、name、name_natural、quotaBytesUsed、recency、sharedWithMeTime、starred、viewedByMeTime",
+			"fields":    "id、name、mimeType に加えて返す Drive ファイルフィールド (例: 'size'、'modifiedTime'、'owners(emailAddress)')。省略すると最も軽いレスポンスになります",
+			"format":    "結果の表示形式: 'json'、'markdown'、'text' (デフォルト: json)。Markdown と text は JSON よりコンパクトです",
+		},
+	},
+	"list_shared_drives": {
+		Description: "ユーザーがメンバーになっている共有ドライブを ID とあわせて一覧表示します。ID を search_files や list_files の driveId に渡すと、そのドライブ内で作業できます",
+		Parameters: map[string]string{
+			"pageSize":  "返す項目の最大数 (デフォルト: 10)。続きはレスポンスの nextPageToken を使って取得します",
+			"pageToken": "次のページを取得するための、前回のレスポンスの nextPageToken",
+		},
+	},
+	"list_modified_files": {
+		Description: "指定した期間内に更新 (または作成) されたファイルを新しい順に一覧表示します。プロジェクトフォルダで今週変更されたものをまとめるといったレポートに便利です",
+		Parameters: map[string]string{
+			"since":     "期間の開始 (この時刻を含む)。RFC 3339 のタイムスタンプまたは YYYY-MM-DD 形式の日付 (UTC)",
+			"until":     "期間の終了 (この時刻を含まない)。RFC 3339 のタイムスタンプまたは YYYY-MM-DD 形式の日付 (UTC)。空の場合は終了なし",
+			"timeField": "照合するタイムスタンプ: 'modified' または 'created' (デフォルト: modified)",
+			"folderId":  "フォルダの ID または URL。その配下にあるファイルのみを一覧表示します。空の場合は Drive 全体を検索します",
+			"owner":     "このメールアドレスのユーザーが所有するファイルのみを一覧表示します",
+			"pageSize":  "返す項目の最大数 (デフォルト: 50)。続きはレスポンスの nextPageToken を使って取得します",
+			"pageToken": "次のページを取得するための、前回のレスポンスの nextPageToken。その他のパラメータは前回と同じにします",
+			"fields":    "id、name、mimeType に加えて返す Drive ファイルフィールド (例: 'size'、'modifiedTime'、'owners(emailAddress)')。省略すると最も軽いレスポンスになります",
+			"format":    "結果の表示形式: 'json'、'markdown'、'text' (デフォルト: json)。Markdown と text は JSON よりコンパクトです",
+		},
+	},
+	"get_changes_start_token": {
+		Description: "Google Drive の変更履歴の現在位置を示すページトークンを取得します。保存しておき、後で list_changes に渡すと、フォルダを走査し直さずにその後の変更を確認できます",
+	},
+	"list_changes": {
+		Description: "ページトークンの発行以降に作成、更新、ゴミ箱への移動、削除されたマイドライブと共有ドライブのファイルを、古い変更から順に一覧表示します。nextPageToken が返される間はそれを渡して続きを取得します。最後のページでは代わりに newStartPageToken が返されるので、次回のために保存します",
+		Parameters: map[string]string{
+			"pageToken": "get_changes_start_token のトークン、または前回の list_changes の nextPageToken か newStartPageToken",
+			"pageSize":  "返す項目の最大数 (デフォルト: 1000)。続きはレスポンスの nextPageToken を使って取得します",
+		},
+	},
+	"list_largest_files": {
+		Description: "使用しているストレージが多い順にファイルを一覧表示し、サイズ、オーナー、最終更新日時を返します。マイドライブやプロジェクトフォルダで容量を占めているものを探すなど、ストレージの整理に便利です",
+		Parameters: map[string]string{
+			"folderId":  "フォルダの ID または URL。その配下にあるファイルのみを一覧表示します。空の場合は Drive 全体を検索します",
+			"owner":     "このメールアドレスのユーザーが所有するファイルのみを一覧表示します。'me' はログイン中のユーザーが所有するファイルです",
+			"pageSize":  "返す項目の最大数 (デフォルト: 25)。続きはレスポンスの nextPageToken を使って取得します",
+			"pageToken": "次のページを取得するための、前回のレスポンスの nextPageToken。その他のパラメータは前回と同じにします",
+			"fields":    "id、name、mimeType に加えて返す Drive ファイルフィールド (例: 'size'、'modifiedTime'、'owners(emailAddress)')。省略すると最も軽いレスポンスになります",
+			"format":    "結果の表示形式: 'json'、'markdown'、'text' (デフォルト: json)。Markdown と text は JSON よりコンパクトです",
+		},
+	},
+	"find_duplicates": {
+		Description: "Google ドライブのフォルダ (必要に応じてサブフォルダも含む) から、内容が同一、名前が同じ、または名前とサイズが同じ重複ファイルの組を探します。余分なコピーが無駄にしている容量の多い順に組を並べ、各組のコピーは古い順に並べます。何も変更しないので、不要なコピーは trash_file でゴミ箱に移動してください",
+		Parameters: map[string]string{
+			"folderId":  "スキャンするフォルダまたは共有ドライブの ID または URL",
+			"recursive": "配下にあるすべてのフォルダもスキャンします (デフォルト: false)",
+			"matchBy":   "ファイルの照合方法: 内容が同一の 'checksum'、'name'、'nameAndSize' (デフォルト: checksum)。Google ドキュメント、スプレッドシート、スライドにはチェックサムやサイズがないため、名前でのみ照合されます",
+			"limit":     "返す重複の組の最大数 (デフォルト: 100)",
+		},
+	},
+	"list_recent_files": {
+		Description: "最近更新されたファイル、またはユーザーが最近閲覧したファイルを新しい順に一覧表示します。検索クエリを組み立てずに、昨日どのファイルで作業したかといった質問に答えるのに便利です",
+		Parameters: map[string]string{
+			"by":        "並べ替えに使う操作: 'modified' は誰かによる最終更新、'viewed' はユーザーが最後にファイルを開いた日時 (デフォルト: modified)",
+			"driveId":   "結果を絞り込む共有ドライブの ID または URL (list_shared_drives で取得)。空の場合はマイドライブとすべての共有ドライブが対象です",
+			"pageSize":  "返す項目の最大数 (デフォルト: 10)。続きはレスポンスの nextPageToken を使って取得します",
+			"pageToken": "次のページを取得するための、前回のレスポンスの nextPageToken。その他のパラメータは前回と同じにします",
+			"fields":    "id、name、mimeType に加えて返す Drive ファイルフィールド (例: 'size'、'modifiedTime'、'owners(emailAddress)')。省略すると最も軽いレスポンスになります",
+			"format":    "結果の表示形式: 'json'、'markdown'、'text' (デフォルト: json)。Markdown と text は JSON よりコンパクトです",
+		},
+	},
+	"list_trashed_files": {
+		Description: "Google Drive のゴミ箱にあるファイルとフォルダを、ゴミ箱に移動した日時とあわせて一覧表示します。empty_trash で削除されるものの確認や、復元するファイルを探すのに使います",
+		Parameters: map[string]string{
+			"pageSize":  "返す項目の最大数 (デフォルト: 10)。続きはレスポンスの nextPageToken を使って取得します",
+			"pageToken": "次のページを取得するための、前回のレスポンスの nextPageToken。その他のパラメータは前回と同じにします",
+			"orderBy":   "カンマ区切りの並べ替えキー。各キーの後に 'desc' を付けると降順になります (例: 'folder,modifiedTime desc,name')。キー: createdTime、folder、modifiedByMeTime、modifiedTime、name、name_natural、quotaBytesUsed、recency、sharedWithMeTime、starred、viewedByMeTime",
+			"fields":    "id、name、mimeType に加えて返す Drive ファイルフィールド (例: 'size'、'modifiedTime'、'owners(emailAddress)')。省略すると最も軽いレスポンスになります",
+			"format":    "結果の表示形式: 'json'、'markdown'、'text' (デフォルト: json)。Markdown と text は JSON よりコンパクトです",
+		},
+	},
+	"list_starred_files": {
+		Description: "Google Drive でスターを付けたファイルとフォルダを一覧表示します。ゴミ箱にあるものは除きます。スターは star_file で付け、unstar_file で外せます",
+		Parameters: map[string]string{
+			"driveId":   "結果を絞り込む共有ドライブの ID または URL (list_shared_drives で取得)。空の場合はマイドライブとすべての共有ドライブが対象です",
+			"pageSize":  "返す項目の最大数 (デフォルト: 10)。続きはレスポンスの nextPageToken を使って取得します",
+			"pageToken": "次のページを取得するための、前回のレスポンスの nextPageToken。その他のパラメータは前回と同じにします",
+			"orderBy":   "カンマ区切りの並べ替えキー。各キーの後に 'desc' を付けると降順になります (例: 'folder,modifiedTime desc,name')。キー: createdTime、folder、modifiedByMeTime、modifiedTime、name、name_natural、quotaBytesUsed、recency、sharedWithMeTime、starred、viewedByMeTime",
+			"fields":    "id、name、mimeType に加えて返す Drive ファイルフィールド (例: 'size'、'modifiedTime'、'owners(emailAddress)')。省略すると最も軽いレスポンスになります",
+			"format":    "結果の表示形式: 'json'、'markdown'、'text' (デフォルト: json)。Markdown と text は JSON よりコンパクトです",
+		},
+	},
+	"get_file_metadata": {
+		Description: "Google Drive のファイルのメタデータを取得します: サイズ、オーナー、作成日時と更新日時、最終更新者、webViewLink、親フォルダ、共有・スター・ゴミ箱の状態、MD5 チェックサム",
+		Parameters: map[string]string{
+			"fileId": "ファイルの ID または URL",
+		},
+	},
+	"get_file_properties": {
+		Description: "Google Drive のファイルのカスタムのキーと値のプロパティを取得します。すべてのアプリから見える properties と、このアプリケーション専用の appProperties を返します。設定は set_file_properties、プロパティによる検索は search_files_by_properties で行います",
+		Parameters: map[string]string{
+			"fileId": "ファイルの ID または URL",
+		},
+	},
+	"list_labels": {
+		Description: "ファイルの分類に使える Drive ラベルを、フィールドと選択フィールドの選択肢、ユーザーが各ラベルを適用できるかどうかとあわせて一覧表示します。ファイルのラベルの取得は get_file_labels、適用は set_file_label で行います",
+		Parameters: map[string]string{
+			"pageSize":  "返す項目の最大数 (デフォルト: 50)。続きはレスポンスの nextPageToken を使って取得します",
+			"pageToken": "次のページを取得するための、前回のレスポンスの nextPageToken。その他のパラメータは前回と同じにします",
+		},
+	},
+	"get_file_labels": {
+		Description: "Google Drive のファイルに適用されている Drive ラベルを、フィールドの値とあわせて一覧表示します",
+		Parameters: map[string]string{
+			"fileId": "ファイルの ID または URL",
+		},
+	},
+	"list_permissions": {
+		Description: "Google Drive のファイルにアクセスできるユーザーを一覧表示します: ユーザー、グループ、ドメイン、リンクを知っている全員の各権限と、そのロール、メールアドレス、ドメイン。ドキュメントについて何かを共有する前に、誰が閲覧できるかを確認するのに便利です",
+		Parameters: map[string]string{
+			"fileId":    "ファイルの ID または URL",
+			"pageSize":  "返す項目の最大数 (デフォルト: 100)。続きはレスポンスの nextPageToken を使って取得します",
+			"pageToken": "次のページを取得するための、前回のレスポンスの nextPageToken。その他のパラメータは前回と同じにします",
+		},
+	},
+	"list_revisions": {
+		Description: "Google Drive のファイルの保存済みバージョンを古い順に一覧表示し、各リビジョンの ID、更新日時、最終更新者を返します。変更する前にドキュメントの履歴を確認するのに便利です。リビジョン ID は diff_documents に渡せます",
+		Parameters: map[string]string{
+			"fileId":    "ファイルの ID または URL",
+			"pageSize":  "返す項目の最大数 (デフォルト: 100)。続きはレスポンスの nextPageToken を使って取得します",
+			"pageToken": "次のページを取得するための、前回のレスポンスの nextPageToken。その他のパラメータは前回と同じにします",
+		},
+	},
+	"get_revision_content": {
+		Description: "Google Drive のファイルの、以前のリビジョン時点の内容をファイルを変更せずに取得します。現在の内容との比較などに使います。Google ドキュメント、スプレッドシート、スライド、図形描画のリビジョンはデフォルトでテキストとして、その他のファイルは base64 で返します。最大 10MB です",
+		Parameters: map[string]string{
+			"fileId":     "ファイルの ID または URL",
+			"revisionId": "リビジョンの ID (list_revisions で取得)",
+			"format":     "Google Workspace のリビジョンをエクスポートする形式 (ファイル拡張子、export_file と同じ)。デフォルトはドキュメントとプレゼンテーションが txt、スプレッドシートが csv (最初のシートのみ)、図形描画が svg です。その他のファイルでは空にします",
+		},
+	},
+	"list_comments": {
+		Description: "Google Drive のファイルのコメントを返信とあわせて古い順に一覧表示します。各コメントが付けられたテキストと作成者も返します。includeResolved が true でない限り、解決済みのコメントは除きます",
+		Parameters: map[string]string{
+			"fileId":          "ファイルの ID または URL",
+			"includeResolved": "解決済みのコメントも一覧表示します (デフォルト: false)",
+			"pageSize":        "返す項目の最大数 (デフォルト: 20)。続きはレスポンスの nextPageToken を使って取得します",
+			"pageToken":       "次のページを取得するための、前回のレスポンスの nextPageToken。その他のパラメータは前回と同じにします",
+		},
+	},
+	"get_file_activity": {
+		Description: "Drive Activity API から Google Drive のファイルまたはフォルダの履歴を新しい順に取得します。編集、コメント、移動、名前の変更、権限の変更などを、誰がいつ行ったかとともに返します。誰がファイルを変更したか、ファイルに何が起きたかを調べるのに便利です",
+		Parameters: map[string]string{
+			"fileId":          "ファイルまたはフォルダの ID または URL",
+			"includeContents": "フォルダの場合、その配下にあるすべてのアイテムのアクティビティも報告します (デフォルト: false)",
+			"actions":         "これらの操作のみを報告します。空の場合はすべて報告します",
+			"since":           "この RFC 3339 のタイムスタンプまたは YYYY-MM-DD 形式の日付 (UTC) 以降のアクティビティのみを報告します",
+			"pageSize":        "返す項目の最大数 (デフォルト: 50)。続きはレスポンスの nextPageToken を使って取得します",
+			"pageToken":       "次のページを取得するための、前回のレスポンスの nextPageToken。その他のパラメータは前回と同じにします",
+		},
+	},
+	"get_files_metadata": {
+		Description: "複数の Google Drive ファイルのメタデータを 1 回の呼び出しで取得します",
+		Parameters: map[string]string{
+			"fileIds": "ファイルの ID または URL",
+			"fields":  "id、name、mimeType に加えて返す Drive ファイルフィールド (例: 'size'、'modifiedTime'、'owners(emailAddress)')。省略すると最も軽いレスポンスになります",
+		},
+	},
+	"download_file": {
+		Description: "Google Drive のバイナリファイル (PDF、画像など) の内容を base64 でチャンクごとにダウンロードします。大きなファイルは continuationToken を渡して複数回に分けて取得します",
+		Parameters: map[string]string{
+			"fileId":            "ファイルの ID または URL",
+			"continuationToken": "次のチャンクを取得するための、前回の呼び出しの continuationToken",
+			"chunkSize":         "1 回の呼び出しで返す最大バイト数 (デフォルト: 1048576、最大: 8388608)",
+		},
+	},
+	"get_thumbnail": {
+		Description: "Google Drive が表示するファイルのプレビュー画像 (PDF やドキュメントの最初のページ、縮小した写真など) を画像コンテンツとして返し、ファイルを見られるようにします。Google スライドのプレゼンテーションでは、slideIndex を指定するとそのスライドを描画します",
+		Parameters: map[string]string{
+			"fileId":     "ファイルの ID または URL",
+			"slideIndex": "プレゼンテーションの場合、描画するスライドのインデックス (0 始まり)。省略した場合、ファイル自体のサムネイルを返します",
+			"size":       "画像のサイズ。長辺が small (200px)、medium (800px)、large (1600px) のいずれか (デフォルト: medium)",
+		},
+	},
+	"save_file": {
+		Description: "Google Drive のファイル全体を、サーバーが動作しているマシン上のパスにダウンロードします。内容はクライアントを経由しません。Google ドキュメント・スプレッドシート・スライド・図形描画は PDF や Office 形式などにエクスポートされます",
+		Parameters: map[string]string{
+			"fileId":    "ファイルの ID または URL",
+			"path":      "書き込むローカルファイル (絶対パス推奨)。既存のディレクトリを指定すると、その中に Drive 上の名前で保存します",
+			"format":    "Google Workspace ファイルをエクスポートする形式 (ファイル拡張子)。ドキュメント: docx、odt、rtf、pdf、txt、md、epub。スプレッドシート: xlsx、ods、pdf、csv、tsv (csv と tsv は最初のシートのみ)。プレゼンテーション: pptx、odp、pdf、txt。図形描画: png、jpg、svg、pdf。デフォルトは docx、xlsx、pptx、png",
+			"overwrite": "ローカルファイルが既に存在する場合に置き換えます (デフォルト: false)",
+		},
+	},
+	"export_file": {
+		Description: "Google ドキュメント・スプレッドシート・スライド・図形描画のファイルを別の形式にエクスポートし、base64 で返します。例: ドキュメントを PDF や DOCX に、スプレッドシートを XLSX や CSV に、プレゼンテーションを PDF や PPTX に。Drive がエクスポートできるのは 10MB までです",
+		Parameters: map[string]string{
+			"fileId": "ファイルの ID または URL",
+			"format": "Google Workspace ファイルをエクスポートする形式 (ファイル拡張子)。ドキュメント: docx、odt、rtf、pdf、txt、md、epub。スプレッドシート: xlsx、ods、pdf、csv、tsv (csv と tsv は最初のシートのみ)。プレゼンテーション: pptx、odp、pdf、txt。図形描画: png、jpg、svg、pdf。デフォルトは docx、xlsx、pptx、png",
+		},
+	},
+	"verify_file": {
+		Description: "Google Drive ファイルの内容のサイズと MD5/SHA-1/SHA-256 チェックサムを取得し、必要に応じて期待するハッシュと比較します。アップロードの検証や気付かれない変更の検出に便利です",
+		Parameters: map[string]string{
+			"fileId":       "ファイルの ID または URL",
+			"expectedHash": "比較する 16 進数の MD5、SHA-1、または SHA-256 ダイジェスト。アルゴリズムは長さから推定されます",
+		},
+	},
+	"get_file_parents": {
+		Description: "Google Drive ファイルを含むすべてのフォルダを、マイドライブまたは共有ドライブからのフルパスとともに一覧表示します。ファイルは複数の親を持つことがあります。ショートカットの場合はリンク先の親も報告されます",
+		Parameters: map[string]string{
+			"fileId": "ファイルの ID または URL",
+		},
+	},
+	"check_capabilities": {
+		Description: "現在のユーザーが Google Drive ファイルに対して行える操作 (編集、コメント、共有、コピー、ダウンロード、名前の変更、ゴミ箱への移動、削除、子の追加、移動、版の閲覧) を確認します。変更を行う前に、成功するかどうかを知るために使います",
+		Parameters: map[string]string{
+			"fileId": "ファイルの ID または URL",
+		},
+	},
+	"resolve_shortcut": {
+		Description: "Google Drive ファイルがショートカットかどうかを報告し、リンク先ファイルの ID と MIME タイプを返します。サーバーで無効にされていない限り、コンテンツを扱うツールはショートカットを自動的にたどります",
+		Parameters: map[string]string{
+			"fileId": "ファイルの ID または URL",
+		},
+	},
+	"resolve_path": {
+		Description: "'/Projects/2025/Q3 Report' のようなパスから、マイドライブまたは共有ドライブの最上位からたどって Google Drive のファイルまたはフォルダの ID を探します。名前が重複している場合は複数のファイルを返します",
+		Parameters: map[string]string{
+			"path":    "マイドライブ (先頭の 'My Drive' は省略可能) または driveId で指定した共有ドライブの最上位からの、スラッシュ区切りのパス。名前に含まれるスラッシュは '\\/' と書きます",
+			"driveId": "パスの起点となる共有ドライブの ID または URL (list_shared_drives で取得)。空の場合、パスはマイドライブから始まります",
+		},
+	},
+	"resolve_url": {
+		Description: "docs.google.com または drive.google.com の URL をファイル ID と種類に変換します。ファイル ID を受け取るツールは URL も直接受け付けます",
+		Parameters: map[string]string{
+			"url": "Google ドキュメントまたは Drive の URL",
+		},
+	},
+	"extract_pdf_text": {
+		Description: "Google Drive に保存された PDF のテキストを抽出します。スキャンしたページも OCR で読み取ります。PDF は Drive のインポートで一時的な Google ドキュメントに変換され、変換後に削除されます",
+		Parameters: map[string]string{
+			"fileId":      "PDF ファイルの ID または URL",
+			"ocrLanguage": "スキャンしたテキストの言語の ISO 639-1 コード (例: 'ja')。OCR の精度が上がります。空の場合は Drive が言語を判定します",
+			"perPage":     "テキストを全体ではなくページごとに返します (デフォルト: false)",
+		},
+	},
+
+	// Document tools
+	"get_document": {
+		Description: "Google ドキュメントの内容を取得します。大きなドキュメントは maxChars を指定して分割して読み取ります",
+		Parameters: map[string]string{
+			"documentId": "Google ドキュメントの ID または URL",
+			"startIndex": "読み取りを開始する文字オフセット (デフォルト: 0)。続きを読むには前回のレスポンスの nextStartIndex を指定します",
+			"maxChars":   "返す最大文字数。指定すると、レスポンスはチャンク、totalChars、nextStartIndex を含む JSON になります",
+			"format":     "結果の表示形式: 'json'、'markdown'、'text' (デフォルト: text)。Markdown と text は JSON よりコンパクトです",
+		},
+	},
+	"update_document": {
+		Description: "Google ドキュメントの内容を更新します",
+		Parameters: map[string]string{
+			"documentId": "Google ドキュメントの ID または URL",
+			"content":    "ドキュメントの新しい内容",
+		},
+	},
+	"append_table_rows": {
+		Description: "Google ドキュメント内の既存の表の末尾に行を追加します。作業ログや進捗表の更新などに使います。表は位置、または直前の見出しで指定します",
+		Parameters: map[string]string{
+			"documentId": "Google ドキュメントの ID または URL",
+			"rows":       "追加するセルの値の 2 次元配列 (1 行につき 1 つの内側の配列)。行の値の数は表の列数より少なくても構いません",
+			"tableIndex": "ドキュメント内の表の位置 (0 始まり、デフォルト: 0)。heading を指定した場合は無視されます",
+			"heading":    "このテキストを含む最初の見出し (大文字と小文字を区別しない) の後にある最初の表を使います",
+		},
+	},
+	"replace_document_image": {
+		Description: "Google ドキュメント内のインライン画像を URL の画像に置き換えます。元のサイズと位置は維持されます。レポート内のグラフやスクリーンショットの更新などに使います。画像はオブジェクト ID または位置で指定します",
+		Parameters: map[string]string{
+			"documentId":    "Google ドキュメントの ID または URL",
+			"imageUrl":      "新しい画像の公開された http(s) URL (PNG、JPEG、GIF、50MB 未満)",
+			"imageObjectId": "置き換えるインライン画像のオブジェクト ID",
+			"imageIndex":    "ドキュメントのインライン画像の中での位置 (0 始まり、デフォルト: 0)。imageObjectId を指定した場合は無視されます",
+		},
+	},
+	"get_document_images": {
+		Description: "Google ドキュメントのインライン画像を、短時間有効なコンテンツ URL とともにドキュメント順に一覧表示します。includeData を指定すると画像そのものも返します。本文で参照されている図の確認などに使います",
+		Parameters: map[string]string{
+			"documentId":  "Google ドキュメントの ID または URL",
+			"includeData": "画像をダウンロードし、画像コンテンツとして返します (デフォルト: false)",
+		},
+	},
+	"get_document_style": {
+		Description: "Google ドキュメントのページサイズ、向き、余白、名前付きスタイル (NORMAL_TEXT、HEADING_1 などのフォント、サイズ、間隔) を取得します。長さの単位はポイントです",
+		Parameters: map[string]string{
+			"documentId": "Google ドキュメントの ID または URL",
+		},
+	},
+	"update_document_style": {
+		Description: "Google ドキュメントのページサイズ、向き、余白を変更します。組織のテンプレートに合わせる場合などに使います。長さの単位はポイントです (72 ポイント = 1 インチ)。指定した設定のみが変更されます",
+		Parameters: map[string]string{
+			"documentId":   "Google ドキュメントの ID または URL",
+			"pageSize":     "標準のページサイズ",
+			"pageWidth":    "ページの幅 (ポイント)。pageSize より優先されます",
+			"pageHeight":   "ページの高さ (ポイント)。pageSize より優先されます",
+			"landscape":    "true で横向き、false で縦向き",
+			"marginTop":    "上余白 (ポイント)",
+			"marginBottom": "下余白 (ポイント)",
+			"marginLeft":   "左余白 (ポイント)",
+			"marginRight":  "右余白 (ポイント)",
+		},
+	},
+	"docs_batch_update": {
+		Description: "他のツールで扱えない機能のために、Google Docs API の batchUpdate リクエストをそのままドキュメントに実行します。各リクエストは 1 種類のリクエストを持つオブジェクトです。例: {\"insertText\": {\"location\": {\"index\": 1}, \"text\": \"Hello\"}}。リクエストは API スキーマで検証され、アトミックに適用されます",
+		Parameters: map[string]string{
+			"documentId":         "Google ドキュメントの ID または URL",
+			"requests":           "順に適用する Docs API の Request オブジェクト (最大 500 件)",
+			"requiredRevisionId": "この版以降にドキュメントが変更されていた場合、リクエストを適用せずに失敗させます",
+		},
+	},
+	"diff_documents": {
+		Description: "2 つの Google ドキュメント、または 1 つのドキュメントの 2 つの版のテキストを行単位で比較します。自動編集で変わった箇所の確認などに使います。otherDocumentId を省略すると、documentId の revisionId と otherRevisionId (デフォルト: 現在の内容) を比較します",
+		Parameters: map[string]string{
+			"documentId":      "比較元の Google ドキュメントの ID または URL",
+			"revisionId":      "比較する比較元ドキュメントの版 (デフォルト: 現在の内容)",
+			"otherDocumentId": "比較対象の Google ドキュメントの ID または URL (デフォルト: documentId)",
+			"otherRevisionId": "比較する比較対象ドキュメントの版 (デフォルト: 現在の内容)",
+			"contextLines":    "各変更の前後に表示する変更のない行数 (デフォルト: 3)",
+			"format":          "出力形式: unified diff の場合は 'unified'、JSON の hunk の場合は 'structured' (デフォルト: 'unified')",
+		},
+	},
+
+	// Presentation tools
+	"get_presentation": {
+		Description: "Google スライドのプレゼンテーションの内容を取得します",
+		Parameters: map[string]string{
+			"presentationId": "Google スライドのプレゼンテーションの ID または URL",
+			"format":         "結果の表示形式: 'json'、'markdown'、'text' (デフォルト: text)。Markdown と text は JSON よりコンパクトです",
+		},
+	},
+	"update_presentation": {
+		Description: "Google スライドのプレゼンテーションの特定のスライドを更新します",
+		Parameters: map[string]string{
+			"presentationId": "Google スライドのプレゼンテーションの ID または URL",
+			"slideIndex":     "更新するスライドのインデックス (0 始まり、デフォルト: 0)",
+			"title":          "スライドのタイトル",
+			"content":        "スライドの内容",
+		},
+	},
+	"get_slide_elements": {
+		Description: "スライド上の要素を、オブジェクト ID、種類、位置、サイズ (ポイント) とともに一覧表示します。重なっているテキストボックスを探す場合などに使います",
+		Parameters: map[string]string{
+			"presentationId": "Google スライドのプレゼンテーションの ID または URL",
+			"slideIndex":     "スライドのインデックス (0 始まり、デフォルト: 0)",
+		},
+	},
+	"update_slide_element": {
+		Description: "スライド上の要素を移動、サイズ変更します。長さはスライドの左上からのポイント数です (標準の 16:9 スライドは 720x405 ポイント)。指定した値のみが変更されます",
+		Parameters: map[string]string{
+			"presentationId": "Google スライドのプレゼンテーションの ID または URL",
+			"objectId":       "get_slide_elements で一覧表示される要素のオブジェクト ID",
+			"x":              "スライドの左端から要素の左端までの新しい距離",
+			"y":              "スライドの上端から要素の上端までの新しい距離",
+			"width":          "要素の新しい幅",
+			"height":         "要素の新しい高さ",
+		},
+	},
+	"diff_presentation_revisions": {
+		Description: "Google スライドのプレゼンテーションの 2 つの版を比較し、追加、削除、テキストが変更されたスライドを報告します。自動で編集されたスライドの確認などに使います",
+		Parameters: map[string]string{
+			"presentationId":  "Google スライドのプレゼンテーションの ID または URL",
+			"revisionId":      "比較元の版",
+			"otherRevisionId": "比較対象の版 (デフォルト: 現在の内容)",
+		},
+	},
+	"apply_presentation_template": {
+		Description: "テンプレートのテーマ、マスター、レイアウトで Google スライドのプレゼンテーションのデザインを変更します。生成したスライドを企業のブランドに合わせる場合などに使います。テンプレートのコピーを作成し、その中にスライドを作り直すため、元のプレゼンテーションは変更されません。テキスト、図形、画像は引き継がれ、表、グラフ、その他の要素はスキップとして報告されます",
+		Parameters: map[string]string{
+			"presentationId": "デザインを変更する Google スライドのプレゼンテーションの ID または URL",
+			"templateId":     "テンプレートのプレゼンテーションの ID または URL",
+			"name":           "新しいプレゼンテーションの名前 (デフォルト: 元のタイトルの後に '(themed)' を付けたもの)",
+			"folderId":       "新しいプレゼンテーションを作成するフォルダの ID または URL。空の場合は元のプレゼンテーションのフォルダを使います",
+		},
+	},
+	"refresh_sheets_charts": {
+		Description: "プレゼンテーション内のリンクされた Google スプレッドシートのグラフを、現在のスプレッドシートのデータで更新します。すべてのグラフ、または選択したグラフのみを更新できます",
+		Parameters: map[string]string{
+			"presentationId": "Google スライドのプレゼンテーションの ID または URL",
+			"objectIds":      "更新するグラフのオブジェクト ID。空の場合はリンクされたすべてのグラフを更新します",
+		},
+	},
+	"get_presentation_images": {
+		Description: "Google スライドのプレゼンテーションの各スライドの画像を、短時間有効なコンテンツ URL とともに一覧表示します。includeData を指定すると画像そのものも返します。画像の監査や再利用などに使います",
+		Parameters: map[string]string{
+			"presentationId": "Google スライドのプレゼンテーションの ID または URL",
+			"includeData":    "画像をダウンロードし、画像コンテンツとして返します (デフォルト: false)",
+		},
+	},
+	"slides_batch_update": {
+		Description: "他のツールで扱えないスライドの変更のために、Google Slides API の batchUpdate リクエストをそのままプレゼンテーションに実行します。各リクエストは 1 種類のリクエストを持つオブジェクトです。例: {\"createSlide\": {\"insertionIndex\": 1}}。リクエストは API スキーマで検証され、アトミックに適用されます",
+		Parameters: map[string]string{
+			"presentationId":     "Google スライドのプレゼンテーションの ID または URL",
+			"requests":           "順に適用する Slides API の Request オブジェクト (最大 500 件)",
+			"requiredRevisionId": "この版以降にプレゼンテーションが変更されていた場合、リクエストを適用せずに失敗させます",
+		},
+	},
+
+	// Spreadsheet tools
+	"get_spreadsheet": {
+		Description: "Google スプレッドシートから値を取得します",
+		Parameters: map[string]string{
+			"spreadsheetId":        "Google スプレッドシートの ID または URL",
+			"range":                "取得する範囲 (例: 'Sheet1!A1:C10')",
+			"valueRenderOption":    "値の表示方法: 表示どおり、数値・文字列・真偽値のまま、または数式 (デフォルト: FORMATTED_VALUE)",
+			"dateTimeRenderOption": "日付と時刻の表示方法: シリアル値、シートのロケールでの表示どおり、または '2024-06-10T13:45:00' のような ISO 8601。シリアル値と表示どおりの違いは書式なしの値と数式でのみ現れます。ISO8601 はすべての valueRenderOption に適用されます",
+			"format":               "結果の表示形式: 'json'、'markdown'、'text' (デフォルト: json)。Markdown と text は JSON よりコンパクトです",
+		},
+	},
+	"append_sheet_table_rows": {
+		Description: "Google スプレッドシートのシート上の表の最終行の直下に行を追加します。シートの最終行の下ではないため、1 つのシートに複数の表があるログでも正しい位置に追加されます。表は見出しセルのテキスト、または見出し行のデベロッパー メタデータで指定します。表の下にあるセルは下にずらされます",
+		Parameters: map[string]string{
+			"spreadsheetId": "Google スプレッドシートの ID または URL",
+			"rows":          "追加する値の 2 次元配列 (1 行につき 1 つの内側の配列)。表の最初の列から書き込まれます。値はシートに入力した場合と同様に解釈されます",
+			"header":        "表の見出しセルのテキスト (大文字と小文字を区別しない)。例: 最初の列の名前。表は最初に一致したセルから始まります",
+			"sheet":         "header を検索するシート (デフォルト: 最初のシート)",
+			"metadataKey":   "表の見出し行に付与されたデベロッパー メタデータのキー。header の代わりに使います",
+		},
+	},
+	"copy_spreadsheet_range": {
+		Description: "Google スプレッドシートの範囲を、別のスプレッドシート (または同じスプレッドシート) の範囲に 1 回の呼び出しでコピーします。集計用シートの作成などに使います。数式は計算結果の値としてコピーされます",
+		Parameters: map[string]string{
+			"sourceSpreadsheetId":      "コピー元のスプレッドシートの ID または URL",
+			"sourceRange":              "コピーする範囲 (例: 'Sheet1!A1:C10')",
+			"destinationSpreadsheetId": "コピー先のスプレッドシートの ID または URL (デフォルト: コピー元のスプレッドシート)",
+			"destinationRange":         "コピー先の範囲。コピー元はこの範囲の左上のセルから書き込まれます (例: 'Rollup!B2')",
+			"mode":                     "コピーする内容: 値と書式、値のみ、または書式のみ (デフォルト: all)",
+		},
+	},
+	"audit_spreadsheet_protection": {
+		Description: "Google スプレッドシートの保護範囲とその編集者をシートごとに、ファイルの共有権限とあわせて報告します。財務関連のブックのコンプライアンス確認などに使います",
+		Parameters: map[string]string{
+			"spreadsheetId": "Google スプレッドシートの ID または URL",
+		},
+	},
+	"snapshot_spreadsheet": {
+		Description: "危険な編集の前に、Google スプレッドシートをコピー (または .xlsx にエクスポート) して 'Snapshots 2026-10-15' のような日付入りフォルダにバックアップします。スナップショットの ID を返すので、そこから復元して変更を元に戻せます",
+		Parameters: map[string]string{
+			"spreadsheetId": "Google スプレッドシートの ID または URL",
+			"folderId":      "日付入りフォルダを作成するフォルダの ID または URL (デフォルト: スプレッドシートのフォルダ)",
+			"xlsx":          "Google スプレッドシートとしてコピーする代わりに .xlsx ファイルにエクスポートします (デフォルト: false)",
+		},
+	},
+
+	// Organize tools
+	"copy_file": {
+		Description: "Google Drive のファイルをコピーします。convert を指定すると、Office ファイル (.docx、.xlsx、.pptx) は Google ドキュメント、スプレッドシート、スライドとしてインポートされ、Google ドキュメント、スプレッドシート、スライドは対応する Office 形式にエクスポートされます",
+		Parameters: map[string]string{
+			"fileId":   "コピーするファイルの ID または URL",
+			"name":     "コピーの名前。空の場合は元の名前を使います (変換時は拡張子が調整されます)",
+			"folderId": "コピーを配置するフォルダの ID または URL。空の場合は元のファイルのフォルダを使います",
+			"convert":  "コピー時に Office 形式と Google Workspace 形式を変換します (デフォルト: false)",
+		},
+	},
+	"copy_folder": {
+		Description: "Google ドライブのフォルダとその配下すべてを新しいフォルダにコピーします。サブフォルダを再作成し、Google ドキュメント・スプレッドシート・スライドを含むすべてのファイルをコピーします。名前のプレースホルダを置き換えながら、テンプレートフォルダをクライアントごとやスプリントごとに複製するのに便利です",
+		Parameters: map[string]string{
+			"folderId":            "コピーするフォルダの ID または URL",
+			"name":                "新しいフォルダの名前。空の場合は置き換えを適用した元の名前を使います",
+			"destinationFolderId": "コピーを作成するフォルダの ID または URL。空の場合は元のフォルダの親を使います",
+			"replacements":        "コピーしたフォルダとファイルの名前で置き換えるテキスト。例: {\"{{client}}\": \"Acme\", \"Sprint N\": \"Sprint 12\"}",
+		},
+	},
+	"create_folder": {
+		Description: "Google Drive にフォルダを作成します。ファイルをアップロードやコピーする前にフォルダ階層を作るのに使います。新しいフォルダの ID と webViewLink を返します",
+		Parameters: map[string]string{
+			"name":     "新しいフォルダの名前",
+			"parentId": "フォルダを作成する親フォルダの ID または URL。空の場合はマイドライブのルートに作成します",
+		},
+	},
+	"create_shortcut": {
+		Description: "Google Drive のファイルやフォルダへのショートカットを作成します。ファイルを移動やコピーせずに別のフォルダに表示するときなどに使います。新しいショートカットの ID とリンク先の ID を返します",
+		Parameters: map[string]string{
+			"targetId": "ショートカットのリンク先のファイルまたはフォルダの ID または URL",
+			"name":     "ショートカットの名前。空の場合、リンク先の名前を使います",
+			"folderId": "ショートカットを作成するフォルダの ID または URL。空の場合、マイドライブのルートに作成します",
+		},
+	},
+	"move_file": {
+		Description: "Google Drive のファイルやフォルダを別のフォルダに移動します。共有ドライブ内や共有ドライブ間の移動にも対応します。ファイルは現在のフォルダから取り除かれますが、複数のフォルダに置かれている古いファイルは fromFolderId で指定したフォルダからだけ取り除けます",
+		Parameters: map[string]string{
+			"fileId":       "移動するファイルまたはフォルダの ID または URL",
+			"folderId":     "移動先のフォルダの ID または URL",
+			"fromFolderId": "ファイルを取り除くフォルダの ID または URL。ほかのフォルダには残ります。空の場合はすべてのフォルダから取り除きます。ファイルが必ず 1 つのフォルダにある共有ドライブでは無視されます",
+		},
+	},
+	"trash_file": {
+		Description: "Google Drive のファイルやフォルダをゴミ箱に移動します。ゴミ箱からは 30 日間復元できます。フォルダをゴミ箱に移動すると、その中身もすべて移動します。delete_file よりもこちらを優先してください",
+		Parameters: map[string]string{
+			"fileId": "ゴミ箱に移動するファイルまたはフォルダの ID または URL",
+		},
+	},
+	"batch_file_operation": {
+		Description: "多数の Google Drive ファイルを 1 回の呼び出しでまとめて移動、ゴミ箱に移動、コピー、スター付け、スター解除します。find_duplicates や search_files の後の一括整理などに使います。ファイルは並行して処理され、成否はファイルごとに報告されるので、1 件の失敗で他が止まることはありません",
+		Parameters: map[string]string{
+			"fileIds":   "ファイルまたはフォルダの ID または URL (最大 100 件)",
+			"operation": "すべてのファイルに適用する操作",
+			"folderId":  "move で移動先とするフォルダ (必須)、または copy でコピーを置くフォルダの ID または URL。空の場合、コピーは元のファイルと同じ場所に置かれます",
+		},
+	},
+	"delete_file": {
+		Description: "Google Drive のファイルやフォルダを、ゴミ箱を経由せずに完全に削除します。元に戻せないため、完全な削除を明示的に求められた場合以外は trash_file を使ってください。confirm を true にする必要があります",
+		Parameters: map[string]string{
+			"fileId":  "削除するファイルまたはフォルダの ID または URL",
+			"confirm": "完全な削除を確認するため true を指定します",
+		},
+	},
+	"empty_trash": {
+		Description: "Google Drive のゴミ箱にあるすべてのファイルを完全に削除します。元に戻せないため、先に list_trashed_files でゴミ箱の中身を確認してください。confirm を true にする必要があります",
+		Parameters: map[string]string{
+			"confirm": "ゴミ箱の中身をすべて完全に削除することを確認するため true を指定します",
+		},
+	},
+	"upload_from_url": {
+		Description: "http(s) URL のファイルをサーバー上で取得し、内容をクライアントに渡さずに Google Drive に保存します。受け付けるサイズとコンテンツタイプはサーバーで制限されます",
+		Parameters: map[string]string{
+			"url":      "取得する http または https の URL",
+			"name":     "新しいファイルの名前。空の場合は URL のファイル名を使います",
+			"folderId": "アップロード先のフォルダの ID または URL。空の場合はマイドライブのルートにアップロードします",
+			"convert":  "Office ファイル (.docx、.xlsx、.pptx) を Google ドキュメント、スプレッドシート、スライドとしてインポートします (デフォルト: false)",
+		},
+	},
+	"upload_file": {
+		Description: "テキストまたは base64 の内容から Google Drive に新しいファイルを作成します。生成したレポート、CSV、画像の保存などに使います。mimeType に 'application/vnd.google-apps.document' などの Google Workspace の形式を指定すると、内容を Google ドキュメントなどに変換します。サーバーは受け付けるサイズとコンテンツタイプを制限します",
+		Parameters: map[string]string{
+			"name":     "新しいファイルの名前 (拡張子を含む)",
+			"content":  "ファイルの内容。encoding に応じてテキストまたは base64 で指定します",
+			"encoding": "content のエンコード: UTF-8 テキストは text、バイナリファイルは base64 (デフォルト: text)",
+			"mimeType": "新しいファイルの MIME タイプ。空の場合は名前の拡張子または内容から推測します",
+			"folderId": "ファイルを作成するフォルダの ID または URL。空の場合はマイドライブのルートにアップロードします",
+		},
+	},
+	"update_file_metadata": {
+		Description: "Google Drive のファイルに、Drive の UI に表示される説明、スター、フォルダの色、検索用テキストを設定します。指定したパラメータのみが変更されます",
+		Parameters: map[string]string{
+			"fileId":         "ファイルの ID または URL",
+			"description":    "ファイルの短い説明。空文字列を指定すると削除されます",
+			"starred":        "ファイルにスターを付けるかどうか",
+			"folderColorRgb": "'#4986e7' のような 16 進数の RGB 文字列で指定するフォルダの色。Drive は最も近いパレットの色を使います。フォルダのみ",
+			"indexableText":  "内容でファイルを検索するときに使われる追加のテキスト。ユーザーには表示されません",
+		},
+	},
+	"set_file_properties": {
+		Description: "Google Drive のファイルにワークフローの状態などのカスタムのキーと値のプロパティを付けます。get_file_properties で読み取り、search_files_by_properties で検索できます。指定したキーだけを変更し、値が null のキーは削除します",
+		Parameters: map[string]string{
+			"fileId":        "ファイルの ID または URL",
+			"properties":    "設定するプロパティ (文字列のオブジェクト、例: {\"status\": \"reviewed\"})。値が null のプロパティは削除します。キーと値の合計は 124 バイトまでです",
+			"appProperties": "すべてのアプリから見える properties の代わりに、このアプリケーション専用のプロパティ (appProperties) を設定します (デフォルト: false)",
+		},
+	},
+	"set_file_label": {
+		Description: "Google Drive のファイルに Drive ラベルを適用してフィールドの値を設定するか、適用済みのラベルのフィールドを変更するか、ラベルを削除します。ラベルとそのフィールド、選択肢は list_labels で探します",
+		Parameters: map[string]string{
+			"fileId":  "ファイルの ID または URL",
+			"labelId": "ラベルの ID (list_labels で取得)",
+			"fields":  "設定するフィールドの値 (フィールドの ID または名前をキーとするオブジェクト、例: {\"Sensitivity\": \"Internal\"})。値は文字列、または複数の値をとるフィールドでは文字列の配列です。null または空の配列はフィールドをクリアします。値はフィールドの種類に応じて、テキスト、整数、YYYY-MM-DD 形式の日付、選択肢の ID または名前、ユーザーのメールアドレスのいずれかです。指定しないフィールドは変更しません",
+			"remove":  "ラベルを適用する代わりにファイルから削除します (デフォルト: false)",
+		},
+	},
+	"share_file": {
+		Description: "Google Drive のファイルやフォルダを、ユーザー、グループ、ドメイン、またはリンクを知っている全員と共有します。生成したドキュメントをチームメンバーに渡すときなどに使います。現在のアクセス権は list_permissions で確認できます",
+		Parameters: map[string]string{
+			"fileId":                "共有するファイルまたはフォルダの ID または URL",
+			"role":                  "付与するアクセス権",
+			"type":                  "共有相手: emailAddress で指定するユーザーまたはグループ、ドメインの全員、またはリンクを知っている全員 (デフォルト: user)",
+			"emailAddress":          "ユーザーまたはグループのメールアドレス。user と group の場合は必須です",
+			"domain":                "共有するドメイン (例: 'example.com')。domain の場合は必須です",
+			"sendNotificationEmail": "ファイルが共有されたことをユーザーまたはグループにメールで通知します (デフォルト: true)。domain と anyone の場合は無視されます",
+			"emailMessage":          "通知メールに含めるメッセージ",
+		},
+	},
+	"get_sharing_link": {
+		Description: "Google Drive のファイルをブラウザで開くリンクを、誰が開けるかとあわせて取得します。チャットに貼り付けるときなどに使います。anyoneRole を指定すると、リンクを知っている全員がそのロールでファイルを開けるようにもします",
+		Parameters: map[string]string{
+			"fileId":     "ファイルの ID または URL",
+			"anyoneRole": "リンク共有を有効にし、リンクを知っている全員にこのロールを付与します。空の場合、アクセス権は変更されません",
+		},
+	},
+	"restore_revision": {
+		Description: "Google Drive のファイルの内容を以前のリビジョンで上書きします。意図しない update_document の呼び出しを取り消すときなどに使います。リビジョンは list_revisions で探します。置き換えられた内容もリビジョンとして残るため、復元も同じ方法で取り消せます",
+		Parameters: map[string]string{
+			"fileId":     "ファイルの ID または URL",
+			"revisionId": "復元するリビジョンの ID (list_revisions で取得)",
+		},
+	},
+	"add_comment": {
+		Description: "Google Drive のファイルにコメントを追加します。ドキュメントを直接編集する代わりにレビューのフィードバックを残すときなどに使います。コメントはファイル全体に付き、特定のテキストには関連付けられません",
+		Parameters: map[string]string{
+			"fileId":  "ファイルの ID または URL",
+			"content": "コメントの本文",
+		},
+	},
+	"reply_to_comment": {
+		Description: "Google Drive のファイルのコメントのスレッドに返信します。コメントは list_comments で探します",
+		Parameters: map[string]string{
+			"fileId":    "ファイルの ID または URL",
+			"commentId": "返信するコメントの ID (list_comments で取得)",
+			"content":   "返信の本文",
+		},
+	},
+	"resolve_comment": {
+		Description: "Google Drive のファイルのコメントのスレッドを解決済みにします。フィードバックに対応し終えたときなどに使います。コメントは list_comments で探します",
+		Parameters: map[string]string{
+			"fileId":    "ファイルの ID または URL",
+			"commentId": "解決するコメントの ID (list_comments で取得)",
+			"content":   "解決とあわせて投稿する返信の本文 (変更内容など)。空の場合、メッセージなしで解決します",
+		},
+	},
+	"star_file": {
+		Description: "Google Drive のファイルやフォルダにスターを付けます。あとで対応するものの目印などに使います。スター付きのファイルは list_starred_files で一覧表示できます",
+		Parameters: map[string]string{
+			"fileId": "スターを付けるファイルまたはフォルダの ID または URL",
+		},
+	},
+	"unstar_file": {
+		Description: "Google Drive のファイルやフォルダからスターを外します",
+		Parameters: map[string]string{
+			"fileId": "スターを外すファイルまたはフォルダの ID または URL",
+		},
+	},
+	"find_empty_folders": {
+		Description: "フォルダの配下にある、空のフォルダしか含まないフォルダを探し、必要に応じてゴミ箱に移動します。dryRun が false でない限り一覧表示のみを行うため、ゴミ箱に移動する前に結果を確認してください",
+		Parameters: map[string]string{
+			"folderId": "スキャンするフォルダの ID または URL",
+			"dryRun":   "ゴミ箱に移動せずに空のフォルダを一覧表示するだけにします (デフォルト: true)",
+		},
+	},
+	"upload_directory": {
+		Description: "サーバーが動作しているマシン上のディレクトリを新しいフォルダとして Google ドライブにアップロードします。サブディレクトリを再作成し、ファイルを並行してアップロードします。クライアントが progress token を送った場合はファイルごとに進捗を報告します",
+		Parameters: map[string]string{
+			"path":     "アップロードするローカルディレクトリ。絶対パスを推奨します",
+			"folderId": "アップロードしたフォルダを作成するフォルダの ID または URL。空の場合はマイドライブのルートを使います",
+		},
+	},
+	"export_folder_zip": {
+		Description: "フォルダ配下のすべてのファイルを、サブフォルダも含めてフォルダ構成どおりの 1 つの zip アーカイブとしてエクスポートします。Google ドキュメント・スプレッドシート・スライド・図形描画は選んだ形式に変換され、ショートカットとフォームはスキップされます。アーカイブは base64 で返すか Drive に保存します",
+		Parameters: map[string]string{
+			"folderId":            "エクスポートするフォルダの ID または URL",
+			"formats":             "Google Workspace ファイルの種類ごとのエクスポート形式を拡張子で指定します。例: {\"document\": \"pdf\", \"spreadsheet\": \"csv\"}。document: docx・odt・rtf・pdf・txt・md・epub、spreadsheet: xlsx・ods・pdf・csv・tsv (csv と tsv は最初のシートのみ)、presentation: pptx・odp・pdf・txt、drawing: png・jpg・svg・pdf。デフォルトは docx・xlsx・pptx・png",
+			"saveToDrive":         "アーカイブの内容を返す代わりに Drive にアップロードします (デフォルト: false)",
+			"destinationFolderId": "saveToDrive のときにアーカイブを保存するフォルダの ID または URL。空の場合はマイドライブのルートに保存します",
+			"name":                "アーカイブのファイル名。空の場合はフォルダ名に .zip を付けた名前を使います",
+		},
+	},
+
+	// Watch tools
+	"watch_file": {
+		Description: "このセッションの間、ファイルの変更を監視します。ファイルは一定間隔で確認され、名前の変更、移動、ゴミ箱への移動、削除を含む各変更が、返された uri の notifications/resources/updated 通知として送られます",
+		Parameters: map[string]string{
+			"fileId": "監視するファイルの ID または URL",
+		},
+	},
+	"watch_folder": {
+		Description: "このセッションの間、フォルダの変更を監視します。フォルダは一定間隔で確認され、フォルダ自体や直下のファイルへの変更 (ファイルの追加、編集、削除など) が、返された uri の notifications/resources/updated 通知として送られます",
+		Parameters: map[string]string{
+			"folderId": "監視するフォルダの ID または URL",
+		},
+	},
+	"unwatch": {
+		Description: "watch_file または watch_folder で開始した監視を停止します",
+		Parameters: map[string]string{
+			"watchId": "watch_file または watch_folder が返した監視の ID",
+		},
+	},
+
+	// Server tools
+	"server_info": {
+		Description: "サーバーのバージョン、有効なツール、認証されたアカウント、許可されたスコープ、設定を報告します",
+	},
+	"get_storage_quota": {
+		Description: "認証されたアカウントのストレージ使用量と上限をバイト単位で、Drive とゴミ箱内のファイルによる使用量の内訳、アカウントのメールアドレスと名前とともに報告します。容量に関する質問や、サーバーがどのアカウントとして動作しているかの確認に使います",
+	},
+	"diagnose_auth": {
+		Description: "サーバーの認証情報、付与されたスコープ、Drive・ドキュメント・スライド・スプレッドシートの各 API へのアクセスをテスト呼び出しで確認します。quota project の未設定、スコープの不足、プロジェクトで API が有効になっていないなどの失敗には、手順付きの解決方法を返します",
+	},
+	"reload_credentials": {
+		Description: "サーバーの Google 認証情報を読み込み直し、解決されたアカウントを報告します。gcloud でのログイン、認証情報ファイルの置き換え、認証の問題の修正の後に、サーバーを再起動する代わりに使います",
+	},
+}
@@ -0,0 +1,1080 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/drivelabels/v2"
+)
+
+// OrganizeTools returns the Google Drive tools that reorganize and clean up files, backed by fileOrganizer
+func OrganizeTools(fileOrganizer gdrive.FileOrganizer) []Tool {
+	// Define copy file tool
+	copyFileTool := mcp.NewTool(
+		"copy_file",
+		mcp.WithDescription("Copy a Google Drive file. With convert, Office files (.docx, .xlsx, .pptx) are imported as native Google Docs, Sheets, or Slides, and Google Docs, Sheets, or Slides are exported to the matching Office format"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file to copy"), mcp.Required()),
+		mcp.WithString("name", mcp.Description("The name of the copy. If empty, keeps the original name (with the extension adjusted when converting)")),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of the folder to put the copy in. If empty, uses the original file's folder")),
+		mcp.WithBoolean("convert", mcp.Description("Convert between Office and Google Workspace formats while copying (default: false)"), mcp.DefaultBool(false)),
+	)
+
+	// Define copy folder tool
+	copyFolderTool := mcp.NewTool(
+		"copy_folder",
+		mcp.WithDescription("Copy a Google Drive folder and everything below it to a new folder, recreating the subfolders and copying every file, "+
+			"including Google Docs, Sheets, and Slides. Useful for cloning a template folder per client or per sprint, with placeholders in names replaced"),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of the folder to copy"), mcp.Required()),
+		mcp.WithString("name", mcp.Description("The name of the new folder. If empty, keeps the original name, with replacements applied")),
+		mcp.WithString("destinationFolderId", mcp.Description("The ID or URL of the folder to create the copy in. If empty, uses the original folder's parent")),
+		mcp.WithObject("replacements",
+			mcp.Description("Text to replace in the names of the copied folders and files, e.g. {\"{{client}}\": \"Acme\", \"Sprint N\": \"Sprint 12\"}"),
+			mcp.AdditionalProperties(map[string]any{"type": "string"}),
+		),
+	)
+
+	// Define create folder tool
+	createFolderTool := mcp.NewTool(
+		"create_folder",
+		mcp.WithDescription("Create a folder in Google Drive, e.g. to build a folder hierarchy before uploading or copying files into it. Returns the new folder's ID and webViewLink"),
+		mcp.WithString("name", mcp.Description("The name of the new folder"), mcp.Required()),
+		mcp.WithString("parentId", mcp.Description("The ID or URL of the folder to create the folder in. If empty, creates it in My Drive root")),
+	)
+
+	// Define create shortcut tool
+	createShortcutTool := mcp.NewTool(
+		"create_shortcut",
+		mcp.WithDescription("Create a shortcut to a Google Drive file or folder, e.g. to make a file appear in another folder without moving or copying it. Returns the new shortcut's ID and the ID of its target"),
+		mcp.WithString("targetId", mcp.Description("The ID or URL of the file or folder the shortcut points to"), mcp.Required()),
+		mcp.WithString("name", mcp.Description("The name of the shortcut. If empty, uses the target's name")),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of the folder to create the shortcut in. If empty, creates it in My Drive root")),
+	)
+
+	// Define move file tool
+	moveFileTool := mcp.NewTool(
+		"move_file",
+		mcp.WithDescription("Move a Google Drive file or folder into another folder, including within and between shared drives. The file is removed from its current folders unless fromFolderId names the only one to leave, for older files that still live in several folders"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file or folder to move"), mcp.Required()),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of the folder to move it into"), mcp.Required()),
+		mcp.WithString("fromFolderId", mcp.Description("The ID or URL of the one folder to remove the file from, keeping its other folders. If empty, removes it from all of them. Ignored in shared drives, where files have exactly one folder")),
+	)
+
+	// Define trash file tool
+	trashFileTool := mcp.NewTool(
+		"trash_file",
+		mcp.WithDescription("Move a Google Drive file or folder to the trash, where it can be restored from for 30 days. Trashing a folder trashes everything in it. Prefer this over delete_file"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file or folder to trash"), mcp.Required()),
+	)
+
+	// Define batch file operation tool
+	batchFileOperationTool := mcp.NewTool(
+		"batch_file_operation",
+		mcp.WithDescription("Move, trash, copy, star, or unstar many Google Drive files in one call, e.g. for bulk cleanup after find_duplicates or search_files. The files are processed concurrently, and each one's success or failure is reported separately, so one failure does not stop the others"),
+		mcp.WithArray("fileIds", mcp.Description(fmt.Sprintf("The IDs or URLs of the files or folders, at most %d", gdrive.MaxBatchFiles)), mcp.Required(), mcp.WithStringItems()),
+		mcp.WithString("operation", mcp.Description("The operation to apply to every file"), mcp.Required(), mcp.Enum(gdrive.BatchMove, gdrive.BatchTrash, gdrive.BatchCopy, gdrive.BatchStar, gdrive.BatchUnstar)),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of the folder to move the files into, required for move, or to put the copies in for copy. If empty, each copy is placed next to its original")),
+	)
+
+	// Define delete file tool
+	deleteFileTool := mcp.NewTool(
+		"delete_file",
+		mcp.WithDescription("Permanently delete a Google Drive file or folder, skipping the trash. This cannot be undone; use trash_file unless permanent deletion was explicitly requested. Requires confirm to be true"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file or folder to delete"), mcp.Required()),
+		mcp.WithBoolean("confirm", mcp.Description("Must be true to confirm the permanent deletion"), mcp.Required()),
+	)
+
+	// Define empty trash tool
+	emptyTrashTool := mcp.NewTool(
+		"empty_trash",
+		mcp.WithDescription("Permanently delete every file in the Google Drive trash. This cannot be undone; review the trash with list_trashed_files first. Requires confirm to be true"),
+		mcp.WithBoolean("confirm", mcp.Description("Must be true to confirm permanently deleting everything in the trash"), mcp.Required()),
+	)
+
+	// Define upload from URL tool
+	uploadFromURLTool := mcp.NewTool(
+		"upload_from_url",
+		mcp.WithDescription("Fetch a file from an http(s) URL on the server and store it in Google Drive, without passing the content through the client. The server limits the size and content types it accepts"),
+		mcp.WithString("url", mcp.Description("The http or https URL to fetch"), mcp.Required()),
+		mcp.WithString("name", mcp.Description("The name of the new file. If empty, uses the file name from the URL")),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of the folder to upload to. If empty, uploads to My Drive root")),
+		mcp.WithBoolean("convert", mcp.Description("Import Office files (.docx, .xlsx, .pptx) as Google Docs, Sheets, or Slides (default: false)"), mcp.DefaultBool(false)),
+	)
+
+	// Define upload file tool
+	uploadFileTool := mcp.NewTool(
+		"upload_file",
+		mcp.WithDescription("Create a new file in Google Drive from text or base64 content, e.g. to save a generated report, CSV, or image. Set mimeType to a Google Workspace type such as 'application/vnd.google-apps.document' to convert the content into a Google Doc. The server limits the size and content types it accepts"),
+		mcp.WithString("name", mcp.Description("The name of the new file, including its extension"), mcp.Required()),
+		mcp.WithString("content", mcp.Description("The file's content, as text or as base64 depending on encoding"), mcp.Required()),
+		mcp.WithString("encoding", mcp.Description("How content is encoded: text for UTF-8 text, or base64 for binary files (default: text)"), mcp.Enum("text", "base64"), mcp.DefaultString("text")),
+		mcp.WithString("mimeType", mcp.Description("The MIME type of the new file. If empty, guessed from the name's extension or the content")),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of the folder to create the file in. If empty, uploads to My Drive root")),
+	)
+
+	// Define update file metadata tool
+	updateFileMetadataTool := mcp.NewTool(
+		"update_file_metadata",
+		mcp.WithDescription("Annotate a Google Drive file with a description, starred state, folder color, or search text visible in the Drive UI. Only the given parameters are changed"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithString("description", mcp.Description("A short human-readable description of the file. An empty string clears it")),
+		mcp.WithBoolean("starred", mcp.Description("Whether the file is starred")),
+		mcp.WithString("folderColorRgb", mcp.Description("The folder color as a hex RGB string such as '#4986e7'; Drive uses the closest palette color. Folders only")),
+		mcp.WithString("indexableText", mcp.Description("Extra text used when searching for the file by content, not shown to users")),
+	)
+
+	// Define set file properties tool
+	setFilePropertiesTool := mcp.NewTool(
+		"set_file_properties",
+		mcp.WithDescription("Tag a Google Drive file with custom key/value properties, e.g. a workflow state, that tools can read back with get_file_properties and search for with search_files_by_properties. Only the given keys are changed; a null value removes a key"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithObject("properties",
+			mcp.Description("The properties to set, as an object of strings, e.g. {\"status\": \"reviewed\"}. A null value removes the property. A key and its value may total at most 124 bytes"),
+			mcp.Required(),
+		),
+		mcp.WithBoolean("appProperties", mcp.Description("Set the properties private to this application (appProperties) instead of the public properties visible to all apps (default: false)"), mcp.DefaultBool(false)),
+	)
+
+	// Define set file label tool
+	setFileLabelTool := mcp.NewTool(
+		"set_file_label",
+		mcp.WithDescription("Apply a Drive label to a Google Drive file and set the values of its fields, change the fields of a label already applied, or remove a label. Find labels, their fields, and their choices with list_labels"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithString("labelId", mcp.Description("The ID of the label, from list_labels"), mcp.Required()),
+		mcp.WithObject("fields",
+			mcp.Description("The field values to set, keyed by field ID or name, e.g. {\"Sensitivity\": \"Internal\"}. Each value is a string, or an array of strings for fields taking several values; null or an empty array clears the field. "+
+				"Values are text, integers, YYYY-MM-DD dates, choice IDs or names, or user email addresses, depending on the field type. Fields not given are left unchanged"),
+		),
+		mcp.WithBoolean("remove", mcp.Description("Remove the label from the file instead of applying it (default: false)"), mcp.DefaultBool(false)),
+	)
+
+	// Define share file tool
+	shareFileTool := mcp.NewTool(
+		"share_file",
+		mcp.WithDescription("Share a Google Drive file or folder with a user, group, domain, or anyone with the link, e.g. to hand a generated document to teammates. Review current access with list_permissions"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file or folder to share"), mcp.Required()),
+		mcp.WithString("role", mcp.Description("The access to grant"), mcp.Required(), mcp.Enum("reader", "commenter", "writer")),
+		mcp.WithString("type", mcp.Description("Who to share with: a user or group by emailAddress, everyone in a domain, or anyone with the link (default: user)"), mcp.Enum("user", "group", "domain", "anyone"), mcp.DefaultString("user")),
+		mcp.WithString("emailAddress", mcp.Description("The email address of the user or group. Required for the user and group types")),
+		mcp.WithString("domain", mcp.Description("The domain to share with, e.g. 'example.com'. Required for the domain type")),
+		mcp.WithBoolean("sendNotificationEmail", mcp.Description("Email the user or group that the file was shared with them (default: true). Ignored for the domain and anyone types"), mcp.DefaultBool(true)),
+		mcp.WithString("emailMessage", mcp.Description("A message to include in the notification email")),
+	)
+
+	// Define get sharing link tool
+	getSharingLinkTool := mcp.NewTool(
+		"get_sharing_link",
+		mcp.WithDescription("Get the link that opens a Google Drive file in the browser, e.g. to paste into chat, with who can open it. Set anyoneRole to also let anyone with the link open the file with that role"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithString("anyoneRole", mcp.Description("Turn on link sharing, giving anyone with the link this role. If empty, access is left unchanged"), mcp.Enum("reader", "commenter", "writer")),
+	)
+
+	// Define restore revision tool
+	restoreRevisionTool := mcp.NewTool(
+		"restore_revision",
+		mcp.WithDescription("Overwrite the content of a Google Drive file with one of its earlier revisions, e.g. to undo an unwanted update_document call. Find the revision with list_revisions. The replaced content becomes a revision itself, so the restore can be undone the same way"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithString("revisionId", mcp.Description("The ID of the revision to restore, from list_revisions"), mcp.Required()),
+	)
+
+	// Define add comment tool
+	addCommentTool := mcp.NewTool(
+		"add_comment",
+		mcp.WithDescription("Add a comment on a Google Drive file, e.g. to leave review feedback instead of editing a document directly. The comment is on the whole file, not anchored to any text"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithString("content", mcp.Description("The text of the comment"), mcp.Required()),
+	)
+
+	// Define reply to comment tool
+	replyToCommentTool := mcp.NewTool(
+		"reply_to_comment",
+		mcp.WithDescription("Reply to a comment thread on a Google Drive file. Find the comment with list_comments"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithString("commentId", mcp.Description("The ID of the comment to reply to, from list_comments"), mcp.Required()),
+		mcp.WithString("content", mcp.Description("The text of the reply"), mcp.Required()),
+	)
+
+	// Define resolve comment tool
+	resolveCommentTool := mcp.NewTool(
+		"resolve_comment",
+		mcp.WithDescription("Mark a comment thread on a Google Drive file as resolved, e.g. once its feedback has been addressed. Find the comment with list_comments"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file"), mcp.Required()),
+		mcp.WithString("commentId", mcp.Description("The ID of the comment to resolve, from list_comments"), mcp.Required()),
+		mcp.WithString("content", mcp.Description("Text of a reply posted with the resolution, e.g. what was changed. If empty, the thread is resolved without a message")),
+	)
+
+	// Define star file tool
+	starFileTool := mcp.NewTool(
+		"star_file",
+		mcp.WithDescription("Star a Google Drive file or folder, e.g. to flag it for follow-up. Starred files are listed by list_starred_files"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file or folder to star"), mcp.Required()),
+	)
+
+	// Define unstar file tool
+	unstarFileTool := mcp.NewTool(
+		"unstar_file",
+		mcp.WithDescription("Remove the star from a Google Drive file or folder"),
+		mcp.WithString("fileId", mcp.Description("The ID or URL of the file or folder to unstar"), mcp.Required()),
+	)
+
+	// Define find empty folders tool
+	findEmptyFoldersTool := mcp.NewTool(
+		"find_empty_folders",
+		mcp.WithDescription("Find folders below a folder that contain nothing but other empty folders, and optionally move them to the trash. Runs as a dry run unless dryRun is false, so review the listing before trashing"),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of the folder to scan"), mcp.Required()),
+		mcp.WithBoolean("dryRun", mcp.Description("Only list the empty folders without trashing them (default: true)"), mcp.DefaultBool(true)),
+	)
+
+	// Define export folder zip tool
+	exportFolderZipTool := mcp.NewTool(
+		"export_folder_zip",
+		mcp.WithDescription(fmt.Sprintf("Export every file below a folder, subfolders included, as one zip archive mirroring the folder structure. "+
+			"Google Docs, Sheets, Slides, and Drawings are converted to the chosen formats; shortcuts and forms are skipped. "+
+			"The archive is returned as base64 or saved to Drive. The files may total at most %d MB", gdrive.MaxArchiveSize>>20)),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of the folder to export"), mcp.Required()),
+		mcp.WithObject("formats",
+			mcp.Description("The format to export each kind of Google Workspace file as, by file extension, e.g. {\"document\": \"pdf\", \"spreadsheet\": \"csv\"}. "+
+				"document: docx, odt, rtf, pdf, txt, md, or epub; spreadsheet: xlsx, ods, pdf, csv, or tsv (csv and tsv hold the first sheet only); "+
+				"presentation: pptx, odp, pdf, or txt; drawing: png, jpg, svg, or pdf. Defaults to docx, xlsx, pptx, and png"),
+			mcp.AdditionalProperties(map[string]any{"type": "string"}),
+		),
+		mcp.WithBoolean("saveToDrive", mcp.Description("Upload the archive to Drive instead of returning its content (default: false)"), mcp.DefaultBool(false)),
+		mcp.WithString("destinationFolderId", mcp.Description("The ID or URL of the folder to save the archive in with saveToDrive. If empty, saves to My Drive root")),
+		mcp.WithString("name", mcp.Description("The archive's file name. If empty, uses the folder's name with a .zip extension")),
+	)
+
+	return []Tool{
+		{Tool: copyFileTool, Handler: createCopyFileHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: copyFolderTool, Handler: createCopyFolderHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: createFolderTool, Handler: createCreateFolderHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: createShortcutTool, Handler: createCreateShortcutHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: moveFileTool, Handler: createMoveFileHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: trashFileTool, Handler: createTrashFileHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: batchFileOperationTool, Handler: createBatchFileOperationHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: deleteFileTool, Handler: createDeleteFileHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: emptyTrashTool, Handler: createEmptyTrashHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: uploadFromURLTool, Handler: createUploadFromURLHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: uploadFileTool, Handler: createUploadFileHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: updateFileMetadataTool, Handler: createUpdateFileMetadataHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: setFilePropertiesTool, Handler: createSetFilePropertiesHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: setFileLabelTool, Handler: createSetFileLabelHandler(fileOrganizer), Scopes: []string{drive.DriveScope, drivelabels.DriveLabelsReadonlyScope}},
+		{Tool: shareFileTool, Handler: createShareFileHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: getSharingLinkTool, Handler: createGetSharingLinkHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: restoreRevisionTool, Handler: createRestoreRevisionHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: addCommentTool, Handler: createAddCommentHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: replyToCommentTool, Handler: createReplyToCommentHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: resolveCommentTool, Handler: createResolveCommentHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: starFileTool, Handler: createStarFileHandler(fileOrganizer, true), Scopes: []string{drive.DriveScope}},
+		{Tool: unstarFileTool, Handler: createStarFileHandler(fileOrganizer, false), Scopes: []string{drive.DriveScope}},
+		{Tool: findEmptyFoldersTool, Handler: createFindEmptyFoldersHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+		{Tool: exportFolderZipTool, Handler: createExportFolderZipHandler(fileOrganizer), Scopes: []string{drive.DriveScope}},
+	}
+}
+
+func createCopyFileHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		name := mcp.ParseString(request, "name", "")
+		folderID := gdrive.ResolveFileID(mcp.ParseString(request, "folderId", ""))
+		convert := mcp.ParseBoolean(request, "convert", false)
+
+		// Copy file
+		file, err := fileOrganizer.CopyFile(ctx, fileID, name, folderID, convert)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to copy file: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(file)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createCopyFolderHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		folderID, err := requireFileID(request, "folderId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'folderId' is required"), nil
+		}
+
+		name := mcp.ParseString(request, "name", "")
+		destinationFolderID := gdrive.ResolveFileID(mcp.ParseString(request, "destinationFolderId", ""))
+		var replacements map[string]string
+		if rawReplacements, ok := request.GetArguments()["replacements"].(map[string]any); ok {
+			replacements = make(map[string]string, len(rawReplacements))
+			for key, value := range rawReplacements {
+				s, ok := value.(string)
+				if !ok {
+					return mcp.NewToolResultError(fmt.Sprintf("Replacement for '%s' must be a string", key)), nil
+				}
+				replacements[key] = s
+			}
+		}
+
+		// Copy folder
+		folderCopy, err := fileOrganizer.CopyFolder(ctx, folderID, name, destinationFolderID, replacements)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to copy folder: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(folderCopy)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createCreateFolderHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		name, err := request.RequireString("name")
+		if err != nil || name == "" {
+			return mcp.NewToolResultError("Parameter 'name' is required"), nil
+		}
+
+		parentID := mcp.ParseString(request, "parentId", "")
+
+		// Create folder
+		folder, err := fileOrganizer.CreateFolder(ctx, name, parentID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to create folder: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(folder)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createCreateShortcutHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		targetID, err := request.RequireString("targetId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'targetId' is required"), nil
+		}
+
+		name := mcp.ParseString(request, "name", "")
+		folderID := gdrive.ResolveFileID(mcp.ParseString(request, "folderId", ""))
+
+		// Create shortcut
+		shortcut, err := fileOrganizer.CreateShortcut(ctx, targetID, name, folderID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to create shortcut: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(shortcut)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createMoveFileHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		folderID, err := requireFileID(request, "folderId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'folderId' is required"), nil
+		}
+
+		fromFolderID := gdrive.ResolveFileID(mcp.ParseString(request, "fromFolderId", ""))
+
+		// Move file
+		file, err := fileOrganizer.MoveFile(ctx, fileID, folderID, fromFolderID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to move file: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(file)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createTrashFileHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		// Trash file
+		file, err := fileOrganizer.TrashFile(ctx, fileID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to trash file: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(file)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createBatchFileOperationHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileIDs, err := request.RequireStringSlice("fileIds")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileIds' is required"), nil
+		}
+		for i, fileID := range fileIDs {
+			fileIDs[i] = gdrive.ResolveFileID(fileID)
+		}
+
+		operation, err := request.RequireString("operation")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'operation' is required"), nil
+		}
+
+		folderID := gdrive.ResolveFileID(mcp.ParseString(request, "folderId", ""))
+
+		// Apply operation to all files
+		results, err := fileOrganizer.BatchFileOperation(ctx, fileIDs, gdrive.BatchOperation{Operation: operation, FolderID: folderID})
+		if err != nil {
+			return mcp.NewToolResultError("Failed to apply batch operation: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		failed := 0
+		for _, result := range results {
+			if result.Error != "" {
+				failed++
+			}
+		}
+		result := map[string]any{
+			"results":   results,
+			"succeeded": len(results) - failed,
+			"failed":    failed,
+		}
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createDeleteFileHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		// Deleting is irreversible, so it only goes ahead when explicitly confirmed
+		if !mcp.ParseBoolean(request, "confirm", false) {
+			return mcp.NewToolResultError("Permanent deletion cannot be undone: set 'confirm' to true to delete the file, or use trash_file instead"), nil
+		}
+
+		// Delete file
+		if err := fileOrganizer.DeleteFile(ctx, fileID); err != nil {
+			return mcp.NewToolResultError("Failed to delete file: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText("File deleted permanently"), nil
+	}
+}
+
+func createEmptyTrashHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Emptying the trash is irreversible, so it only goes ahead when explicitly confirmed
+		if !mcp.ParseBoolean(request, "confirm", false) {
+			return mcp.NewToolResultError("Emptying the trash cannot be undone: set 'confirm' to true to permanently delete everything in it"), nil
+		}
+
+		// Empty trash
+		if err := fileOrganizer.EmptyTrash(ctx); err != nil {
+			return mcp.NewToolResultError("Failed to empty trash: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText("Trash emptied"), nil
+	}
+}
+
+func createUploadFromURLHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		rawURL, err := request.RequireString("url")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'url' is required"), nil
+		}
+
+		name := mcp.ParseString(request, "name", "")
+		folderID := gdrive.ResolveFileID(mcp.ParseString(request, "folderId", ""))
+		convert := mcp.ParseBoolean(request, "convert", false)
+
+		// Fetch and upload file
+		file, err := fileOrganizer.UploadFromURL(ctx, rawURL, name, folderID, convert)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to upload from URL: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(file)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createUploadFileHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		name, err := request.RequireString("name")
+		if err != nil || name == "" {
+			return mcp.NewToolResultError("Parameter 'name' is required"), nil
+		}
+
+		rawContent, err := request.RequireString("content")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'content' is required"), nil
+		}
+
+		var content []byte
+		switch encoding := mcp.ParseString(request, "encoding", "text"); encoding {
+		case "text":
+			content = []byte(rawContent)
+		case "base64":
+			content, err = base64.StdEncoding.DecodeString(rawContent)
+			if err != nil {
+				return mcp.NewToolResultError("Parameter 'content' is not valid base64: " + err.Error()), nil
+			}
+		default:
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid encoding '%s': must be text or base64", encoding)), nil
+		}
+
+		mimeType := mcp.ParseString(request, "mimeType", "")
+		folderID := gdrive.ResolveFileID(mcp.ParseString(request, "folderId", ""))
+
+		// Upload file
+		file, err := fileOrganizer.UploadFile(ctx, name, mimeType, folderID, content)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to upload file: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(file)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createUpdateFileMetadataHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		// Only parameters present in the request are changed
+		var update gdrive.FileMetadataUpdate
+		args := request.GetArguments()
+		if _, ok := args["description"]; ok {
+			description := mcp.ParseString(request, "description", "")
+			update.Description = &description
+		}
+		if _, ok := args["starred"]; ok {
+			update.Starred = mcp.ToBoolPtr(mcp.ParseBoolean(request, "starred", false))
+		}
+		if _, ok := args["folderColorRgb"]; ok {
+			folderColorRGB := mcp.ParseString(request, "folderColorRgb", "")
+			update.FolderColorRGB = &folderColorRGB
+		}
+		if _, ok := args["indexableText"]; ok {
+			indexableText := mcp.ParseString(request, "indexableText", "")
+			update.IndexableText = &indexableText
+		}
+
+		// Update file metadata
+		file, err := fileOrganizer.UpdateFileMetadata(ctx, fileID, update)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to update file metadata: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(file)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createSetFilePropertiesHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		rawProperties, ok := request.GetArguments()["properties"].(map[string]any)
+		if !ok || len(rawProperties) == 0 {
+			return mcp.NewToolResultError("Parameter 'properties' is required"), nil
+		}
+
+		properties := make(map[string]*string, len(rawProperties))
+		for key, value := range rawProperties {
+			if value == nil {
+				properties[key] = nil
+				continue
+			}
+			s, ok := value.(string)
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("Property '%s' must be a string or null", key)), nil
+			}
+			properties[key] = &s
+		}
+
+		appProperties := mcp.ParseBoolean(request, "appProperties", false)
+
+		// Set file properties
+		result, err := fileOrganizer.SetFileProperties(ctx, fileID, properties, appProperties)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to set file properties: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createSetFileLabelHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		labelID, err := request.RequireString("labelId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'labelId' is required"), nil
+		}
+
+		rawFields, _ := request.GetArguments()["fields"].(map[string]any)
+		fields := make(map[string][]string, len(rawFields))
+		for key, value := range rawFields {
+			switch value := value.(type) {
+			case nil:
+				fields[key] = nil
+			case string:
+				fields[key] = []string{value}
+			case []any:
+				values := make([]string, 0, len(value))
+				for _, item := range value {
+					s, ok := item.(string)
+					if !ok {
+						return mcp.NewToolResultError(fmt.Sprintf("Field '%s' must be a string, an array of strings, or null", key)), nil
+					}
+					values = append(values, s)
+				}
+				fields[key] = values
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("Field '%s' must be a string, an array of strings, or null", key)), nil
+			}
+		}
+
+		remove := mcp.ParseBoolean(request, "remove", false)
+
+		// Set file label
+		labels, err := fileOrganizer.SetFileLabel(ctx, fileID, labelID, fields, remove)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to set file label: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(labels)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createShareFileHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		role, err := request.RequireString("role")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'role' is required"), nil
+		}
+
+		share := gdrive.ShareRequest{
+			Role:         role,
+			Type:         mcp.ParseString(request, "type", "user"),
+			EmailAddress: mcp.ParseString(request, "emailAddress", ""),
+			Domain:       mcp.ParseString(request, "domain", ""),
+			EmailMessage: mcp.ParseString(request, "emailMessage", ""),
+		}
+		// Notifications only apply to users and groups, so the default does not get in the way of the other types
+		if share.Type == "user" || share.Type == "group" {
+			share.SendNotificationEmail = mcp.ParseBoolean(request, "sendNotificationEmail", true)
+		}
+
+		// Share file
+		permission, err := fileOrganizer.ShareFile(ctx, fileID, share)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to share file: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(permission)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createGetSharingLinkHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+		anyoneRole := mcp.ParseString(request, "anyoneRole", "")
+
+		// Get sharing link
+		link, err := fileOrganizer.GetSharingLink(ctx, fileID, anyoneRole)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get sharing link: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(link)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createRestoreRevisionHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		revisionID, err := request.RequireString("revisionId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'revisionId' is required"), nil
+		}
+
+		// Restore revision
+		file, err := fileOrganizer.RestoreRevision(ctx, fileID, revisionID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to restore revision: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(file)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createAddCommentHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		content, err := request.RequireString("content")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'content' is required"), nil
+		}
+
+		// Add comment
+		comment, err := fileOrganizer.AddComment(ctx, fileID, content)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to add comment: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(comment)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createReplyToCommentHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		commentID, err := request.RequireString("commentId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'commentId' is required"), nil
+		}
+
+		content, err := request.RequireString("content")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'content' is required"), nil
+		}
+
+		// Reply to comment
+		reply, err := fileOrganizer.ReplyToComment(ctx, fileID, commentID, content)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to reply to comment: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(reply)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createResolveCommentHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		commentID, err := request.RequireString("commentId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'commentId' is required"), nil
+		}
+
+		content := mcp.ParseString(request, "content", "")
+
+		// Resolve comment
+		reply, err := fileOrganizer.ResolveComment(ctx, fileID, commentID, content)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to resolve comment: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(reply)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+// createStarFileHandler returns the handler of star_file when starred is true, or of unstar_file otherwise
+func createStarFileHandler(fileOrganizer gdrive.FileOrganizer, starred bool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		fileID, err := requireFileID(request, "fileId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'fileId' is required"), nil
+		}
+
+		// Update starred state
+		file, err := fileOrganizer.UpdateFileMetadata(ctx, fileID, gdrive.FileMetadataUpdate{Starred: &starred})
+		if err != nil {
+			if starred {
+				return mcp.NewToolResultError("Failed to star file: " + err.Error()), nil
+			}
+			return mcp.NewToolResultError("Failed to unstar file: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(file)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createFindEmptyFoldersHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		folderID, err := requireFileID(request, "folderId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'folderId' is required"), nil
+		}
+
+		dryRun := mcp.ParseBoolean(request, "dryRun", true)
+
+		// Scan for empty folders
+		report, err := fileOrganizer.FindEmptyFolders(ctx, folderID, dryRun)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to find empty folders: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		result := map[string]any{
+			"folders": report.Folders,
+			"count":   len(report.Folders),
+			"dryRun":  dryRun,
+		}
+		if !dryRun {
+			result["trashed"] = report.Trashed
+		}
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createExportFolderZipHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		folderID, err := requireFileID(request, "folderId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'folderId' is required"), nil
+		}
+
+		opts := gdrive.FolderArchiveOptions{
+			SaveToDrive:         mcp.ParseBoolean(request, "saveToDrive", false),
+			DestinationFolderID: gdrive.ResolveFileID(mcp.ParseString(request, "destinationFolderId", "")),
+			Name:                mcp.ParseString(request, "name", ""),
+		}
+		if rawFormats, ok := request.GetArguments()["formats"].(map[string]any); ok {
+			opts.Formats = make(map[string]string, len(rawFormats))
+			for kind, value := range rawFormats {
+				format, ok := value.(string)
+				if !ok {
+					return mcp.NewToolResultError(fmt.Sprintf("Format for '%s' must be a string", kind)), nil
+				}
+				opts.Formats[kind] = format
+			}
+		}
+
+		// Export folder
+		archive, err := fileOrganizer.ExportFolderZip(ctx, folderID, opts)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to export folder: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(archive)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+// UploadDirectoryTool returns the upload_directory tool backed by fileOrganizer. It reads files from the machine
+// the server runs on, so it is not part of the default registry: register it only for a local server talking to
+// its client over stdio, never for one serving remote clients.
+func UploadDirectoryTool(fileOrganizer gdrive.FileOrganizer) Tool {
+	// Define upload directory tool
+	uploadDirectoryTool := mcp.NewTool(
+		"upload_directory",
+		mcp.WithDescription(fmt.Sprintf("Upload a directory on the machine running the server to Google Drive as a new folder, recreating its "+
+			"subdirectories and uploading its files concurrently. Progress is reported per file when the client sends a progress token. "+
+			"At most %d files are uploaded per call", gdrive.MaxDirectoryUploadFiles)),
+		mcp.WithString("path", mcp.Description("The local directory to upload, preferably as an absolute path"), mcp.Required()),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of the folder to create the uploaded folder in. If empty, uses My Drive root")),
+	)
+
+	return Tool{Tool: uploadDirectoryTool, Handler: createUploadDirectoryHandler(fileOrganizer), Scopes: []string{drive.DriveScope}}
+}
+
+func createUploadDirectoryHandler(fileOrganizer gdrive.FileOrganizer) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		localPath, err := request.RequireString("path")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'path' is required"), nil
+		}
+
+		folderID := gdrive.ResolveFileID(mcp.ParseString(request, "folderId", ""))
+
+		// Report progress when the client asked for it
+		var progress func(gdrive.UploadProgress)
+		s := server.ServerFromContext(ctx)
+		if request.Params.Meta != nil && request.Params.Meta.ProgressToken != nil && s != nil {
+			token := request.Params.Meta.ProgressToken
+			progress = func(p gdrive.UploadProgress) {
+				// Progress is informational, so a failure to send it does not stop the upload
+				_ = s.SendNotificationToClient(ctx, "notifications/progress", map[string]any{
+					"progressToken": token,
+					"progress":      p.Done,
+					"total":         p.Total,
+					"message":       "Uploaded " + p.Path,
+				})
+			}
+		}
+
+		// Upload directory
+		upload, err := fileOrganizer.UploadDirectory(ctx, localPath, folderID, progress)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to upload directory: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(upload)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
@@ -0,0 +1,96 @@
+package tools
+
+import (
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Tool is an MCP tool together with the metadata used to decide whether to register it
+type Tool struct {
+	// Tool is the MCP tool definition
+	Tool mcp.Tool
+	// Handler handles calls to the tool
+	Handler server.ToolHandlerFunc
+	// Scopes are the OAuth scopes the tool needs
+	Scopes []string
+	// ReadOnly reports whether the tool never modifies any files
+	ReadOnly bool
+}
+
+// Filter reports whether a tool should be registered
+type Filter func(tool Tool) bool
+
+// ReadOnlyFilter keeps only tools that never modify any files
+func ReadOnlyFilter(tool Tool) bool {
+	return tool.ReadOnly
+}
+
+// ScopeFilter keeps only tools whose scopes are all included in granted
+func ScopeFilter(granted []string) Filter {
+	grantedSet := make(map[string]bool, len(granted))
+	for _, scope := range granted {
+		grantedSet[scope] = true
+	}
+
+	return func(tool Tool) bool {
+		for _, scope := range tool.Scopes {
+			if !grantedSet[scope] {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Registry collects tools to register with an MCP server.
+// Third-party tools can be added alongside the built-in ones with Add.
+type Registry struct {
+	tools []Tool
+}
+
+// NewRegistry creates an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Add adds tools to the registry
+func (r *Registry) Add(tools ...Tool) {
+	r.tools = append(r.tools, tools...)
+}
+
+// Tools returns the tools accepted by all filters, in the order they were added
+func (r *Registry) Tools(filters ...Filter) []Tool {
+	var tools []Tool
+	for _, tool := range r.tools {
+		accepted := true
+		for _, filter := range filters {
+			if !filter(tool) {
+				accepted = false
+				break
+			}
+		}
+		if accepted {
+			tools = append(tools, tool)
+		}
+	}
+	return tools
+}
+
+// Register adds the tools accepted by all filters to s and returns their names
+func (r *Registry) Register(s *server.MCPServer, filters ...Filter) []string {
+	var serverTools []server.ServerTool
+	var names []string
+	for _, tool := range r.Tools(filters...) {
+		definition := tool.Tool
+		if tool.ReadOnly {
+			definition.Annotations.ReadOnlyHint = mcp.ToBoolPtr(true)
+			definition.Annotations.DestructiveHint = mcp.ToBoolPtr(false)
+		}
+
+		serverTools = append(serverTools, server.ServerTool{Tool: definition, Handler: tool.Handler})
+		names = append(names, definition.Name)
+	}
+
+	s.AddTools(serverTools...)
+	return names
+}
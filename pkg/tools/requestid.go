@@ -0,0 +1,78 @@
+package tools
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+type requestIDKey struct{}
+
+// RequestIDFromContext returns the ID of the tool call handling ctx, or "" outside a tool call
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// newRequestID returns a short random hex ID
+func newRequestID() string {
+	var b [6]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b[:])
+}
+
+// NewRequestIDMiddleware assigns each tool call an ID, logs the call under it, and adds it to error messages
+// so that a failure reported by a client can be matched to the server logs. Add it before other middleware
+// so that their errors are tagged too.
+func NewRequestIDMiddleware(logger *log.Logger) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			id := newRequestID()
+			ctx = context.WithValue(ctx, requestIDKey{}, id)
+
+			name := request.Params.Name
+			start := time.Now()
+			logger.Printf("[%s] %s started", id, name)
+
+			result, err := next(ctx, request)
+			elapsed := time.Since(start).Round(time.Millisecond)
+
+			switch {
+			case err != nil:
+				logger.Printf("[%s] %s failed after %s: %v", id, name, elapsed, err)
+				return result, fmt.Errorf("%w (request ID: %s)", err, id)
+			case result != nil && result.IsError:
+				logger.Printf("[%s] %s failed after %s: %s", id, name, elapsed, resultText(result))
+				return tagErrorResult(result, id), nil
+			default:
+				logger.Printf("[%s] %s finished in %s", id, name, elapsed)
+				return result, nil
+			}
+		}
+	}
+}
+
+// tagErrorResult appends the request ID to the text of an error result
+func tagErrorResult(result *mcp.CallToolResult, id string) *mcp.CallToolResult {
+	tagged := *result
+	tagged.Content = make([]mcp.Content, len(result.Content))
+	copy(tagged.Content, result.Content)
+
+	for i, content := range tagged.Content {
+		if text, ok := mcp.AsTextContent(content); ok {
+			tagged.Content[i] = mcp.NewTextContent(fmt.Sprintf("%s (request ID: %s)", text.Text, id))
+			return &tagged
+		}
+	}
+
+	tagged.Content = append(tagged.Content, mcp.NewTextContent("Request ID: "+id))
+	return &tagged
+}
@@ -0,0 +1,297 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/sheets/v4"
+)
+
+// SheetTools returns the Google Sheets tools backed by sheetEditor
+func SheetTools(sheetEditor gdrive.SheetEditor) []Tool {
+	// Define get spreadsheet tool
+	getSpreadsheetTool := mcp.NewTool(
+		"get_spreadsheet",
+		mcp.WithDescription("Get values from a Google Spreadsheet"),
+		mcp.WithString("spreadsheetId", mcp.Description("The ID or URL of the Google Spreadsheet"), mcp.Required()),
+		mcp.WithString("range", mcp.Description("The range to retrieve (e.g., 'Sheet1!A1:C10')"), mcp.Required()),
+		mcp.WithString("valueRenderOption", mcp.Description("How to render values: as displayed, as plain numbers, strings, and booleans, or as formulas (default: FORMATTED_VALUE)"), mcp.Enum(gdrive.ValueFormatted, gdrive.ValueUnformatted, gdrive.ValueFormula)),
+		mcp.WithString("dateTimeRenderOption", mcp.Description("How to render dates and times: as serial numbers, as displayed in the sheet's locale, or in ISO 8601 such as '2024-06-10T13:45:00'. Serial numbers and displayed strings only differ for unformatted values and formulas; ISO8601 applies to every valueRenderOption"), mcp.Enum(gdrive.DateTimeSerialNumber, gdrive.DateTimeFormattedString, gdrive.DateTimeISO8601)),
+		withFormat(FormatJSON),
+	)
+
+	// Define update spreadsheet tool
+	// updateSpreadsheetTool := mcp.NewTool(
+	// 	"update_spreadsheet",
+	// 	mcp.WithDescription("Update values in a Google Spreadsheet"),
+	// 	mcp.WithString("spreadsheetId", mcp.Description("The ID or URL of the Google Spreadsheet"), mcp.Required()),
+	// 	mcp.WithString("range", mcp.Description("The range to update (e.g., 'Sheet1!A1:C10')"), mcp.Required()),
+	// 	mcp.WithAny("values", mcp.Description("2D array of values to write"), mcp.Required()),
+	// )
+
+	// Define append sheet table rows tool
+	appendSheetTableRowsTool := mcp.NewTool(
+		"append_sheet_table_rows",
+		mcp.WithDescription("Append rows directly below the last row of a table on a Google Sheets sheet, rather than below the sheet's last row, so logs with several tables on one sheet grow in the right place. Locate the table by a header cell's text or by developer metadata on its header row. Cells below the table are shifted down"),
+		mcp.WithString("spreadsheetId", mcp.Description("The ID or URL of the Google Spreadsheet"), mcp.Required()),
+		mcp.WithArray("rows", mcp.Description("2D array of values to append, one inner array per row, starting at the table's first column. Values are parsed as if typed into the sheet"), mcp.Required(), mcp.Items(map[string]any{"type": "array"})),
+		mcp.WithString("header", mcp.Description("Text of a header cell of the table (case-insensitive), e.g. its first column's name. The table starts at the first matching cell")),
+		mcp.WithString("sheet", mcp.Description("The sheet to search for header (default: the first sheet)")),
+		mcp.WithString("metadataKey", mcp.Description("Key of developer metadata attached to the table's header row. Used instead of header")),
+	)
+
+	// Define copy spreadsheet range tool
+	copySpreadsheetRangeTool := mcp.NewTool(
+		"copy_spreadsheet_range",
+		mcp.WithDescription("Copy a range from one Google Spreadsheet into a range of another, or of the same spreadsheet, in one call, e.g. to build consolidation and rollup sheets. Formulas are copied as their computed values"),
+		mcp.WithString("sourceSpreadsheetId", mcp.Description("The ID or URL of the spreadsheet to copy from"), mcp.Required()),
+		mcp.WithString("sourceRange", mcp.Description("The range to copy (e.g., 'Sheet1!A1:C10')"), mcp.Required()),
+		mcp.WithString("destinationSpreadsheetId", mcp.Description("The ID or URL of the spreadsheet to copy to (default: the source spreadsheet)")),
+		mcp.WithString("destinationRange", mcp.Description("The range to copy to. The source is written starting at its top-left cell (e.g., 'Rollup!B2')"), mcp.Required()),
+		mcp.WithString("mode", mcp.Description("What to copy: values and formatting, values only, or formatting only (default: all)"), mcp.Enum(gdrive.CopyAll, gdrive.CopyValues, gdrive.CopyFormats), mcp.DefaultString(gdrive.CopyAll)),
+	)
+
+	// Define audit spreadsheet protection tool
+	auditSpreadsheetProtectionTool := mcp.NewTool(
+		"audit_spreadsheet_protection",
+		mcp.WithDescription("Report, per sheet, the protected ranges of a Google Spreadsheet and who may edit them, together with the file's share permissions, e.g. for compliance reviews of financial workbooks"),
+		mcp.WithString("spreadsheetId", mcp.Description("The ID or URL of the Google Spreadsheet"), mcp.Required()),
+	)
+
+	// Define snapshot spreadsheet tool
+	snapshotSpreadsheetTool := mcp.NewTool(
+		"snapshot_spreadsheet",
+		mcp.WithDescription("Back up a Google Spreadsheet before risky edits by copying it, or exporting it to .xlsx, into a dated folder such as 'Snapshots 2026-10-15'. Returns the snapshot's ID, so changes can be rolled back by restoring from it"),
+		mcp.WithString("spreadsheetId", mcp.Description("The ID or URL of the Google Spreadsheet"), mcp.Required()),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of the folder to create the dated folder in (default: the spreadsheet's folder)")),
+		mcp.WithBoolean("xlsx", mcp.Description("Export the snapshot to an .xlsx file instead of copying it as a Google Spreadsheet (default: false)")),
+	)
+
+	return []Tool{
+		{Tool: getSpreadsheetTool, Handler: createGetSpreadsheetHandler(sheetEditor), Scopes: []string{sheets.SpreadsheetsScope}, ReadOnly: true},
+		// {Tool: updateSpreadsheetTool, Handler: createUpdateSpreadsheetHandler(sheetEditor), Scopes: []string{sheets.SpreadsheetsScope}},
+		{Tool: appendSheetTableRowsTool, Handler: createAppendSheetTableRowsHandler(sheetEditor), Scopes: []string{sheets.SpreadsheetsScope}},
+		{Tool: copySpreadsheetRangeTool, Handler: createCopySpreadsheetRangeHandler(sheetEditor), Scopes: []string{sheets.SpreadsheetsScope}},
+		{Tool: auditSpreadsheetProtectionTool, Handler: createAuditSpreadsheetProtectionHandler(sheetEditor), Scopes: []string{drive.DriveScope, sheets.SpreadsheetsScope}, ReadOnly: true},
+		{Tool: snapshotSpreadsheetTool, Handler: createSnapshotSpreadsheetHandler(sheetEditor), Scopes: []string{drive.DriveScope}},
+	}
+}
+
+func createGetSpreadsheetHandler(sheetEditor gdrive.SheetEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		spreadsheetID, err := requireFileID(request, "spreadsheetId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'spreadsheetId' is required"), nil
+		}
+
+		rangeName, err := request.RequireString("range")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'range' is required"), nil
+		}
+
+		options := gdrive.SpreadsheetValuesOptions{
+			ValueRenderOption:    mcp.ParseString(request, "valueRenderOption", ""),
+			DateTimeRenderOption: mcp.ParseString(request, "dateTimeRenderOption", ""),
+		}
+		format, err := parseFormat(request, FormatJSON)
+		if err != nil {
+			return mcp.NewToolResultError("Invalid parameter 'format': " + err.Error()), nil
+		}
+
+		// Get spreadsheet values
+		values, err := sheetEditor.GetSpreadsheetValues(ctx, spreadsheetID, rangeName, options)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get spreadsheet values: " + err.Error()), nil
+		}
+
+		rendered, err := renderValues(values, rangeName, format)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(rendered), nil
+	}
+}
+
+func createAppendSheetTableRowsHandler(sheetEditor gdrive.SheetEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		spreadsheetID, err := requireFileID(request, "spreadsheetId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'spreadsheetId' is required"), nil
+		}
+
+		rowsParam, ok := request.GetArguments()["rows"].([]any)
+		if !ok || len(rowsParam) == 0 {
+			return mcp.NewToolResultError("Parameter 'rows' is required"), nil
+		}
+
+		rows := make([][]interface{}, len(rowsParam))
+		for i, row := range rowsParam {
+			cells, ok := row.([]any)
+			if !ok {
+				return mcp.NewToolResultError("Invalid rows format: each row must be an array"), nil
+			}
+			rows[i] = cells
+		}
+
+		locator := gdrive.SheetTableLocator{
+			Sheet:       mcp.ParseString(request, "sheet", ""),
+			Header:      mcp.ParseString(request, "header", ""),
+			MetadataKey: mcp.ParseString(request, "metadataKey", ""),
+		}
+		if locator.Header == "" && locator.MetadataKey == "" {
+			return mcp.NewToolResultError("Either 'header' or 'metadataKey' is required"), nil
+		}
+
+		// Append rows
+		result, err := sheetEditor.AppendSheetTableRows(ctx, spreadsheetID, locator, rows)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to append table rows: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createCopySpreadsheetRangeHandler(sheetEditor gdrive.SheetEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		sourceID, err := requireFileID(request, "sourceSpreadsheetId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'sourceSpreadsheetId' is required"), nil
+		}
+
+		sourceRange, err := request.RequireString("sourceRange")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'sourceRange' is required"), nil
+		}
+
+		destinationRange, err := request.RequireString("destinationRange")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'destinationRange' is required"), nil
+		}
+
+		destinationID := gdrive.ResolveFileID(mcp.ParseString(request, "destinationSpreadsheetId", ""))
+		if destinationID == "" {
+			destinationID = sourceID
+		}
+
+		source := gdrive.SpreadsheetRange{SpreadsheetID: sourceID, Range: sourceRange}
+		destination := gdrive.SpreadsheetRange{SpreadsheetID: destinationID, Range: destinationRange}
+		mode := mcp.ParseString(request, "mode", gdrive.CopyAll)
+
+		// Copy range
+		result, err := sheetEditor.CopySpreadsheetRange(ctx, source, destination, mode)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to copy spreadsheet range: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createAuditSpreadsheetProtectionHandler(sheetEditor gdrive.SheetEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		spreadsheetID, err := requireFileID(request, "spreadsheetId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'spreadsheetId' is required"), nil
+		}
+
+		// Audit protections
+		audit, err := sheetEditor.AuditSpreadsheetProtection(ctx, spreadsheetID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to audit spreadsheet: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(audit)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createSnapshotSpreadsheetHandler(sheetEditor gdrive.SheetEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		spreadsheetID, err := requireFileID(request, "spreadsheetId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'spreadsheetId' is required"), nil
+		}
+
+		folderID := gdrive.ResolveFileID(mcp.ParseString(request, "folderId", ""))
+		asXLSX := mcp.ParseBoolean(request, "xlsx", false)
+
+		// Take snapshot
+		snapshot, err := sheetEditor.SnapshotSpreadsheet(ctx, spreadsheetID, folderID, asXLSX)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to snapshot spreadsheet: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(snapshot)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+// func createUpdateSpreadsheetHandler(sheetEditor gdrive.SheetEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+// 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+// 		// Get parameters
+// 		spreadsheetID, err := requireFileID(request, "spreadsheetId")
+// 		if err != nil {
+// 			return mcp.NewToolResultError("Parameter 'spreadsheetId' is required"), nil
+// 		}
+//
+// 		rangeName, err := request.RequireString("range")
+// 		if err != nil {
+// 			return mcp.NewToolResultError("Parameter 'range' is required"), nil
+// 		}
+//
+// 		valuesParam := request.Params["values"]
+// 		if valuesParam == nil {
+// 			return mcp.NewToolResultError("Parameter 'values' is required"), nil
+// 		}
+//
+// 		// Convert values to [][]interface{}
+// 		var values [][]interface{}
+// 		if valuesSlice, ok := valuesParam.([]interface{}); ok {
+// 			for _, row := range valuesSlice {
+// 				if rowSlice, ok := row.([]interface{}); ok {
+// 					values = append(values, rowSlice)
+// 				} else {
+// 					return mcp.NewToolResultError("Invalid values format: each row must be an array"), nil
+// 				}
+// 			}
+// 		} else {
+// 			return mcp.NewToolResultError("Invalid values format: values must be a 2D array"), nil
+// 		}
+//
+// 		// Update spreadsheet values
+// 		err = sheetEditor.UpdateSpreadsheetValues(ctx, spreadsheetID, rangeName, values)
+// 		if err != nil {
+// 			return mcp.NewToolResultError("Failed to update spreadsheet: " + err.Error()), nil
+// 		}
+//
+// 		return mcp.NewToolResultText("Spreadsheet updated successfully"), nil
+// 	}
+// }
@@ -0,0 +1,414 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+	"github.com/mark3labs/mcp-go/mcp"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+)
+
+// SlideTools returns the Google Slides tools backed by slideEditor
+func SlideTools(slideEditor gdrive.SlideEditor) []Tool {
+	// Define get presentation tool
+	getPresentationTool := mcp.NewTool(
+		"get_presentation",
+		mcp.WithDescription("Get the content of a Google Slides presentation"),
+		mcp.WithString("presentationId", mcp.Description("The ID or URL of the Google Slides presentation"), mcp.Required()),
+		withFormat(FormatText),
+	)
+
+	// Define update presentation tool
+	updatePresentationTool := mcp.NewTool(
+		"update_presentation",
+		mcp.WithDescription("Update a specific slide in a Google Slides presentation"),
+		mcp.WithString("presentationId", mcp.Description("The ID or URL of the Google Slides presentation"), mcp.Required()),
+		mcp.WithNumber("slideIndex", mcp.Description("The index of the slide to update (0-based, default: 0)"), mcp.DefaultNumber(0)),
+		mcp.WithString("title", mcp.Description("The title for the slide"), mcp.Required()),
+		mcp.WithString("content", mcp.Description("The content for the slide"), mcp.Required()),
+	)
+
+	// Define get slide elements tool
+	getSlideElementsTool := mcp.NewTool(
+		"get_slide_elements",
+		mcp.WithDescription("List the elements on a slide with their object IDs, types, position, and size in points, e.g. to find overlapping text boxes"),
+		mcp.WithString("presentationId", mcp.Description("The ID or URL of the Google Slides presentation"), mcp.Required()),
+		mcp.WithNumber("slideIndex", mcp.Description("The index of the slide (0-based, default: 0)"), mcp.DefaultNumber(0)),
+	)
+
+	// Define update slide element tool
+	updateSlideElementTool := mcp.NewTool(
+		"update_slide_element",
+		mcp.WithDescription("Move and resize an element on a slide. Lengths are in points from the top-left corner of the slide (a standard 16:9 slide is 720x405 points). Only the given values are changed"),
+		mcp.WithString("presentationId", mcp.Description("The ID or URL of the Google Slides presentation"), mcp.Required()),
+		mcp.WithString("objectId", mcp.Description("The object ID of the element, as listed by get_slide_elements"), mcp.Required()),
+		mcp.WithNumber("x", mcp.Description("New distance of the element's left edge from the slide's left edge")),
+		mcp.WithNumber("y", mcp.Description("New distance of the element's top edge from the slide's top edge")),
+		mcp.WithNumber("width", mcp.Description("New width of the element")),
+		mcp.WithNumber("height", mcp.Description("New height of the element")),
+	)
+
+	// Define diff presentation revisions tool
+	diffPresentationRevisionsTool := mcp.NewTool(
+		"diff_presentation_revisions",
+		mcp.WithDescription("Compare two revisions of a Google Slides presentation and report which slides were added, removed, or had their text changed, e.g. to review automated deck edits"),
+		mcp.WithString("presentationId", mcp.Description("The ID or URL of the Google Slides presentation"), mcp.Required()),
+		mcp.WithString("revisionId", mcp.Description("The base revision to compare"), mcp.Required()),
+		mcp.WithString("otherRevisionId", mcp.Description("The revision to compare against (default: the current content)")),
+	)
+
+	// Define apply presentation template tool
+	applyPresentationTemplateTool := mcp.NewTool(
+		"apply_presentation_template",
+		mcp.WithDescription("Re-theme a Google Slides presentation with a template deck's theme, masters, and layouts, e.g. to conform a generated deck to corporate branding. Creates a copy of the template and rebuilds the presentation's slides in it; the original is not changed. Text, shapes, and images are carried over; tables, charts, and other elements are reported as skipped"),
+		mcp.WithString("presentationId", mcp.Description("The ID or URL of the Google Slides presentation to re-theme"), mcp.Required()),
+		mcp.WithString("templateId", mcp.Description("The ID or URL of the template presentation"), mcp.Required()),
+		mcp.WithString("name", mcp.Description("Name of the new presentation (default: the original's title followed by '(themed)')")),
+		mcp.WithString("folderId", mcp.Description("The ID or URL of the folder to create the new presentation in. If empty, uses the original presentation's folder")),
+	)
+
+	// Define refresh sheets charts tool
+	refreshSheetsChartsTool := mcp.NewTool(
+		"refresh_sheets_charts",
+		mcp.WithDescription("Refresh linked Google Sheets charts in a presentation with the current spreadsheet data, all at once or only selected charts"),
+		mcp.WithString("presentationId", mcp.Description("The ID or URL of the Google Slides presentation"), mcp.Required()),
+		mcp.WithArray("objectIds", mcp.Description("Object IDs of the charts to refresh. If empty, refreshes all linked charts"), mcp.WithStringItems()),
+	)
+
+	// Define get presentation images tool
+	getPresentationImagesTool := mcp.NewTool(
+		"get_presentation_images",
+		mcp.WithDescription("List the images on each slide of a Google Slides presentation, with short-lived content URLs. Set includeData to also return the images themselves, e.g. to audit or reuse them"),
+		mcp.WithString("presentationId", mcp.Description("The ID or URL of the Google Slides presentation"), mcp.Required()),
+		mcp.WithBoolean("includeData", mcp.Description("Download the images and return them as image content (default: false)"), mcp.DefaultBool(false)),
+	)
+
+	// Define slides batch update tool
+	slidesBatchUpdateTool := mcp.NewTool(
+		"slides_batch_update",
+		mcp.WithDescription("Execute raw Google Slides API batchUpdate requests on a presentation, for deck changes the other tools do not cover. Each request is an object with one request type, e.g. {\"createSlide\": {\"insertionIndex\": 1}}. Requests are validated against the API schema and applied atomically"),
+		mcp.WithString("presentationId", mcp.Description("The ID or URL of the Google Slides presentation"), mcp.Required()),
+		mcp.WithArray("requests", mcp.Description(fmt.Sprintf("Slides API Request objects, applied in order (at most %d)", gdrive.MaxBatchUpdateRequests)), mcp.Required(), mcp.Items(map[string]any{"type": "object"})),
+		mcp.WithString("requiredRevisionId", mcp.Description("Fail instead of applying the requests if the presentation has changed since this revision")),
+	)
+
+	return []Tool{
+		{Tool: getPresentationTool, Handler: createGetPresentationHandler(slideEditor), Scopes: []string{slides.PresentationsScope}, ReadOnly: true},
+		{Tool: updatePresentationTool, Handler: createUpdatePresentationHandler(slideEditor), Scopes: []string{slides.PresentationsScope}},
+		{Tool: getSlideElementsTool, Handler: createGetSlideElementsHandler(slideEditor), Scopes: []string{slides.PresentationsScope}, ReadOnly: true},
+		{Tool: updateSlideElementTool, Handler: createUpdateSlideElementHandler(slideEditor), Scopes: []string{slides.PresentationsScope}},
+		{Tool: diffPresentationRevisionsTool, Handler: createDiffPresentationRevisionsHandler(slideEditor), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: applyPresentationTemplateTool, Handler: createApplyPresentationTemplateHandler(slideEditor), Scopes: []string{drive.DriveScope, slides.PresentationsScope}},
+		{Tool: refreshSheetsChartsTool, Handler: createRefreshSheetsChartsHandler(slideEditor), Scopes: []string{drive.DriveScope, slides.PresentationsScope}},
+		{Tool: getPresentationImagesTool, Handler: createGetPresentationImagesHandler(slideEditor), Scopes: []string{slides.PresentationsScope}, ReadOnly: true},
+		{Tool: slidesBatchUpdateTool, Handler: createSlidesBatchUpdateHandler(slideEditor), Scopes: []string{slides.PresentationsScope}},
+	}
+}
+
+func createGetPresentationHandler(slideEditor gdrive.SlideEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		presentationID, err := requireFileID(request, "presentationId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'presentationId' is required"), nil
+		}
+
+		format, err := parseFormat(request, FormatText)
+		if err != nil {
+			return mcp.NewToolResultError("Invalid parameter 'format': " + err.Error()), nil
+		}
+
+		if format != FormatText {
+			presentation, err := slideEditor.GetPresentationText(ctx, presentationID)
+			if err != nil {
+				return mcp.NewToolResultError("Failed to get presentation content: " + err.Error()), nil
+			}
+
+			rendered, err := renderPresentationText(presentationID, presentation, format)
+			if err != nil {
+				return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(rendered), nil
+		}
+
+		// Get presentation content
+		content, err := slideEditor.GetPresentationContent(ctx, presentationID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get presentation content: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(content), nil
+	}
+}
+
+func createUpdatePresentationHandler(slideEditor gdrive.SlideEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		presentationID, err := requireFileID(request, "presentationId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'presentationId' is required"), nil
+		}
+
+		slideIndex := mcp.ParseInt(request, "slideIndex", 0)
+
+		title, err := request.RequireString("title")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'title' is required"), nil
+		}
+
+		content, err := request.RequireString("content")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'content' is required"), nil
+		}
+
+		// Update presentation slide
+		err = slideEditor.UpdatePresentationSlide(ctx, presentationID, slideIndex, title, content)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to update presentation: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText("Presentation slide updated successfully"), nil
+	}
+}
+
+func createGetSlideElementsHandler(slideEditor gdrive.SlideEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		presentationID, err := requireFileID(request, "presentationId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'presentationId' is required"), nil
+		}
+
+		slideIndex := mcp.ParseInt(request, "slideIndex", 0)
+
+		// Get slide elements
+		elements, err := slideEditor.GetSlideElements(ctx, presentationID, slideIndex)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get slide elements: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		result := map[string]any{
+			"slideIndex": slideIndex,
+			"elements":   elements,
+		}
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createUpdateSlideElementHandler(slideEditor gdrive.SlideEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		presentationID, err := requireFileID(request, "presentationId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'presentationId' is required"), nil
+		}
+
+		objectID, err := request.RequireString("objectId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'objectId' is required"), nil
+		}
+
+		// Only change the values present in the request
+		var update gdrive.SlideElementUpdate
+		lengths := map[string]**float64{
+			"x":      &update.X,
+			"y":      &update.Y,
+			"width":  &update.Width,
+			"height": &update.Height,
+		}
+		for name, field := range lengths {
+			if _, ok := request.GetArguments()[name]; ok {
+				value := mcp.ParseFloat64(request, name, 0)
+				*field = &value
+			}
+		}
+
+		// Update element
+		element, err := slideEditor.UpdateSlideElement(ctx, presentationID, objectID, update)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to update slide element: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		resultData, err := json.Marshal(element)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createDiffPresentationRevisionsHandler(slideEditor gdrive.SlideEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		presentationID, err := requireFileID(request, "presentationId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'presentationId' is required"), nil
+		}
+
+		revisionID, err := request.RequireString("revisionId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'revisionId' is required"), nil
+		}
+
+		otherRevisionID := mcp.ParseString(request, "otherRevisionId", "")
+
+		// Compare revisions
+		diff, err := slideEditor.DiffPresentationRevisions(ctx, presentationID, revisionID, otherRevisionID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to diff presentation revisions: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		resultData, err := json.Marshal(diff)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createApplyPresentationTemplateHandler(slideEditor gdrive.SlideEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		presentationID, err := requireFileID(request, "presentationId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'presentationId' is required"), nil
+		}
+
+		templateID, err := requireFileID(request, "templateId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'templateId' is required"), nil
+		}
+
+		name := mcp.ParseString(request, "name", "")
+		folderID := gdrive.ResolveFileID(mcp.ParseString(request, "folderId", ""))
+
+		// Apply template
+		themed, err := slideEditor.ApplyPresentationTemplate(ctx, presentationID, templateID, name, folderID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to apply presentation template: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		resultData, err := json.Marshal(themed)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createRefreshSheetsChartsHandler(slideEditor gdrive.SlideEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		presentationID, err := requireFileID(request, "presentationId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'presentationId' is required"), nil
+		}
+
+		objectIDs := request.GetStringSlice("objectIds", nil)
+
+		// Refresh charts
+		charts, err := slideEditor.RefreshSheetsCharts(ctx, presentationID, objectIDs)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to refresh charts: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		result := map[string]any{
+			"presentationId": presentationID,
+			"refreshed":      charts,
+		}
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func createGetPresentationImagesHandler(slideEditor gdrive.SlideEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		presentationID, err := requireFileID(request, "presentationId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'presentationId' is required"), nil
+		}
+
+		includeData := mcp.ParseBoolean(request, "includeData", false)
+
+		// Get images
+		images, err := slideEditor.GetPresentationImages(ctx, presentationID, includeData)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get presentation images: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		result := map[string]any{
+			"presentationId": presentationID,
+			"images":         images,
+		}
+
+		resultData, err := json.Marshal(result)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		// Follow the listing with the downloaded images, in the same order
+		toolResult := mcp.NewToolResultText(string(resultData))
+		for _, image := range images {
+			if len(image.Data) > 0 {
+				toolResult.Content = append(toolResult.Content, mcp.NewImageContent(base64.StdEncoding.EncodeToString(image.Data), image.MimeType))
+			}
+		}
+
+		return toolResult, nil
+	}
+}
+
+func createSlidesBatchUpdateHandler(slideEditor gdrive.SlideEditor) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		presentationID, err := requireFileID(request, "presentationId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'presentationId' is required"), nil
+		}
+
+		requests, errResult := rawRequests(request)
+		if errResult != nil {
+			return errResult, nil
+		}
+
+		// Execute requests
+		resp, err := slideEditor.BatchUpdatePresentation(ctx, presentationID, requests, mcp.ParseString(request, "requiredRevisionId", ""))
+		if err != nil {
+			return mcp.NewToolResultError("Failed to update presentation: " + err.Error()), nil
+		}
+
+		// Convert result to JSON
+		resultData, err := json.Marshal(resp)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
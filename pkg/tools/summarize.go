@@ -0,0 +1,230 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// summaryMaxTokens bounds the length of summaries requested from the client
+const summaryMaxTokens = 1024
+
+// maxDocumentSections bounds the number of sections a document is split into, so the list stays short
+const maxDocumentSections = 50
+
+// previewChars is the length of the section previews listed with a limited read
+const previewChars = 80
+
+// readSection points to a part of a document or presentation too large to return whole
+type readSection struct {
+	// StartIndex and Chars locate a document section for get_document's startIndex and maxChars
+	StartIndex *int `json:"startIndex,omitempty"`
+	Chars      int  `json:"chars,omitempty"`
+	// SlideIndex locates a slide for get_slide_elements
+	SlideIndex *int   `json:"slideIndex,omitempty"`
+	Preview    string `json:"preview"`
+
+	text string
+}
+
+// limitedRead is returned instead of a document or presentation over the read limit
+type limitedRead struct {
+	// Summary is the client model's summary of the whole text, when sampling succeeded
+	Summary string `json:"summary,omitempty"`
+	// Content is the beginning of the text, cut at a section boundary, when it was not summarized
+	Content    string        `json:"content,omitempty"`
+	TotalChars int           `json:"totalChars"`
+	Sections   []readSection `json:"sections"`
+	Hint       string        `json:"hint"`
+}
+
+// sectionSplitter splits the text returned by a read tool into sections no longer than maxChars
+type sectionSplitter func(text string, maxChars int) []readSection
+
+// limitedTools are the read tools whose results are limited, with how to split their text
+var limitedTools = map[string]struct {
+	split sectionSplitter
+	hint  string
+}{
+	"get_document": {
+		split: documentSections,
+		hint:  "Read a section with get_document's startIndex and maxChars set to the section's startIndex and chars",
+	},
+	"get_presentation": {
+		split: slideSections,
+		hint:  "Read a slide with get_slide_elements' slideIndex",
+	},
+}
+
+// NewReadLimitMiddleware limits the text get_document and get_presentation return to maxChars characters.
+// A larger document or presentation is replaced by a list of its sections with pointers to read each one,
+// together with a summary of the whole text made by the client's model through MCP sampling when summarize is
+// set, or with the text up to the last section boundary within the limit when sampling is off or fails.
+// Chunked reads and formats other than text are passed through. maxChars <= 0 disables the limit.
+func NewReadLimitMiddleware(maxChars int, summarize bool) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, request)
+			tool, ok := limitedTools[request.Params.Name]
+			if maxChars <= 0 || !ok || err != nil || result == nil || result.IsError || len(result.Content) != 1 {
+				return result, err
+			}
+			args := request.GetArguments()
+			if args["startIndex"] != nil || args["maxChars"] != nil || (args["format"] != nil && args["format"] != FormatText) {
+				return result, nil
+			}
+			text := resultText(result)
+			totalChars := utf8.RuneCountInString(text)
+			if totalChars <= maxChars {
+				return result, nil
+			}
+
+			limited := &limitedRead{
+				TotalChars: totalChars,
+				Sections:   tool.split(text, maxChars),
+				Hint:       tool.hint,
+			}
+			if summarize {
+				limited.Summary = summarizeSections(ctx, request.Params.Name, limited.Sections)
+			}
+			if limited.Summary == "" {
+				limited.Content = leadingSections(limited.Sections, maxChars)
+			}
+
+			resultData, err := json.Marshal(limited)
+			if err != nil {
+				return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+			}
+
+			return mcp.NewToolResultText(string(resultData)), nil
+		}
+	}
+}
+
+// summarizeSections asks the client's model to summarize sections, returning "" when the client does not
+// support sampling or the request fails
+func summarizeSections(ctx context.Context, toolName string, sections []readSection) string {
+	s := server.ServerFromContext(ctx)
+	if s == nil {
+		return ""
+	}
+
+	kind := "document"
+	if toolName == "get_presentation" {
+		kind = "presentation"
+	}
+	var prompt strings.Builder
+	fmt.Fprintf(&prompt, "Summarize the following %s, which is too long to read whole. Cover its purpose and main points, "+
+		"and refer to the numbered sections where each topic is covered, e.g. [3], so they can be read in full.\n\n", kind)
+	for i, section := range sections {
+		fmt.Fprintf(&prompt, "[%d]\n%s\n\n", i+1, section.text)
+	}
+
+	result, err := s.RequestSampling(ctx, mcp.CreateMessageRequest{
+		CreateMessageParams: mcp.CreateMessageParams{
+			Messages: []mcp.SamplingMessage{{
+				Role:    mcp.RoleUser,
+				Content: mcp.NewTextContent(prompt.String()),
+			}},
+			SystemPrompt: "You write concise, faithful summaries of Google Workspace files for another assistant.",
+			MaxTokens:    summaryMaxTokens,
+		},
+	})
+	if err != nil {
+		return ""
+	}
+	return samplingText(result.Content)
+}
+
+// samplingText returns the text of sampled content, which is decoded as a map when it arrives over the wire
+func samplingText(content any) string {
+	switch c := content.(type) {
+	case mcp.TextContent:
+		return c.Text
+	case *mcp.TextContent:
+		return c.Text
+	case map[string]any:
+		text, _ := c["text"].(string)
+		return text
+	}
+	return ""
+}
+
+// documentSections splits document text at paragraph boundaries into sections of at most a quarter of maxChars,
+// so that several of them fit in one read, or into larger ones for very long documents. A paragraph longer than
+// that is a section of its own.
+func documentSections(text string, maxChars int) []readSection {
+	sectionChars := max(maxChars/4, utf8.RuneCountInString(text)/maxDocumentSections, 1)
+	var sections []readSection
+	var current strings.Builder
+	start, chars := 0, 0
+	flush := func() {
+		if chars == 0 {
+			return
+		}
+		startIndex := start
+		sections = append(sections, readSection{StartIndex: &startIndex, Chars: chars, Preview: preview(current.String()), text: current.String()})
+		start += chars
+		current.Reset()
+		chars = 0
+	}
+	for _, paragraph := range strings.SplitAfter(text, "\n") {
+		n := utf8.RuneCountInString(paragraph)
+		if chars > 0 && chars+n > sectionChars {
+			flush()
+		}
+		current.WriteString(paragraph)
+		chars += n
+	}
+	flush()
+	return sections
+}
+
+// slideSections splits presentation text into one section per slide, following the "--- Slide N ---" markers
+// written by get_presentation. Text before the first slide, such as the title, is left out.
+func slideSections(text string, maxChars int) []readSection {
+	var sections []readSection
+	for i, part := range strings.Split(text, "--- Slide ")[1:] {
+		_, body, _ := strings.Cut(part, "\n")
+		slideIndex := i
+		sections = append(sections, readSection{SlideIndex: &slideIndex, Preview: preview(body), text: body})
+	}
+	return sections
+}
+
+// leadingSections returns the text of the sections that fit in maxChars, or the beginning of the first section
+// when even it does not fit
+func leadingSections(sections []readSection, maxChars int) string {
+	var content strings.Builder
+	chars := 0
+	for _, section := range sections {
+		n := utf8.RuneCountInString(section.text)
+		if chars+n > maxChars {
+			if chars == 0 {
+				return string([]rune(section.text)[:maxChars])
+			}
+			break
+		}
+		content.WriteString(section.text)
+		chars += n
+	}
+	return content.String()
+}
+
+// preview returns the first non-empty line of text, shortened to previewChars characters
+func preview(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			if runes := []rune(line); len(runes) > previewChars {
+				return string(runes[:previewChars]) + "…"
+			}
+			return line
+		}
+	}
+	return ""
+}
@@ -0,0 +1,90 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// DefaultTimeout is the timeout applied to tool calls without a per-tool override
+const DefaultTimeout = 60 * time.Second
+
+// ToolTimeouts holds per-tool timeout overrides parsed from "name=duration" flags
+type ToolTimeouts map[string]time.Duration
+
+// String returns the overrides in "name=duration" form
+func (t ToolTimeouts) String() string {
+	var pairs []string
+	for name, timeout := range t {
+		pairs = append(pairs, name+"="+timeout.String())
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// Set parses a "name=duration" override
+func (t ToolTimeouts) Set(value string) error {
+	name, duration, ok := strings.Cut(value, "=")
+	if !ok || name == "" {
+		return fmt.Errorf("invalid tool timeout %q: expected name=duration", value)
+	}
+
+	timeout, err := time.ParseDuration(duration)
+	if err != nil {
+		return fmt.Errorf("invalid tool timeout %q: %w", value, err)
+	}
+
+	t[name] = timeout
+	return nil
+}
+
+// NewTimeoutMiddleware bounds each tool call with its configured timeout
+func NewTimeoutMiddleware(defaultTimeout time.Duration, overrides ToolTimeouts) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			timeout := defaultTimeout
+			if override, ok := overrides[request.Params.Name]; ok {
+				timeout = override
+			}
+			if timeout <= 0 {
+				return next(ctx, request)
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			result, err := next(ctx, request)
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) && (result == nil || result.IsError) {
+				message := fmt.Sprintf("Tool '%s' timed out after %s", request.Params.Name, timeout)
+				if text := resultText(result); text != "" {
+					message = fmt.Sprintf("%s (timed out after %s)", text, timeout)
+				}
+				return mcp.NewToolResultError(message), nil
+			}
+
+			return result, err
+		}
+	}
+}
+
+// resultText returns the text content of a tool result, if any
+func resultText(result *mcp.CallToolResult) string {
+	if result == nil {
+		return ""
+	}
+
+	var texts []string
+	for _, content := range result.Content {
+		if text, ok := mcp.AsTextContent(content); ok {
+			texts = append(texts, text.Text)
+		}
+	}
+
+	return strings.Join(texts, "\n")
+}
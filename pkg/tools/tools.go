@@ -0,0 +1,24 @@
+// Package tools defines the Drive MCP tools and their handlers, so they can be embedded in any MCP server.
+package tools
+
+import "github.com/kitagry/drive-mcp/pkg/gdrive"
+
+// Service is the set of Google Workspace operations the tools are built on
+type Service interface {
+	gdrive.FileStore
+	gdrive.DocEditor
+	gdrive.SlideEditor
+	gdrive.SheetEditor
+	gdrive.FileOrganizer
+}
+
+// NewDefaultRegistry returns a registry holding all Google Drive, Docs, Slides, and Sheets tools backed by service
+func NewDefaultRegistry(service Service) *Registry {
+	r := NewRegistry()
+	r.Add(FileTools(service)...)
+	r.Add(DocTools(service)...)
+	r.Add(SlideTools(service)...)
+	r.Add(SheetTools(service)...)
+	r.Add(OrganizeTools(service)...)
+	return r
+}
@@ -0,0 +1,45 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation name of the spans created for tool calls
+const TracerName = "github.com/kitagry/drive-mcp/pkg/tools"
+
+// NewTracingMiddleware records a span for each tool call using tp. Google API requests made by the call become
+// its child spans when the service traces them too. Arguments are not recorded, since they hold file IDs and content.
+// Add it after NewRequestIDMiddleware so that spans carry the request ID.
+func NewTracingMiddleware(tp trace.TracerProvider) server.ToolHandlerMiddleware {
+	tracer := tp.Tracer(TracerName)
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name := request.Params.Name
+			ctx, span := tracer.Start(ctx, "tools/call "+name,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(attribute.String("mcp.tool.name", name)),
+			)
+			defer span.End()
+			if id := RequestIDFromContext(ctx); id != "" {
+				span.SetAttributes(attribute.String("mcp.request.id", id))
+			}
+
+			result, err := next(ctx, request)
+			switch {
+			case err != nil:
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			case result != nil && result.IsError:
+				// Error texts may quote file names, so only the failure is recorded
+				span.SetStatus(codes.Error, "tool returned an error")
+			}
+			return result, err
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// fileIDParams are the parameters that take a file, folder, or shared drive ID, or a list of them
+var fileIDParams = map[string]bool{
+	"fileId":                   true,
+	"fileIds":                  true,
+	"folderId":                 true,
+	"fromFolderId":             true,
+	"destinationFolderId":      true,
+	"documentId":               true,
+	"otherDocumentId":          true,
+	"templateId":               true,
+	"spreadsheetId":            true,
+	"sourceSpreadsheetId":      true,
+	"destinationSpreadsheetId": true,
+	"presentationId":           true,
+	"driveId":                  true,
+	"parentId":                 true,
+	"targetId":                 true,
+}
+
+// NewFileURLMiddleware replaces a Google Docs or Drive URL passed in place of a file ID with the ID, so that every
+// tool accepts pasted links. A URL with no file ID in it fails the call with an error naming the parameter, instead
+// of reaching the API and failing there with a file not found error.
+func NewFileURLMiddleware() server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			args, ok := request.Params.Arguments.(map[string]any)
+			if !ok {
+				return next(ctx, request)
+			}
+			for name, value := range args {
+				if !fileIDParams[name] {
+					continue
+				}
+				switch value := value.(type) {
+				case string:
+					id, err := resolveFileURL(value)
+					if err != nil {
+						return mcp.NewToolResultError("Invalid parameter '" + name + "': " + err.Error()), nil
+					}
+					args[name] = id
+				case []any:
+					for i, item := range value {
+						s, ok := item.(string)
+						if !ok {
+							continue
+						}
+						id, err := resolveFileURL(s)
+						if err != nil {
+							return mcp.NewToolResultError("Invalid parameter '" + name + "': " + err.Error()), nil
+						}
+						value[i] = id
+					}
+				}
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// resolveFileURL returns the file ID in value when it is a URL, and value itself otherwise
+func resolveFileURL(value string) (string, error) {
+	if !gdrive.IsFileURL(value) {
+		return value, nil
+	}
+	ref, err := gdrive.ParseFileURL(value)
+	if err != nil {
+		return "", err
+	}
+	return ref.ID, nil
+}
+
+// requireFileID returns the required file ID parameter name, accepting a Google Docs or Drive URL in place of the ID
+func requireFileID(request mcp.CallToolRequest, name string) (string, error) {
+	value, err := request.RequireString(name)
+	if err != nil {
+		return "", err
+	}
+	return gdrive.ResolveFileID(value), nil
+}
+
+func createResolveURLHandler() func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		rawURL, err := request.RequireString("url")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'url' is required"), nil
+		}
+
+		// Parse URL
+		ref, err := gdrive.ParseFileURL(rawURL)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to resolve URL: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(ref)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
@@ -0,0 +1,281 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/kitagry/drive-mcp/pkg/gdrive"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"google.golang.org/api/drive/v3"
+)
+
+// DefaultWatchInterval is how often watched files and folders are checked for changes
+const DefaultWatchInterval = 30 * time.Second
+
+// folderMimeType is the MIME type of Drive folders
+const folderMimeType = "application/vnd.google-apps.folder"
+
+// watch is a file or folder watched by one client session
+type watch struct {
+	ID       string `json:"watchId"`
+	TargetID string `json:"targetId"`
+	Name     string `json:"name"`
+	Folder   bool   `json:"folder"`
+	URI      string `json:"uri"`
+
+	server    *server.MCPServer
+	sessionID string
+}
+
+// matches reports whether change is to the watched file, or to the watched folder or a file directly in it
+func (w *watch) matches(change gdrive.FileChange) bool {
+	return change.FileID == w.TargetID || (w.Folder && slices.Contains(change.Parents, w.TargetID))
+}
+
+// Watcher polls the Drive Changes API while any file or folder is watched and notifies the client session that
+// registered each watch of the changes to it with a notifications/resources/updated notification
+type Watcher struct {
+	fileStore gdrive.FileStore
+	interval  time.Duration
+	logger    *log.Logger
+
+	mu      sync.Mutex
+	watches map[string]*watch
+	lastID  int
+	// stop ends polling; it is nil while nothing is watched
+	stop context.CancelFunc
+}
+
+// NewWatcher creates a Watcher checking fileStore for changes every interval and logging polling failures to logger
+func NewWatcher(fileStore gdrive.FileStore, interval time.Duration, logger *log.Logger) *Watcher {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	return &Watcher{fileStore: fileStore, interval: interval, logger: logger, watches: make(map[string]*watch)}
+}
+
+// WatchTools returns the watch_file, watch_folder, and unwatch tools backed by watcher
+func WatchTools(watcher *Watcher) []Tool {
+	// Define watch file tool
+	watchFileTool := mcp.NewTool(
+		"watch_file",
+		mcp.WithDescription(fmt.Sprintf("Watch a file for changes during this session. The file is checked every %s, and each change, "+
+			"including renames, moves, trashing, and deletion, is sent as a notifications/resources/updated notification "+
+			"for the returned uri", watcher.interval)),
+		mcp.WithString("fileId",
+			mcp.Required(),
+			mcp.Description("ID or URL of the file to watch"),
+		),
+	)
+
+	// Define watch folder tool
+	watchFolderTool := mcp.NewTool(
+		"watch_folder",
+		mcp.WithDescription(fmt.Sprintf("Watch a folder for changes during this session. The folder is checked every %s, and each "+
+			"change to the folder or to a file directly in it, such as a file added, edited, or removed, is sent as a "+
+			"notifications/resources/updated notification for the returned uri", watcher.interval)),
+		mcp.WithString("folderId",
+			mcp.Required(),
+			mcp.Description("ID or URL of the folder to watch"),
+		),
+	)
+
+	// Define unwatch tool
+	unwatchTool := mcp.NewTool(
+		"unwatch",
+		mcp.WithDescription("Stop a watch started by watch_file or watch_folder"),
+		mcp.WithString("watchId",
+			mcp.Required(),
+			mcp.Description("ID of the watch returned by watch_file or watch_folder"),
+		),
+	)
+
+	return []Tool{
+		{Tool: watchFileTool, Handler: watcher.createWatchHandler("fileId", false), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: watchFolderTool, Handler: watcher.createWatchHandler("folderId", true), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+		{Tool: unwatchTool, Handler: watcher.createUnwatchHandler(), Scopes: []string{drive.DriveScope}, ReadOnly: true},
+	}
+}
+
+func (w *Watcher) createWatchHandler(param string, folder bool) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		targetID, err := requireFileID(request, param)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Parameter '%s' is required", param)), nil
+		}
+
+		// Notifications are sent to the session that asked for them
+		s := server.ServerFromContext(ctx)
+		session := server.ClientSessionFromContext(ctx)
+		if s == nil || session == nil {
+			return mcp.NewToolResultError("Failed to watch: no client session to notify"), nil
+		}
+
+		target, err := w.fileStore.GetFileParents(ctx, targetID)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to get file: " + err.Error()), nil
+		}
+		if folder && target.MimeType != folderMimeType {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to watch: %s is not a folder", targetID)), nil
+		}
+
+		watch, err := w.add(ctx, &watch{
+			TargetID:  target.ID,
+			Name:      target.Name,
+			Folder:    folder,
+			URI:       "gdrive:///" + target.ID,
+			server:    s,
+			sessionID: session.SessionID(),
+		})
+		if err != nil {
+			return mcp.NewToolResultError("Failed to watch: " + err.Error()), nil
+		}
+
+		resultData, err := json.Marshal(watch)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+func (w *Watcher) createUnwatchHandler() func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// Get parameters
+		watchID, err := request.RequireString("watchId")
+		if err != nil {
+			return mcp.NewToolResultError("Parameter 'watchId' is required"), nil
+		}
+
+		watch := w.remove(watchID)
+		if watch == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("Failed to unwatch: no watch %q", watchID)), nil
+		}
+
+		resultData, err := json.Marshal(watch)
+		if err != nil {
+			return mcp.NewToolResultError("Failed to serialize result: " + err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(string(resultData)), nil
+	}
+}
+
+// add registers a watch, starting to poll for changes if it is the first one
+func (w *Watcher) add(ctx context.Context, watch *watch) (*watch, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.stop == nil {
+		// Changes are listed from now on, so only those made after the watch started are reported
+		pageToken, err := w.fileStore.GetStartPageToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Polling outlives the tool call that started it
+		pollCtx, stop := context.WithCancel(context.WithoutCancel(ctx))
+		w.stop = stop
+		go w.run(pollCtx, pageToken)
+	}
+
+	w.lastID++
+	watch.ID = strconv.Itoa(w.lastID)
+	w.watches[watch.ID] = watch
+	return watch, nil
+}
+
+// remove unregisters a watch, stopping polling once nothing is watched. It returns nil for an unknown watch.
+func (w *Watcher) remove(watchID string) *watch {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	watch, ok := w.watches[watchID]
+	if !ok {
+		return nil
+	}
+	delete(w.watches, watchID)
+	if len(w.watches) == 0 && w.stop != nil {
+		w.stop()
+		w.stop = nil
+	}
+	return watch
+}
+
+// run checks for changes every interval until ctx is canceled
+func (w *Watcher) run(ctx context.Context, pageToken string) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pageToken = w.poll(ctx, pageToken)
+		}
+	}
+}
+
+// poll reads the changes made since pageToken, notifies the watches they match, and returns the page token to
+// continue from. A failed read is retried on the next poll.
+func (w *Watcher) poll(ctx context.Context, pageToken string) string {
+	for {
+		list, err := w.fileStore.ListChanges(ctx, gdrive.ListOptions{PageToken: pageToken})
+		if err != nil {
+			if ctx.Err() == nil {
+				w.logger.Printf("Failed to check watched files for changes: %v", err)
+			}
+			return pageToken
+		}
+		w.notify(list.Changes)
+
+		if list.NewStartPageToken != "" {
+			return list.NewStartPageToken
+		}
+		pageToken = list.NextPageToken
+	}
+}
+
+// notify sends each watch the changes it matches in one notification, dropping watches of sessions that ended
+func (w *Watcher) notify(changes []gdrive.FileChange) {
+	w.mu.Lock()
+	watches := make([]*watch, 0, len(w.watches))
+	for _, watch := range w.watches {
+		watches = append(watches, watch)
+	}
+	w.mu.Unlock()
+
+	for _, watch := range watches {
+		var matched []gdrive.FileChange
+		for _, change := range changes {
+			if watch.matches(change) {
+				matched = append(matched, change)
+			}
+		}
+		if len(matched) == 0 {
+			continue
+		}
+
+		err := watch.server.SendNotificationToSpecificClient(watch.sessionID, mcp.MethodNotificationResourceUpdated, map[string]any{
+			"uri":     watch.URI,
+			"watchId": watch.ID,
+			"changes": matched,
+		})
+		switch {
+		case errors.Is(err, server.ErrSessionNotFound):
+			w.remove(watch.ID)
+		case err != nil:
+			w.logger.Printf("Failed to notify watch %s of changes: %v", watch.ID, err)
+		}
+	}
+}